@@ -0,0 +1,190 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/moov-io/base"
+)
+
+const (
+	// cycleDateFormat is the CCYYMMDD format of InputCycleDate and OutputCycleDate
+	cycleDateFormat = "20060102"
+)
+
+// FedwireCalendar knows the Fedwire Funds Service operating days and hours.
+//
+// Fedwire operates on Federal Reserve banking days, which exclude weekends and Federal Reserve holidays.
+// The cycle for a banking day opens at Opening (9:00 p.m. ET) on the preceding calendar day and closes at
+// Closing (7:00 p.m. ET) on the banking day, with CustomerCutoff (6:00 p.m. ET) the last time for customer
+// transfers (CTR and CTP). Times are offsets from midnight in Location and may be adjusted for extensions.
+type FedwireCalendar struct {
+	// Location is the time zone of the schedule, America/New_York
+	Location *time.Location
+	// Opening is when the cycle of the next banking day opens, on the preceding calendar day
+	Opening time.Duration
+	// CustomerCutoff is the cutoff for CustomerTransfer and CustomerTransferPlus messages
+	CustomerCutoff time.Duration
+	// Closing is when the cycle closes and the cutoff for all other messages
+	Closing time.Duration
+	// CutoffWarning is how close to a cutoff CheckCutoff reports a risk
+	CutoffWarning time.Duration
+}
+
+// NewFedwireCalendar returns a FedwireCalendar with the standard Fedwire Funds Service schedule in Eastern time
+func NewFedwireCalendar() (*FedwireCalendar, error) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		return nil, fmt.Errorf("loading Fedwire time zone: %v", err)
+	}
+	return &FedwireCalendar{
+		Location:       loc,
+		Opening:        21 * time.Hour,
+		CustomerCutoff: 18 * time.Hour,
+		Closing:        19 * time.Hour,
+		CutoffWarning:  15 * time.Minute,
+	}, nil
+}
+
+// IsOperatingDay returns true if the date of t in Location is a Fedwire banking day
+func (c *FedwireCalendar) IsOperatingDay(t time.Time) bool {
+	return base.NewTime(c.midnight(t)).IsBankingDay()
+}
+
+// IsOpen returns true if Fedwire accepts messages at t
+func (c *FedwireCalendar) IsOpen(t time.Time) bool {
+	t = t.In(c.Location)
+	clock := c.clock(t)
+	if clock < c.Closing {
+		return c.IsOperatingDay(t)
+	}
+	if clock >= c.Opening {
+		return c.IsOperatingDay(c.midnight(t).AddDate(0, 0, 1))
+	}
+	return false
+}
+
+// CycleDate returns midnight, in Location, of the banking day on which a message sent at t is processed
+func (c *FedwireCalendar) CycleDate(t time.Time) time.Time {
+	t = t.In(c.Location)
+	day := c.midnight(t)
+	if c.clock(t) >= c.Closing {
+		day = day.AddDate(0, 0, 1)
+	}
+	for !c.IsOperatingDay(day) {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+// InputCycleDate returns the CCYYMMDD InputCycleDate of an IMAD for a message sent at t
+func (c *FedwireCalendar) InputCycleDate(t time.Time) string {
+	return c.CycleDate(t).Format(cycleDateFormat)
+}
+
+// NextCycleDate returns the banking day following the cycle date a message sent at t is processed on
+func (c *FedwireCalendar) NextCycleDate(t time.Time) time.Time {
+	day := c.CycleDate(t).AddDate(0, 0, 1)
+	for !c.IsOperatingDay(day) {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+// Cutoff returns the last time on cycleDate a message with the business function code is accepted
+func (c *FedwireCalendar) Cutoff(cycleDate time.Time, businessFunctionCode string) time.Time {
+	switch businessFunctionCode {
+	case CustomerTransfer, CustomerTransferPlus:
+		return c.at(cycleDate, c.CustomerCutoff)
+	}
+	return c.at(cycleDate, c.Closing)
+}
+
+// SettlesToday returns true if a message with the business function code sent at t settles on the
+// calendar date of t in Location
+func (c *FedwireCalendar) SettlesToday(t time.Time, businessFunctionCode string) bool {
+	t = t.In(c.Location)
+	today := c.midnight(t)
+	if !c.CycleDate(t).Equal(today) {
+		return false
+	}
+	return t.Before(c.Cutoff(today, businessFunctionCode))
+}
+
+// CheckCutoff returns a CutoffRiskErr when sending fwm at t risks an ErrorWire category W (Cutoff Hour Error):
+// Fedwire is closed, the cutoff for the business function code has passed or is within CutoffWarning, or the
+// IMAD InputCycleDate is not the cycle date the message would be processed on.
+func (c *FedwireCalendar) CheckCutoff(fwm *FEDWireMessage, t time.Time) error {
+	bfc := ""
+	if fwm.BusinessFunctionCode != nil {
+		bfc = fwm.BusinessFunctionCode.BusinessFunctionCode
+	}
+	t = t.In(c.Location)
+	cycleDate := c.CycleDate(t)
+	cutoff := c.Cutoff(cycleDate, bfc)
+
+	if !c.IsOpen(t) {
+		return NewCutoffRiskErr("Fedwire is closed", cycleDate, cutoff)
+	}
+	if !t.Before(cutoff) {
+		return NewCutoffRiskErr(fmt.Sprintf("cutoff for %s has passed", bfc), cycleDate, cutoff)
+	}
+	if remaining := cutoff.Sub(t); remaining <= c.CutoffWarning {
+		return NewCutoffRiskErr(fmt.Sprintf("cutoff for %s is in %v", bfc, remaining), cycleDate, cutoff)
+	}
+	if imad := fwm.InputMessageAccountabilityData; imad != nil {
+		if imad.InputCycleDate != cycleDate.Format(cycleDateFormat) {
+			return NewCutoffRiskErr(fmt.Sprintf("InputCycleDate %s is not the current cycle date", imad.InputCycleDate),
+				cycleDate, cutoff)
+		}
+	}
+	return nil
+}
+
+// midnight returns the start of the calendar day of t in Location
+func (c *FedwireCalendar) midnight(t time.Time) time.Time {
+	t = t.In(c.Location)
+	return c.at(t, 0)
+}
+
+// at returns the time of day offset, as wall clock time in Location, on the calendar day of t
+func (c *FedwireCalendar) at(t time.Time, offset time.Duration) time.Time {
+	t = t.In(c.Location)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, int(offset), c.Location)
+}
+
+// clock returns the wall clock time of t in Location as an offset from midnight
+func (c *FedwireCalendar) clock(t time.Time) time.Duration {
+	t = t.In(c.Location)
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+}
+
+// CutoffRiskErr is the error given when a message risks an ErrorWire category W (Cutoff Hour Error)
+type CutoffRiskErr struct {
+	Message   string
+	CycleDate string
+	Cutoff    time.Time
+}
+
+// NewCutoffRiskErr creates a new error of the CutoffRiskErr type
+func NewCutoffRiskErr(reason string, cycleDate, cutoff time.Time) CutoffRiskErr {
+	return CutoffRiskErr{
+		Message:   fmt.Sprintf("%s: cycle date %s cutoff %s", reason, cycleDate.Format(cycleDateFormat), cutoff.Format(time.Kitchen)),
+		CycleDate: cycleDate.Format(cycleDateFormat),
+		Cutoff:    cutoff,
+	}
+}
+
+func (e CutoffRiskErr) Error() string {
+	return e.Message
+}
+
+// ErrorCategory returns the ErrorWire ErrorCategory the Fedwire Funds Service would report
+func (e CutoffRiskErr) ErrorCategory() string {
+	return ErrorCategoryCutoffHourError
+}
@@ -0,0 +1,129 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mockFedwireCalendar(t *testing.T) *FedwireCalendar {
+	t.Helper()
+	c, err := NewFedwireCalendar()
+	require.NoError(t, err)
+	return c
+}
+
+func TestFedwireCalendar_IsOperatingDay(t *testing.T) {
+	c := mockFedwireCalendar(t)
+	et := c.Location
+
+	require.True(t, c.IsOperatingDay(time.Date(2025, time.July, 3, 12, 0, 0, 0, et)))
+	require.False(t, c.IsOperatingDay(time.Date(2025, time.July, 4, 12, 0, 0, 0, et)))
+	require.False(t, c.IsOperatingDay(time.Date(2025, time.July, 5, 12, 0, 0, 0, et)))
+	require.False(t, c.IsOperatingDay(time.Date(2025, time.December, 25, 12, 0, 0, 0, et)))
+
+	// 2:00 a.m. UTC on Friday is still Thursday in Eastern time
+	require.True(t, c.IsOperatingDay(time.Date(2025, time.July, 4, 2, 0, 0, 0, time.UTC)))
+}
+
+func TestFedwireCalendar_IsOpen(t *testing.T) {
+	c := mockFedwireCalendar(t)
+	et := c.Location
+
+	// Sunday evening opens the Monday cycle
+	require.False(t, c.IsOpen(time.Date(2025, time.June, 1, 20, 59, 0, 0, et)))
+	require.True(t, c.IsOpen(time.Date(2025, time.June, 1, 21, 0, 0, 0, et)))
+	require.True(t, c.IsOpen(time.Date(2025, time.June, 2, 10, 0, 0, 0, et)))
+	require.False(t, c.IsOpen(time.Date(2025, time.June, 2, 19, 30, 0, 0, et)))
+
+	// Friday evening does not open a cycle
+	require.False(t, c.IsOpen(time.Date(2025, time.June, 6, 21, 30, 0, 0, et)))
+	// nor does the evening before a holiday
+	require.False(t, c.IsOpen(time.Date(2025, time.July, 3, 21, 30, 0, 0, et)))
+}
+
+func TestFedwireCalendar_CycleDate(t *testing.T) {
+	c := mockFedwireCalendar(t)
+	et := c.Location
+
+	require.Equal(t, "20250602", c.InputCycleDate(time.Date(2025, time.June, 2, 18, 59, 0, 0, et)))
+	require.Equal(t, "20250603", c.InputCycleDate(time.Date(2025, time.June, 2, 19, 0, 0, 0, et)))
+	require.Equal(t, "20250603", c.InputCycleDate(time.Date(2025, time.June, 2, 22, 0, 0, 0, et)))
+
+	// after Friday's close the next cycle is Monday
+	require.Equal(t, "20250609", c.InputCycleDate(time.Date(2025, time.June, 6, 19, 0, 0, 0, et)))
+	require.Equal(t, "20250609", c.InputCycleDate(time.Date(2025, time.June, 7, 12, 0, 0, 0, et)))
+
+	// Thursday evening before Independence Day rolls to Monday
+	require.Equal(t, "20250707", c.InputCycleDate(time.Date(2025, time.July, 3, 20, 0, 0, 0, et)))
+
+	next := c.NextCycleDate(time.Date(2025, time.June, 6, 10, 0, 0, 0, et))
+	require.Equal(t, "20250609", next.Format(cycleDateFormat))
+}
+
+func TestFedwireCalendar_Cutoff(t *testing.T) {
+	c := mockFedwireCalendar(t)
+	day := time.Date(2025, time.June, 2, 0, 0, 0, 0, c.Location)
+
+	require.Equal(t, time.Date(2025, time.June, 2, 18, 0, 0, 0, c.Location), c.Cutoff(day, CustomerTransfer))
+	require.Equal(t, time.Date(2025, time.June, 2, 18, 0, 0, 0, c.Location), c.Cutoff(day, CustomerTransferPlus))
+	require.Equal(t, time.Date(2025, time.June, 2, 19, 0, 0, 0, c.Location), c.Cutoff(day, BankTransfer))
+
+	// wall clock times hold across daylight saving time changes
+	day = time.Date(2025, time.March, 10, 0, 0, 0, 0, c.Location)
+	require.Equal(t, 19, c.Cutoff(day, BankTransfer).Hour())
+}
+
+func TestFedwireCalendar_SettlesToday(t *testing.T) {
+	c := mockFedwireCalendar(t)
+	et := c.Location
+
+	require.True(t, c.SettlesToday(time.Date(2025, time.June, 2, 17, 0, 0, 0, et), CustomerTransfer))
+	require.False(t, c.SettlesToday(time.Date(2025, time.June, 2, 18, 30, 0, 0, et), CustomerTransfer))
+	require.True(t, c.SettlesToday(time.Date(2025, time.June, 2, 18, 30, 0, 0, et), BankTransfer))
+	require.False(t, c.SettlesToday(time.Date(2025, time.June, 2, 22, 0, 0, 0, et), BankTransfer))
+	require.False(t, c.SettlesToday(time.Date(2025, time.June, 7, 12, 0, 0, 0, et), BankTransfer))
+}
+
+func TestFedwireCalendar_CheckCutoff(t *testing.T) {
+	c := mockFedwireCalendar(t)
+	et := c.Location
+
+	fwm := mockCustomerTransferData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20250602"
+
+	require.NoError(t, c.CheckCutoff(&fwm, time.Date(2025, time.June, 2, 10, 0, 0, 0, et)))
+	// the cycle opened the evening before
+	require.NoError(t, c.CheckCutoff(&fwm, time.Date(2025, time.June, 1, 21, 30, 0, 0, et)))
+
+	var riskErr CutoffRiskErr
+
+	err := c.CheckCutoff(&fwm, time.Date(2025, time.June, 2, 17, 50, 0, 0, et))
+	require.True(t, errors.As(err, &riskErr))
+	require.Contains(t, err.Error(), "cutoff for CTR is in 10m0s")
+	require.Equal(t, ErrorCategoryCutoffHourError, riskErr.ErrorCategory())
+	require.Equal(t, "20250602", riskErr.CycleDate)
+
+	err = c.CheckCutoff(&fwm, time.Date(2025, time.June, 2, 18, 30, 0, 0, et))
+	require.True(t, errors.As(err, &riskErr))
+	require.Contains(t, err.Error(), "cutoff for CTR has passed")
+
+	err = c.CheckCutoff(&fwm, time.Date(2025, time.June, 2, 20, 0, 0, 0, et))
+	require.True(t, errors.As(err, &riskErr))
+	require.Contains(t, err.Error(), "Fedwire is closed")
+	require.Equal(t, "20250603", riskErr.CycleDate)
+
+	err = c.CheckCutoff(&fwm, time.Date(2025, time.June, 3, 10, 0, 0, 0, et))
+	require.True(t, errors.As(err, &riskErr))
+	require.Contains(t, err.Error(), "InputCycleDate 20250602 is not the current cycle date")
+
+	// other business function codes have until the close
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BankTransfer
+	require.NoError(t, c.CheckCutoff(&fwm, time.Date(2025, time.June, 2, 18, 30, 0, 0, et)))
+}
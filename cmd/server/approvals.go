@@ -0,0 +1,618 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+// Approval statuses
+const (
+	approvalPending  = "pending"
+	approvalApproved = "approved"
+	approvalRejected = "rejected"
+)
+
+// anyBusinessFunctionCode is the threshold key of business function codes without their own threshold
+const anyBusinessFunctionCode = "*"
+
+var (
+	errNotApproved       = errors.New("file requires approval before it can be exported")
+	errConvertApproval   = errors.New("messages over the approval threshold are only exported by GET /files/{fileId}/contents once approved")
+	errNotPending        = errors.New("file is not pending approval")
+	errFileChanged       = errors.New("file changed since it was submitted for approval")
+	errSelfApproval      = errors.New("files can't be approved or rejected by the caller who submitted them")
+	errApproverRequired  = errors.New("approving files requires an authenticated caller")
+	errApprovalsDisabled = errors.New("approvals are not enabled, set WIRE_APPROVAL_THRESHOLDS to require them")
+)
+
+// fileApproval is the approval state of a file which requires a second person's approval. Digest identifies the
+// message which was submitted, so any change to the file needs a new approval.
+type fileApproval struct {
+	FileID               string     `json:"fileId"`
+	TenantID             string     `json:"tenantId,omitempty"`
+	Status               string     `json:"status"`
+	BusinessFunctionCode string     `json:"businessFunctionCode"`
+	Amount               int64      `json:"amount"`
+	Threshold            int64      `json:"threshold"`
+	Digest               string     `json:"digest"`
+	SubmittedBy          string     `json:"submittedBy"`
+	SubmittedAt          time.Time  `json:"submittedAt"`
+	DecidedBy            string     `json:"decidedBy,omitempty"`
+	DecidedAt            *time.Time `json:"decidedAt,omitempty"`
+	Reason               string     `json:"reason,omitempty"`
+}
+
+// messageDigest returns the hex SHA-256 of the JSON of fwm
+func messageDigest(fwm *wire.FEDWireMessage) (string, error) {
+	bs, err := json.Marshal(fwm)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bs)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// parseApprovalThresholds reads "<business function code>=<cents>" pairs separated by commas. The * code sets
+// the threshold of every code without its own.
+func parseApprovalThresholds(v string) (map[string]int64, error) {
+	out := make(map[string]int64)
+	for _, pair := range strings.Split(v, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		code, cents, ok := strings.Cut(pair, "=")
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if !ok || code == "" {
+			return nil, fmt.Errorf("invalid approval threshold %q: expected <business function code>=<cents>", pair)
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(cents), 10, 64)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid approval threshold %q: amount must be cents", pair)
+		}
+		if _, exists := out[code]; exists {
+			return nil, fmt.Errorf("duplicate approval threshold for %s", code)
+		}
+		out[code] = n
+	}
+	if len(out) == 0 {
+		return nil, errors.New("no approval thresholds")
+	}
+	return out, nil
+}
+
+// approvalStore keeps the approval state of files. get returns nil without an error when a file has none.
+type approvalStore interface {
+	get(tenantID, fileID string) (*fileApproval, error)
+	put(approval fileApproval) error
+	remove(tenantID, fileID string) error
+}
+
+// newApprovalStore returns the store kept alongside the files of repo: the database of sqlite and postgres
+// storage, an approvals directory next to the files of filesystem storage and memory otherwise
+func newApprovalStore(repo WireFileRepository) approvalStore {
+	switch r := repo.(type) {
+	case *sqlWireFileRepository:
+		return &sqlApprovalStore{repo: r}
+	case *filesystemWireFileRepository:
+		return &filesystemApprovalStore{repo: r}
+	}
+	return newMemoryApprovalStore()
+}
+
+type memoryApprovalStore struct {
+	mu        sync.Mutex
+	approvals map[string]fileApproval
+}
+
+func newMemoryApprovalStore() *memoryApprovalStore {
+	return &memoryApprovalStore{approvals: make(map[string]fileApproval)}
+}
+
+func (s *memoryApprovalStore) get(tenantID, fileID string) (*fileApproval, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	approval, ok := s.approvals[auditKey(tenantID, fileID)]
+	if !ok {
+		return nil, nil
+	}
+	return &approval, nil
+}
+
+func (s *memoryApprovalStore) put(approval fileApproval) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.approvals[auditKey(approval.TenantID, approval.FileID)] = approval
+	return nil
+}
+
+func (s *memoryApprovalStore) remove(tenantID, fileID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.approvals, auditKey(tenantID, fileID))
+	return nil
+}
+
+// filesystemApprovalStore writes the approval of each file as JSON to an approvals directory beside the file
+type filesystemApprovalStore struct {
+	repo *filesystemWireFileRepository
+}
+
+func (s *filesystemApprovalStore) path(tenantID, fileID string) (*filesystemWireFileRepository, string, error) {
+	tenant := s.repo.ForTenant(tenantID).(*filesystemWireFileRepository)
+	path, err := tenant.path(fileID)
+	if err != nil {
+		return nil, "", err
+	}
+	return tenant, filepath.Join(tenant.dir, "approvals", filepath.Base(path)), nil
+}
+
+func (s *filesystemApprovalStore) get(tenantID, fileID string) (*fileApproval, error) {
+	_, path, err := s.path(tenantID, fileID)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var approval fileApproval
+	if err := json.Unmarshal(bs, &approval); err != nil {
+		return nil, fmt.Errorf("reading %s: %v", path, err)
+	}
+	return &approval, nil
+}
+
+func (s *filesystemApprovalStore) put(approval fileApproval) error {
+	tenant, path, err := s.path(approval.TenantID, approval.FileID)
+	if err != nil {
+		return err
+	}
+	bs, err := json.Marshal(approval)
+	if err != nil {
+		return err
+	}
+	return tenant.write(path, bs)
+}
+
+func (s *filesystemApprovalStore) remove(tenantID, fileID string) error {
+	_, path, err := s.path(tenantID, fileID)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// sqlApprovalStore keeps approvals in the wire_approvals table of the storage database
+type sqlApprovalStore struct {
+	repo *sqlWireFileRepository
+}
+
+func (s *sqlApprovalStore) get(tenantID, fileID string) (*fileApproval, error) {
+	var bs []byte
+	err := s.repo.db.QueryRow(s.repo.rebind(`SELECT approval FROM wire_approvals WHERE tenant_id = ? AND file_id = ?`), tenantID, fileID).Scan(&bs)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var approval fileApproval
+	if err := json.Unmarshal(bs, &approval); err != nil {
+		return nil, err
+	}
+	return &approval, nil
+}
+
+func (s *sqlApprovalStore) put(approval fileApproval) error {
+	bs, err := json.Marshal(approval)
+	if err != nil {
+		return err
+	}
+	_, err = s.repo.db.Exec(s.repo.rebind(`INSERT INTO wire_approvals (tenant_id, file_id, approval, updated_at) VALUES (?, ?, ?, ?)
+ON CONFLICT (tenant_id, file_id) DO UPDATE SET approval = excluded.approval, updated_at = excluded.updated_at`),
+		approval.TenantID, approval.FileID, string(bs), time.Now().UTC())
+	return err
+}
+
+func (s *sqlApprovalStore) remove(tenantID, fileID string) error {
+	_, err := s.repo.db.Exec(s.repo.rebind(`DELETE FROM wire_approvals WHERE tenant_id = ? AND file_id = ?`), tenantID, fileID)
+	return err
+}
+
+// approvalWorkflow holds outbound files over the threshold of their business function code until a second
+// caller approves them. Every file over its threshold requires approval, including those with output tags such as
+// OutputMessageAccountabilityData, as callers can set those tags themselves.
+//
+// A nil *approvalWorkflow requires no approvals.
+type approvalWorkflow struct {
+	thresholds map[string]int64
+	store      approvalStore
+	// audit records decisions when set
+	audit auditSink
+}
+
+// newApprovalWorkflowFromEnv returns the workflow of the WIRE_APPROVAL_THRESHOLDS thresholds, storing approvals
+// alongside the files of repo. It returns nil when no thresholds are set.
+func newApprovalWorkflowFromEnv(logger log.Logger, getenv func(string) string, repo WireFileRepository, audit auditSink) (*approvalWorkflow, error) {
+	v := getenv("WIRE_APPROVAL_THRESHOLDS")
+	if v == "" {
+		return nil, nil
+	}
+	thresholds, err := parseApprovalThresholds(v)
+	if err != nil {
+		return nil, err
+	}
+	logger.Logf("requiring approval of files over %d threshold(s)", len(thresholds))
+	return &approvalWorkflow{thresholds: thresholds, store: newApprovalStore(repo), audit: audit}, nil
+}
+
+// requires returns the threshold fwm is over, and false when fwm needs no approval
+func (a *approvalWorkflow) requires(fwm *wire.FEDWireMessage) (int64, bool) {
+	if a == nil {
+		return 0, false
+	}
+	code := ""
+	if fwm.BusinessFunctionCode != nil {
+		code = strings.ToUpper(strings.TrimSpace(fwm.BusinessFunctionCode.BusinessFunctionCode))
+	}
+	threshold, ok := a.thresholds[code]
+	if !ok {
+		if threshold, ok = a.thresholds[anyBusinessFunctionCode]; !ok {
+			return 0, false
+		}
+	}
+	return threshold, messageAmount(fwm) > threshold
+}
+
+// messageAmount returns the Amount of fwm in cents
+func messageAmount(fwm *wire.FEDWireMessage) int64 {
+	if fwm.Amount == nil {
+		return 0
+	}
+	n, _ := strconv.ParseInt(strings.TrimSpace(fwm.Amount.Amount), 10, 64)
+	return n
+}
+
+// submit marks file pending approval when it requires it, replacing any earlier decision
+func (a *approvalWorkflow) submit(tenantID string, file *wire.File, submittedBy string) error {
+	if a == nil {
+		return nil
+	}
+	threshold, required := a.requires(&file.FEDWireMessage)
+	if !required {
+		return a.store.remove(tenantID, file.ID)
+	}
+	digest, err := messageDigest(&file.FEDWireMessage)
+	if err != nil {
+		return err
+	}
+	approval := fileApproval{
+		FileID:      file.ID,
+		TenantID:    tenantID,
+		Status:      approvalPending,
+		Amount:      messageAmount(&file.FEDWireMessage),
+		Threshold:   threshold,
+		Digest:      digest,
+		SubmittedBy: submittedBy,
+		SubmittedAt: time.Now().UTC(),
+	}
+	if bfc := file.FEDWireMessage.BusinessFunctionCode; bfc != nil {
+		approval.BusinessFunctionCode = strings.TrimSpace(bfc.BusinessFunctionCode)
+	}
+	return a.store.put(approval)
+}
+
+// remove forgets the approval of a deleted file
+func (a *approvalWorkflow) remove(tenantID, fileID string) error {
+	if a == nil {
+		return nil
+	}
+	return a.store.remove(tenantID, fileID)
+}
+
+// checkExport returns errNotApproved, along with the approval of file, unless file needs no approval or was
+// approved as it is now
+func (a *approvalWorkflow) checkExport(tenantID string, file *wire.File) (*fileApproval, error) {
+	if _, required := a.requires(&file.FEDWireMessage); !required {
+		return nil, nil
+	}
+	approval, err := a.store.get(tenantID, file.ID)
+	if err != nil {
+		return nil, err
+	}
+	if approval == nil || approval.Status != approvalApproved {
+		return approval, errNotApproved
+	}
+	digest, err := messageDigest(&file.FEDWireMessage)
+	if err != nil {
+		return nil, err
+	}
+	if digest != approval.Digest {
+		return approval, errNotApproved
+	}
+	return approval, nil
+}
+
+// checkConvert returns errConvertApproval when fwm requires approval, as converting it to an output format would
+// export it without one
+func (a *approvalWorkflow) checkConvert(fwm *wire.FEDWireMessage) error {
+	if _, required := a.requires(fwm); required {
+		return errConvertApproval
+	}
+	return nil
+}
+
+// approvalDecision is a checker's approval or rejection of a file
+type approvalDecision struct {
+	Approve   bool
+	Reason    string
+	Checker   *principal
+	RequestID string
+}
+
+// decide approves or rejects file, which must be pending approval as it was submitted. The checker must be
+// another caller than the one who submitted the file.
+func (a *approvalWorkflow) decide(tenantID string, file *wire.File, decision approvalDecision) (*fileApproval, error) {
+	if decision.Checker == nil {
+		return nil, errApproverRequired
+	}
+	approval, err := a.store.get(tenantID, file.ID)
+	if err != nil {
+		return nil, err
+	}
+	if approval == nil || approval.Status != approvalPending {
+		return approval, errNotPending
+	}
+	digest, err := messageDigest(&file.FEDWireMessage)
+	if err != nil {
+		return nil, err
+	}
+	if digest != approval.Digest {
+		return approval, errFileChanged
+	}
+	if decision.Checker.Subject == approval.SubmittedBy {
+		return approval, errSelfApproval
+	}
+
+	now := time.Now().UTC()
+	action := auditReject
+	approval.Status = approvalRejected
+	if decision.Approve {
+		approval.Status, action = approvalApproved, auditApprove
+	}
+	approval.DecidedBy, approval.DecidedAt, approval.Reason = decision.Checker.Subject, &now, decision.Reason
+	if err := a.store.put(*approval); err != nil {
+		return nil, err
+	}
+	if a.audit != nil {
+		entry := auditEntry{
+			ID:         base.ID(),
+			FileID:     file.ID,
+			TenantID:   tenantID,
+			Action:     action,
+			Actor:      decision.Checker.Subject,
+			AuthMethod: decision.Checker.Method,
+			RequestID:  decision.RequestID,
+			Timestamp:  now,
+		}
+		if err := a.audit.record(entry); err != nil {
+			return approval, fmt.Errorf("file %s but recording audit entry failed: %v", approval.Status, err)
+		}
+	}
+	return approval, nil
+}
+
+// approvalWireFileRepository submits the files saved through it for approval, recording the caller who saved them
+// as the submitter
+type approvalWireFileRepository struct {
+	WireFileRepository
+	approvals *approvalWorkflow
+	tenant    string
+	submitter string
+}
+
+func newApprovalWireFileRepository(repo WireFileRepository, approvals *approvalWorkflow) *approvalWireFileRepository {
+	return &approvalWireFileRepository{WireFileRepository: repo, approvals: approvals, submitter: "system"}
+}
+
+func (r *approvalWireFileRepository) ForTenant(tenantID string) WireFileRepository {
+	return &approvalWireFileRepository{
+		WireFileRepository: r.WireFileRepository.ForTenant(tenantID),
+		approvals:          r.approvals,
+		tenant:             tenantID,
+		submitter:          r.submitter,
+	}
+}
+
+// forRequest returns the repository submitting files as the caller of req
+func (r *approvalWireFileRepository) forRequest(req *http.Request) WireFileRepository {
+	out := *r
+	out.submitter = "anonymous"
+	if p := requestPrincipal(req); p != nil {
+		out.submitter = p.Subject
+	}
+	return &out
+}
+
+func (r *approvalWireFileRepository) SaveFile(file *wire.File) error {
+	if err := r.WireFileRepository.SaveFile(file); err != nil {
+		return err
+	}
+	if err := r.approvals.submit(r.tenant, file, r.submitter); err != nil {
+		return fmt.Errorf("file saved but submitting it for approval failed: %v", err)
+	}
+	return nil
+}
+
+func (r *approvalWireFileRepository) DeleteFile(fileId string) error {
+	if err := r.WireFileRepository.DeleteFile(fileId); err != nil {
+		return err
+	}
+	if err := r.approvals.remove(r.tenant, fileId); err != nil {
+		return fmt.Errorf("file deleted but removing its approval failed: %v", err)
+	}
+	return nil
+}
+
+// Close closes the wrapped repository
+func (r *approvalWireFileRepository) Close() error {
+	if closer, ok := r.WireFileRepository.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// notApprovedProblem writes a 403 Forbidden with the approval of a file which can't be exported yet
+func notApprovedProblem(w http.ResponseWriter, err error, approval *fileApproval) {
+	writeJSON(w, http.StatusForbidden, map[string]interface{}{
+		"error":    err.Error(),
+		"approval": approval,
+	})
+}
+
+func addApprovalRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository, approvals *approvalWorkflow) {
+	if approvals == nil {
+		return
+	}
+	r.Methods("GET").Path("/files/{fileId}/approval").HandlerFunc(getFileApproval(logger, repo, approvals))
+	r.Methods("POST").Path("/files/{fileId}/approve").HandlerFunc(decideFile(logger, repo, approvals, true))
+	r.Methods("POST").Path("/files/{fileId}/reject").HandlerFunc(decideFile(logger, repo, approvals, false))
+}
+
+// getApprovalFile returns the file of the request, writing a response and returning nil when it can't be read
+func getApprovalFile(logger log.Logger, w http.ResponseWriter, r *http.Request, repo WireFileRepository) (log.Logger, *wire.File) {
+	fileId := getFileId(w, r)
+	if fileId == "" {
+		logger.LogError(errNoFileId)
+		return logger, nil
+	}
+	logger = logger.Set("fileID", log.String(fileId))
+
+	file, err := repo.GetFile(fileId)
+	if err != nil {
+		err = logger.LogErrorf("error retrieving file: %v", err).Err()
+		moovhttp.Problem(w, err)
+		return logger, nil
+	}
+	if file == nil {
+		logger.Log("file not found")
+		http.NotFound(w, r)
+	}
+	return logger, file
+}
+
+// getFileApproval returns the approval state of a file, or 404 when the file doesn't require approval
+func getFileApproval(logger log.Logger, repo WireFileRepository, approvals *approvalWorkflow) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
+
+		logger, file := getApprovalFile(logger, w, r, repo)
+		if file == nil {
+			return
+		}
+		if _, required := approvals.requires(&file.FEDWireMessage); !required {
+			logger.Log("file does not require approval")
+			http.NotFound(w, r)
+			return
+		}
+		approval, err := approvals.store.get(requestTenantID(r), file.ID)
+		if err != nil {
+			err = logger.LogErrorf("error reading file approval: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		if approval == nil {
+			logger.Log("file approval not found")
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(w, http.StatusOK, approval)
+	}
+}
+
+// decideFile approves or rejects a file pending approval. The request body may hold a reason for the decision.
+func decideFile(logger log.Logger, repo WireFileRepository, approvals *approvalWorkflow, approve bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
+
+		var req struct {
+			Reason string `json:"reason"`
+		}
+		if r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+				err = logger.LogErrorf("error reading request body: %v", err).Err()
+				moovhttp.Problem(w, err)
+				return
+			}
+		}
+
+		logger, file := getApprovalFile(logger, w, r, repo)
+		if file == nil {
+			return
+		}
+
+		approval, err := approvals.decide(requestTenantID(r), file, approvalDecision{
+			Approve:   approve,
+			Reason:    strings.TrimSpace(req.Reason),
+			Checker:   requestPrincipal(r),
+			RequestID: moovhttp.GetRequestID(r),
+		})
+		switch {
+		case errors.Is(err, errApproverRequired), errors.Is(err, errSelfApproval):
+			logger.LogErrorf("approval refused: %v", err)
+			writeJSON(w, http.StatusForbidden, map[string]interface{}{"error": err.Error()})
+			return
+		case errors.Is(err, errNotPending), errors.Is(err, errFileChanged):
+			logger.LogErrorf("approval refused: %v", err)
+			writeJSON(w, http.StatusConflict, map[string]interface{}{"error": err.Error(), "approval": approval})
+			return
+		case err != nil:
+			err = logger.LogErrorf("error deciding file approval: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		logger.Logf("file %s by %s", approval.Status, approval.DecidedBy)
+		writeJSON(w, http.StatusOK, approval)
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseApprovalThresholds(t *testing.T) {
+	thresholds, err := parseApprovalThresholds(" ctr=1000000, *=5000000,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{"CTR": 1000000, "*": 5000000}, thresholds)
+
+	for _, v := range []string{"", ",", "CTR", "=100", "CTR=10.00", "CTR=-1", "CTR=1,CTR=2"} {
+		_, err := parseApprovalThresholds(v)
+		require.Error(t, err, v)
+	}
+}
+
+func TestApprovalWorkflow_requires(t *testing.T) {
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	fwm := &f.FEDWireMessage // CTR of 1234567 cents
+
+	var none *approvalWorkflow
+	_, required := none.requires(fwm)
+	require.False(t, required)
+
+	for thresholds, want := range map[string]bool{
+		"CTR=1234566":       true,
+		"CTR=1234567":       false,
+		"BTR=0":             false,
+		"BTR=0,*=1000":      true,
+		"CTR=2000000,*=100": false,
+	} {
+		th, err := parseApprovalThresholds(thresholds)
+		require.NoError(t, err)
+		_, required := (&approvalWorkflow{thresholds: th}).requires(fwm)
+		require.Equal(t, want, required, thresholds)
+	}
+
+	// output tags are set by callers too, so they don't exempt a message
+	output := *fwm
+	output.OutputMessageAccountabilityData = wire.NewOutputMessageAccountabilityData()
+	_, required = (&approvalWorkflow{thresholds: map[string]int64{"*": 0}}).requires(&output)
+	require.True(t, required)
+}
+
+// testApprovalStore checks store keeps the approval of each file of each tenant
+func testApprovalStore(t *testing.T, store approvalStore) {
+	t.Helper()
+
+	fileID := base.ID()
+	approval, err := store.get("", fileID)
+	require.NoError(t, err)
+	require.Nil(t, approval)
+
+	submitted := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, store.put(fileApproval{FileID: fileID, Status: approvalPending, Amount: 100, SubmittedBy: "jane", SubmittedAt: submitted}))
+	require.NoError(t, store.put(fileApproval{FileID: fileID, TenantID: "acme", Status: approvalPending}))
+
+	approval, err = store.get("", fileID)
+	require.NoError(t, err)
+	require.Equal(t, approvalPending, approval.Status)
+	require.Equal(t, int64(100), approval.Amount)
+	require.Equal(t, "jane", approval.SubmittedBy)
+	require.True(t, submitted.Equal(approval.SubmittedAt))
+
+	approval.Status, approval.DecidedBy = approvalApproved, "john"
+	require.NoError(t, store.put(*approval))
+	approval, err = store.get("", fileID)
+	require.NoError(t, err)
+	require.Equal(t, approvalApproved, approval.Status)
+	require.Equal(t, "john", approval.DecidedBy)
+
+	// tenants are kept apart
+	approval, err = store.get("acme", fileID)
+	require.NoError(t, err)
+	require.Equal(t, approvalPending, approval.Status)
+
+	require.NoError(t, store.remove("", fileID))
+	require.NoError(t, store.remove("", fileID))
+	approval, err = store.get("", fileID)
+	require.NoError(t, err)
+	require.Nil(t, approval)
+	approval, err = store.get("acme", fileID)
+	require.NoError(t, err)
+	require.NotNil(t, approval)
+}
+
+func TestApprovalStores(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		store := newApprovalStore(newMemoryWireFileRepository())
+		require.IsType(t, &memoryApprovalStore{}, store)
+		testApprovalStore(t, store)
+	})
+
+	t.Run("filesystem", func(t *testing.T) {
+		repo, err := newFilesystemWireFileRepository(t.TempDir())
+		require.NoError(t, err)
+		store := newApprovalStore(repo)
+		require.IsType(t, &filesystemApprovalStore{}, store)
+		testApprovalStore(t, store)
+
+		// approvals aren't listed as files
+		files, err := repo.ForTenant("acme").GetFiles()
+		require.NoError(t, err)
+		require.Empty(t, files)
+		_, err = store.get("", "../foo")
+		require.Error(t, err)
+	})
+
+	t.Run("sqlite", func(t *testing.T) {
+		repo, err := newSQLWireFileRepository("sqlite", filepath.Join(t.TempDir(), "wire.db"))
+		require.NoError(t, err)
+		defer repo.Close()
+		store := newApprovalStore(repo)
+		require.IsType(t, &sqlApprovalStore{}, store)
+		testApprovalStore(t, store)
+	})
+}
+
+func TestNewApprovalWorkflowFromEnv(t *testing.T) {
+	env := map[string]string{}
+	getenv := func(key string) string { return env[key] }
+	logger := log.NewNopLogger()
+
+	approvals, err := newApprovalWorkflowFromEnv(logger, getenv, newMemoryWireFileRepository(), nil)
+	require.NoError(t, err)
+	require.Nil(t, approvals)
+
+	env["WIRE_APPROVAL_THRESHOLDS"] = "CTR=100"
+	approvals, err = newApprovalWorkflowFromEnv(logger, getenv, newMemoryWireFileRepository(), nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), approvals.thresholds["CTR"])
+
+	env["WIRE_APPROVAL_THRESHOLDS"] = "CTR"
+	_, err = newApprovalWorkflowFromEnv(logger, getenv, newMemoryWireFileRepository(), nil)
+	require.Error(t, err)
+}
+
+func TestFiles_approval(t *testing.T) {
+	sink := newMemoryAuditSink()
+	approvals := &approvalWorkflow{
+		thresholds: map[string]int64{"CTR": 1000000},
+		store:      newMemoryApprovalStore(),
+		audit:      sink,
+	}
+	repo := newAuditWireFileRepository(newApprovalWireFileRepository(newMemoryWireFileRepository(), approvals), sink)
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, approvals)
+	addApprovalRoutes(log.NewNopLogger(), router, repo, approvals)
+	addAuditRoutes(log.NewNopLogger(), router, sink)
+
+	body, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	request := func(method, path, body string, p *principal) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, strings.NewReader(body))
+		if p != nil {
+			r = withPrincipal(r, p)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+	readApproval := func(w *httptest.ResponseRecorder) fileApproval {
+		t.Helper()
+		var approval fileApproval
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&approval))
+		return approval
+	}
+	maker := &principal{Subject: "jane", TenantID: "acme", Method: "apikey"}
+	checker := &principal{Subject: "john", TenantID: "acme", Method: "jwt"}
+	outsider := &principal{Subject: "mallory", TenantID: "globex", Method: "apikey"}
+
+	w := request("POST", "/files/create", string(body), maker)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&created))
+	path := "/files/" + created.ID
+
+	// the file is pending until approved
+	w = request("GET", path+"/approval", "", maker)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	approval := readApproval(w)
+	require.Equal(t, approvalPending, approval.Status)
+	require.Equal(t, "jane", approval.SubmittedBy)
+	require.Equal(t, "CTR", approval.BusinessFunctionCode)
+	require.Equal(t, int64(1234567), approval.Amount)
+	require.Equal(t, int64(1000000), approval.Threshold)
+
+	w = request("GET", path+"/contents", "", maker)
+	require.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+	require.Contains(t, w.Body.String(), "pending")
+
+	// by another caller of the tenant
+	w = request("POST", path+"/approve", "", maker)
+	require.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+	require.Equal(t, http.StatusNotFound, request("POST", path+"/approve", "", outsider).Code)
+	require.Equal(t, http.StatusNotFound, request("GET", path+"/approval", "", outsider).Code)
+
+	w = request("POST", path+"/approve", `{"reason":"checked with the customer"}`, checker)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	approval = readApproval(w)
+	require.Equal(t, approvalApproved, approval.Status)
+	require.Equal(t, "john", approval.DecidedBy)
+	require.Equal(t, "checked with the customer", approval.Reason)
+	require.NotNil(t, approval.DecidedAt)
+
+	w = request("GET", path+"/contents", "", maker)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Contains(t, w.Body.String(), "{2000}000001234567")
+
+	// decisions are final
+	require.Equal(t, http.StatusConflict, request("POST", path+"/reject", "", checker).Code)
+
+	// changing the file needs a new approval
+	w = request("PATCH", path+"/FEDWireMessage/amount", `{"amount":"000002000000"}`, checker)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, http.StatusForbidden, request("GET", path+"/contents", "", maker).Code)
+	w = request("POST", path+"/approve", "", checker)
+	require.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+
+	w = request("POST", path+"/reject", `{"reason":"wrong amount"}`, maker)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	approval = readApproval(w)
+	require.Equal(t, approvalRejected, approval.Status)
+	require.Equal(t, "jane", approval.DecidedBy)
+	require.Equal(t, http.StatusForbidden, request("GET", path+"/contents", "", maker).Code)
+
+	// decisions are kept in the audit trail
+	w = request("GET", path+"/history", "", maker)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var entries []auditEntry
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&entries))
+	require.Len(t, entries, 4)
+	require.Equal(t, auditApprove, entries[1].Action)
+	require.Equal(t, "john", entries[1].Actor)
+	require.Equal(t, "jwt", entries[1].AuthMethod)
+	require.Equal(t, auditReject, entries[3].Action)
+
+	// files under the threshold are exported without approval
+	w = request("PATCH", path+"/FEDWireMessage/amount", `{"amount":"000000000100"}`, maker)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, http.StatusOK, request("GET", path+"/contents", "", maker).Code)
+	require.Equal(t, http.StatusNotFound, request("GET", path+"/approval", "", maker).Code)
+
+	// approvals are removed with their file
+	w = request("PATCH", path+"/FEDWireMessage/amount", `{"amount":"000002000000"}`, maker)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, http.StatusOK, request("DELETE", path, "", maker).Code)
+	pending, err := approvals.store.get("acme", created.ID)
+	require.NoError(t, err)
+	require.Nil(t, pending)
+}
+
+func TestFiles_approvalOutputTags(t *testing.T) {
+	approvals := &approvalWorkflow{thresholds: map[string]int64{"CTR": 1000000}, store: newMemoryApprovalStore()}
+	repo := newApprovalWireFileRepository(newMemoryWireFileRepository(), approvals)
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, approvals)
+	maker := &principal{Subject: "jane", TenantID: "acme", Method: "apikey"}
+	request := func(method, path, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, withPrincipal(httptest.NewRequest(method, path, strings.NewReader(body)), maker))
+		return w
+	}
+
+	// a caller adding an OMAD to a file over the threshold still needs a second caller's approval
+	body, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	w := request("POST", "/files/create", "{1120}20190410Dest0001000001041012300000\n"+string(body))
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created wire.File
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&created))
+	require.NotNil(t, created.FEDWireMessage.OutputMessageAccountabilityData)
+
+	w = request("GET", "/files/"+created.ID+"/contents", "")
+	require.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+	require.Contains(t, w.Body.String(), "pending")
+}
+
+func TestApprovalWorkflow_decide(t *testing.T) {
+	approvals := &approvalWorkflow{thresholds: map[string]int64{"*": 0}, store: newMemoryApprovalStore()}
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.ID = base.ID()
+	require.NoError(t, approvals.submit("", f, "jane"))
+
+	// callers must be authenticated
+	_, err = approvals.decide("", f, approvalDecision{Approve: true})
+	require.ErrorIs(t, err, errApproverRequired)
+
+	// the file must be approved as it was submitted
+	changed := *f
+	changed.FEDWireMessage.Amount = &wire.Amount{Amount: "000000000001"}
+	_, err = approvals.decide("", &changed, approvalDecision{Approve: true, Checker: &principal{Subject: "john"}})
+	require.ErrorIs(t, err, errFileChanged)
+
+	// files approved as they are now are exported
+	_, err = approvals.checkExport("", f)
+	require.ErrorIs(t, err, errNotApproved)
+	approval, err := approvals.decide("", f, approvalDecision{Approve: true, Checker: &principal{Subject: "john"}})
+	require.NoError(t, err)
+	require.Equal(t, approvalApproved, approval.Status)
+	_, err = approvals.checkExport("", f)
+	require.NoError(t, err)
+	_, err = approvals.checkExport("", &changed)
+	require.ErrorIs(t, err, errNotApproved)
+
+	// failures to record are returned
+	approvals.audit = failingAuditSink{}
+	require.NoError(t, approvals.submit("", f, "jane"))
+	_, err = approvals.decide("", f, approvalDecision{Checker: &principal{Subject: "john"}})
+	require.ErrorContains(t, err, "audit")
+}
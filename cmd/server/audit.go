@@ -0,0 +1,492 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/moov-io/wire/wirepb"
+)
+
+// Audit actions
+const (
+	auditCreate         = "create"
+	auditUpdate         = "update"
+	auditAddMessage     = "add-message"
+	auditReplaceMessage = "replace-message"
+	auditPatch          = "patch"
+	auditDelete         = "delete"
+	auditApprove        = "approve"
+	auditReject         = "reject"
+)
+
+// auditActions are the actions of the routes which change existing files. Other changes are recorded as updates.
+var auditActions = map[string]string{
+	"POST /files/{fileId}/FEDWireMessage":         auditAddMessage,
+	"PUT /files/{fileId}/FEDWireMessage":          auditReplaceMessage,
+	"PUT /files/{fileId}/FEDWireMessage/{tag}":    auditPatch,
+	"PATCH /files/{fileId}/FEDWireMessage/{tag}":  auditPatch,
+	"DELETE /files/{fileId}/FEDWireMessage/{tag}": auditPatch,
+
+	"POST " + wirepb.WireService_AddFEDWireMessage_FullMethodName: auditAddMessage,
+}
+
+// auditEntry records a change to a stored file
+type auditEntry struct {
+	ID       string `json:"id"`
+	FileID   string `json:"fileId"`
+	TenantID string `json:"tenantId,omitempty"`
+	Action   string `json:"action"`
+	// Actor is the authenticated caller, anonymous when authentication is disabled and system outside requests
+	Actor      string        `json:"actor"`
+	AuthMethod string        `json:"authMethod,omitempty"`
+	RequestID  string        `json:"requestId,omitempty"`
+	Timestamp  time.Time     `json:"timestamp"`
+	Changes    []auditChange `json:"changes,omitempty"`
+}
+
+// auditChange is the JSON of a FEDWireMessage tag before and after a change. Before is omitted for added tags
+// and After for removed tags.
+type auditChange struct {
+	Tag    string          `json:"tag"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// diffMessages returns the tags which differ between before and after, either of which may be nil
+func diffMessages(before, after *wire.FEDWireMessage) ([]auditChange, error) {
+	var out []auditChange
+	typ := reflect.TypeOf(wire.FEDWireMessage{})
+	for i := 0; i < typ.NumField(); i++ {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		if idx, ok := messageTags[strings.ToLower(name)]; !ok || idx != i {
+			continue
+		}
+		b, err := marshalMessageTag(before, i)
+		if err != nil {
+			return nil, err
+		}
+		a, err := marshalMessageTag(after, i)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(b, a) {
+			out = append(out, auditChange{Tag: name, Before: b, After: a})
+		}
+	}
+	return out, nil
+}
+
+// marshalMessageTag returns the JSON of field i of msg, or nil when msg or the field are empty
+func marshalMessageTag(msg *wire.FEDWireMessage, i int) (json.RawMessage, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	field := reflect.ValueOf(msg).Elem().Field(i)
+	if field.IsZero() {
+		return nil, nil
+	}
+	return json.Marshal(field.Interface())
+}
+
+// auditSink stores audit entries. Entries are only ever appended, and history returns those of a file oldest
+// first.
+type auditSink interface {
+	record(entry auditEntry) error
+	history(tenantID, fileID string) ([]auditEntry, error)
+}
+
+// newAuditSinkFromEnv returns the sink named by WIRE_AUDIT_SINK: memory (the default), file, which appends to
+// WIRE_AUDIT_FILE, or database, which uses the database of sqlite and postgres storage.
+func newAuditSinkFromEnv(logger log.Logger, getenv func(string) string, repo WireFileRepository) (auditSink, error) {
+	switch sink := strings.ToLower(getenv("WIRE_AUDIT_SINK")); sink {
+	case "", "memory":
+		logger.Log("keeping audit trail in memory")
+		return newMemoryAuditSink(), nil
+	case "file":
+		path := getenv("WIRE_AUDIT_FILE")
+		if path == "" {
+			return nil, errors.New("file audit sink requires WIRE_AUDIT_FILE")
+		}
+		logger.Logf("appending audit trail to %s", path)
+		return newFileAuditSink(path)
+	case "database":
+		sqlRepo, ok := repo.(*sqlWireFileRepository)
+		if !ok {
+			return nil, errors.New("database audit sink requires sqlite or postgres storage")
+		}
+		logger.Logf("storing audit trail in %s", sqlRepo.driver)
+		return &sqlAuditSink{repo: sqlRepo}, nil
+	default:
+		return nil, fmt.Errorf("unknown audit sink %q", sink)
+	}
+}
+
+// sealedAuditSink encrypts the before and after JSON of each change with cipher, as stored files are, so the
+// audit trail doesn't keep in clear text the data of encrypted files. Each value is bound to its entry and tag.
+type sealedAuditSink struct {
+	auditSink
+	cipher *fileCipher
+}
+
+// newSealedAuditSink returns sink encrypting the changes it records with cipher, or sink when cipher is nil
+func newSealedAuditSink(sink auditSink, cipher *fileCipher) auditSink {
+	if cipher == nil {
+		return sink
+	}
+	return &sealedAuditSink{auditSink: sink, cipher: cipher}
+}
+
+// changeAdditionalData binds the encrypted value of a change to its entry, tag and side
+func changeAdditionalData(entry auditEntry, tag, side string) []byte {
+	return append(fileAdditionalData(entry.TenantID, entry.FileID), []byte("\x00"+entry.ID+"\x00"+tag+"\x00"+side)...)
+}
+
+func (s *sealedAuditSink) record(entry auditEntry) error {
+	changes := make([]auditChange, len(entry.Changes))
+	var err error
+	for i, change := range entry.Changes {
+		changes[i].Tag = change.Tag
+		if change.Before != nil {
+			if changes[i].Before, err = s.cipher.seal(change.Before, changeAdditionalData(entry, change.Tag, "before")); err != nil {
+				return err
+			}
+		}
+		if change.After != nil {
+			if changes[i].After, err = s.cipher.seal(change.After, changeAdditionalData(entry, change.Tag, "after")); err != nil {
+				return err
+			}
+		}
+	}
+	entry.Changes = changes
+	return s.auditSink.record(entry)
+}
+
+func (s *sealedAuditSink) history(tenantID, fileID string) ([]auditEntry, error) {
+	entries, err := s.auditSink.history(tenantID, fileID)
+	if err != nil {
+		return nil, err
+	}
+	for i, entry := range entries {
+		changes := make([]auditChange, len(entry.Changes))
+		for j, change := range entry.Changes {
+			changes[j].Tag = change.Tag
+			// entries recorded before encryption was enabled are read as they are
+			if change.Before != nil {
+				if changes[j].Before, err = s.cipher.open(change.Before, changeAdditionalData(entry, change.Tag, "before")); err != nil {
+					return nil, fmt.Errorf("audit entry %s: %v", entry.ID, err)
+				}
+			}
+			if change.After != nil {
+				if changes[j].After, err = s.cipher.open(change.After, changeAdditionalData(entry, change.Tag, "after")); err != nil {
+					return nil, fmt.Errorf("audit entry %s: %v", entry.ID, err)
+				}
+			}
+		}
+		entries[i].Changes = changes
+	}
+	return entries, nil
+}
+
+func auditKey(tenantID, fileID string) string {
+	return tenantID + "\x00" + fileID
+}
+
+type memoryAuditSink struct {
+	mu      sync.Mutex
+	entries map[string][]auditEntry
+}
+
+func newMemoryAuditSink() *memoryAuditSink {
+	return &memoryAuditSink{entries: make(map[string][]auditEntry)}
+}
+
+func (s *memoryAuditSink) record(entry auditEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := auditKey(entry.TenantID, entry.FileID)
+	s.entries[key] = append(s.entries[key], entry)
+	return nil
+}
+
+func (s *memoryAuditSink) history(tenantID, fileID string) ([]auditEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]auditEntry(nil), s.entries[auditKey(tenantID, fileID)]...), nil
+}
+
+// fileAuditSink appends entries as JSON lines to a file opened for appending only, syncing each one to disk.
+// history reads the whole file, so it suits files rotated or shipped elsewhere by log tooling.
+type fileAuditSink struct {
+	path string
+
+	mu sync.Mutex
+	fd *os.File
+}
+
+func newFileAuditSink(path string) (*fileAuditSink, error) {
+	fd, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &fileAuditSink{path: path, fd: fd}, nil
+}
+
+func (s *fileAuditSink) record(entry auditEntry) error {
+	bs, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.fd.Write(append(bs, '\n')); err != nil {
+		return err
+	}
+	return s.fd.Sync()
+}
+
+func (s *fileAuditSink) history(tenantID, fileID string) ([]auditEntry, error) {
+	fd, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	var out []auditEntry
+	dec := json.NewDecoder(fd)
+	for {
+		var entry auditEntry
+		if err := dec.Decode(&entry); err != nil {
+			if err == io.EOF {
+				return out, nil
+			}
+			return nil, fmt.Errorf("reading %s: %v", s.path, err)
+		}
+		if entry.TenantID == tenantID && entry.FileID == fileID {
+			out = append(out, entry)
+		}
+	}
+}
+
+func (s *fileAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.fd.Close()
+}
+
+// sqlAuditSink inserts entries into the wire_audit table of the storage database, which rejects updates and
+// deletes
+type sqlAuditSink struct {
+	repo *sqlWireFileRepository
+}
+
+func (s *sqlAuditSink) record(entry auditEntry) error {
+	bs, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = s.repo.db.Exec(s.repo.rebind(`INSERT INTO wire_audit (entry_id, tenant_id, file_id, recorded_at, entry) VALUES (?, ?, ?, ?, ?)`),
+		entry.ID, entry.TenantID, entry.FileID, entry.Timestamp, bs)
+	return err
+}
+
+func (s *sqlAuditSink) history(tenantID, fileID string) ([]auditEntry, error) {
+	rows, err := s.repo.db.Query(s.repo.rebind(`SELECT entry FROM wire_audit WHERE tenant_id = ? AND file_id = ? ORDER BY seq`), tenantID, fileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []auditEntry
+	for rows.Next() {
+		var bs []byte
+		if err := rows.Scan(&bs); err != nil {
+			return nil, err
+		}
+		var entry auditEntry
+		if err := json.Unmarshal(bs, &entry); err != nil {
+			return nil, err
+		}
+		out = append(out, entry)
+	}
+	return out, rows.Err()
+}
+
+// requestRepository is implemented by repositories which record the request changing files
+type requestRepository interface {
+	forRequest(r *http.Request) WireFileRepository
+}
+
+// auditWireFileRepository records an audit entry for each file saved or deleted through it. The entry is recorded
+// once the change is stored, and a failure to record it is returned.
+type auditWireFileRepository struct {
+	WireFileRepository
+	sink   auditSink
+	tenant string
+
+	actor      string
+	authMethod string
+	requestID  string
+	route      string
+}
+
+func newAuditWireFileRepository(repo WireFileRepository, sink auditSink) *auditWireFileRepository {
+	return &auditWireFileRepository{WireFileRepository: repo, sink: sink, actor: "system"}
+}
+
+func (r *auditWireFileRepository) ForTenant(tenantID string) WireFileRepository {
+	return &auditWireFileRepository{
+		WireFileRepository: r.WireFileRepository.ForTenant(tenantID),
+		sink:               r.sink,
+		tenant:             tenantID,
+		actor:              r.actor,
+	}
+}
+
+// forRequest returns the repository recording changes as made by the caller of req
+func (r *auditWireFileRepository) forRequest(req *http.Request) WireFileRepository {
+	out := *r
+	if rr, ok := r.WireFileRepository.(requestRepository); ok {
+		out.WireFileRepository = rr.forRequest(req)
+	}
+	out.actor, out.authMethod = "anonymous", ""
+	if p := requestPrincipal(req); p != nil {
+		out.actor, out.authMethod = p.Subject, p.Method
+	}
+	out.requestID = moovhttp.GetRequestID(req)
+	// gRPC calls have no route, their path is the method called
+	out.route = req.Method + " " + req.URL.Path
+	if route := mux.CurrentRoute(req); route != nil {
+		tmpl, _ := route.GetPathTemplate()
+		out.route = req.Method + " " + tmpl
+	}
+	return &out
+}
+
+func (r *auditWireFileRepository) newEntry(fileID, action string, before, after *wire.FEDWireMessage) (auditEntry, error) {
+	changes, err := diffMessages(before, after)
+	if err != nil {
+		return auditEntry{}, err
+	}
+	return auditEntry{
+		ID:         base.ID(),
+		FileID:     fileID,
+		TenantID:   r.tenant,
+		Action:     action,
+		Actor:      r.actor,
+		AuthMethod: r.authMethod,
+		RequestID:  r.requestID,
+		Timestamp:  time.Now().UTC(),
+		Changes:    changes,
+	}, nil
+}
+
+func (r *auditWireFileRepository) SaveFile(file *wire.File) error {
+	existing, err := r.WireFileRepository.GetFile(file.ID)
+	if err != nil {
+		return err
+	}
+	action, before := auditCreate, (*wire.FEDWireMessage)(nil)
+	if existing != nil {
+		action, before = auditUpdate, &existing.FEDWireMessage
+		if a, ok := auditActions[r.route]; ok {
+			action = a
+		}
+	}
+	entry, err := r.newEntry(file.ID, action, before, &file.FEDWireMessage)
+	if err != nil {
+		return err
+	}
+	if err := r.WireFileRepository.SaveFile(file); err != nil {
+		return err
+	}
+	if err := r.sink.record(entry); err != nil {
+		return fmt.Errorf("file saved but recording audit entry failed: %v", err)
+	}
+	return nil
+}
+
+func (r *auditWireFileRepository) DeleteFile(fileId string) error {
+	existing, err := r.WireFileRepository.GetFile(fileId)
+	if err != nil {
+		return err
+	}
+	if err := r.WireFileRepository.DeleteFile(fileId); err != nil {
+		return err
+	}
+	if existing == nil {
+		return nil
+	}
+	entry, err := r.newEntry(fileId, auditDelete, &existing.FEDWireMessage, nil)
+	if err != nil {
+		return err
+	}
+	if err := r.sink.record(entry); err != nil {
+		return fmt.Errorf("file deleted but recording audit entry failed: %v", err)
+	}
+	return nil
+}
+
+// Close closes the wrapped repository
+func (r *auditWireFileRepository) Close() error {
+	if closer, ok := r.WireFileRepository.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func addAuditRoutes(logger log.Logger, r *mux.Router, sink auditSink) {
+	r.Methods("GET").Path("/files/{fileId}/history").HandlerFunc(getFileHistory(logger, sink))
+}
+
+// getFileHistory returns the audit entries of a file, oldest first. Deleted files keep their history.
+func getFileHistory(logger log.Logger, sink auditSink) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		fileId := getFileId(w, r)
+		if fileId == "" {
+			logger.LogError(errNoFileId)
+			return
+		}
+		logger = logger.Set("fileID", log.String(fileId))
+
+		entries, err := sink.history(requestTenantID(r), fileId)
+		if err != nil {
+			err = logger.LogErrorf("error reading file history: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		if len(entries) == 0 {
+			logger.Log("no file history found")
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(w, http.StatusOK, entries)
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffMessages(t *testing.T) {
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+
+	changes, err := diffMessages(&f.FEDWireMessage, &f.FEDWireMessage)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	after := f.FEDWireMessage
+	after.Amount = &wire.Amount{Amount: "000000000200"}
+	after.Beneficiary = nil
+	changes, err = diffMessages(&f.FEDWireMessage, &after)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, "amount", changes[0].Tag)
+	require.Contains(t, string(changes[0].Before), f.FEDWireMessage.Amount.Amount)
+	require.Contains(t, string(changes[0].After), "000000000200")
+	require.Equal(t, "beneficiary", changes[1].Tag)
+	require.NotEmpty(t, changes[1].Before)
+	require.Empty(t, changes[1].After)
+
+	// every tag of a created message is added
+	changes, err = diffMessages(nil, &f.FEDWireMessage)
+	require.NoError(t, err)
+	require.NotEmpty(t, changes)
+	for _, c := range changes {
+		require.Empty(t, c.Before, c.Tag)
+		require.NotEmpty(t, c.After, c.Tag)
+	}
+}
+
+// testAuditSink checks sink keeps the entries of each file in order
+func testAuditSink(t *testing.T, sink auditSink) {
+	t.Helper()
+
+	fileID := base.ID()
+	for _, entry := range []auditEntry{
+		{ID: base.ID(), FileID: fileID, Action: auditCreate},
+		{ID: base.ID(), FileID: base.ID(), Action: auditCreate},
+		{ID: base.ID(), FileID: fileID, TenantID: "acme", Action: auditCreate},
+		{ID: base.ID(), FileID: fileID, Action: auditPatch, Changes: []auditChange{{Tag: "amount", After: json.RawMessage(`{"amount":"1"}`)}}},
+		{ID: base.ID(), FileID: fileID, Action: auditDelete},
+	} {
+		entry.Actor, entry.Timestamp = "jane", time.Now().UTC()
+		require.NoError(t, sink.record(entry))
+	}
+
+	entries, err := sink.history("", fileID)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, auditCreate, entries[0].Action)
+	require.Equal(t, auditPatch, entries[1].Action)
+	require.Equal(t, "amount", entries[1].Changes[0].Tag)
+	require.JSONEq(t, `{"amount":"1"}`, string(entries[1].Changes[0].After))
+	require.Equal(t, auditDelete, entries[2].Action)
+	require.Equal(t, "jane", entries[2].Actor)
+
+	entries, err = sink.history("acme", fileID)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	entries, err = sink.history("", base.ID())
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestAuditSinks(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		testAuditSink(t, newMemoryAuditSink())
+	})
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "audit.log")
+		sink, err := newFileAuditSink(path)
+		require.NoError(t, err)
+		testAuditSink(t, sink)
+		require.NoError(t, sink.Close())
+
+		// reopening appends to the existing entries
+		sink, err = newFileAuditSink(path)
+		require.NoError(t, err)
+		defer sink.Close()
+		require.NoError(t, sink.record(auditEntry{ID: base.ID(), FileID: "foo", Action: auditCreate}))
+		bs, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, 6, strings.Count(string(bs), "\n"))
+	})
+
+	t.Run("sqlite", func(t *testing.T) {
+		repo, err := newSQLWireFileRepository("sqlite", filepath.Join(t.TempDir(), "wire.db"))
+		require.NoError(t, err)
+		defer repo.Close()
+		testAuditSink(t, &sqlAuditSink{repo: repo})
+
+		// entries can't be changed or removed
+		_, err = repo.db.Exec(`UPDATE wire_audit SET entry = '{}'`)
+		require.ErrorContains(t, err, "append-only")
+		_, err = repo.db.Exec(`DELETE FROM wire_audit`)
+		require.ErrorContains(t, err, "append-only")
+	})
+}
+
+func TestSealedAuditSink(t *testing.T) {
+	c, err := newFileCipher(writeTestKeys(t, "a"))
+	require.NoError(t, err)
+	inner := newMemoryAuditSink()
+	require.Equal(t, auditSink(inner), newSealedAuditSink(inner, nil))
+	sink := newSealedAuditSink(inner, c)
+	testAuditSink(t, sink)
+
+	// values are encrypted in the wrapped sink
+	fileID := base.ID()
+	before := json.RawMessage(`{"personal":{"identifier":"123456789"}}`)
+	require.NoError(t, sink.record(auditEntry{ID: base.ID(), FileID: fileID, TenantID: "acme", Action: auditPatch,
+		Changes: []auditChange{{Tag: "beneficiary", Before: before}}}))
+	stored, err := inner.history("acme", fileID)
+	require.NoError(t, err)
+	require.NotContains(t, string(stored[0].Changes[0].Before), "123456789")
+	require.Nil(t, stored[0].Changes[0].After)
+
+	entries, err := sink.history("acme", fileID)
+	require.NoError(t, err)
+	require.JSONEq(t, string(before), string(entries[0].Changes[0].Before))
+
+	// a value can't be moved to another entry
+	require.NoError(t, inner.record(auditEntry{ID: base.ID(), FileID: fileID, TenantID: "acme", Action: auditPatch,
+		Changes: []auditChange{{Tag: "beneficiary", After: stored[0].Changes[0].Before}}}))
+	_, err = sink.history("acme", fileID)
+	require.ErrorContains(t, err, "decrypting stored file")
+}
+
+func TestNewAuditSinkFromEnv(t *testing.T) {
+	env := map[string]string{}
+	getenv := func(key string) string { return env[key] }
+	logger := log.NewNopLogger()
+
+	sink, err := newAuditSinkFromEnv(logger, getenv, newMemoryWireFileRepository())
+	require.NoError(t, err)
+	require.IsType(t, &memoryAuditSink{}, sink)
+
+	env["WIRE_AUDIT_SINK"] = "file"
+	_, err = newAuditSinkFromEnv(logger, getenv, newMemoryWireFileRepository())
+	require.Error(t, err)
+	env["WIRE_AUDIT_FILE"] = filepath.Join(t.TempDir(), "audit.log")
+	sink, err = newAuditSinkFromEnv(logger, getenv, newMemoryWireFileRepository())
+	require.NoError(t, err)
+	require.NoError(t, sink.(*fileAuditSink).Close())
+
+	env["WIRE_AUDIT_SINK"] = "database"
+	_, err = newAuditSinkFromEnv(logger, getenv, newMemoryWireFileRepository())
+	require.Error(t, err)
+
+	env["WIRE_AUDIT_SINK"] = "kafka"
+	_, err = newAuditSinkFromEnv(logger, getenv, newMemoryWireFileRepository())
+	require.Error(t, err)
+}
+
+func TestFiles_history(t *testing.T) {
+	sink := newMemoryAuditSink()
+	repo := newAuditWireFileRepository(newMemoryWireFileRepository(), sink)
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+	addAuditRoutes(log.NewNopLogger(), router, sink)
+
+	body, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	request := func(method, path, body string, p *principal) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, strings.NewReader(body))
+		r.Header.Set("X-Request-Id", method+" "+path)
+		if p != nil {
+			r = withPrincipal(r, p)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+	jane := &principal{Subject: "jane", TenantID: "acme", Method: "apikey"}
+
+	w := request("POST", "/files/create", string(body), jane)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&created))
+	path := "/files/" + created.ID
+
+	w = request("PATCH", path+"/FEDWireMessage/amount", `{"amount":"000000000200"}`, jane)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	w = request("DELETE", path, "", jane)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// other tenants don't see the history
+	require.Equal(t, http.StatusNotFound, request("GET", path+"/history", "", nil).Code)
+	require.Equal(t, http.StatusNotFound, request("GET", "/files/"+base.ID()+"/history", "", jane).Code)
+
+	w = request("GET", path+"/history", "", jane)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var entries []auditEntry
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&entries))
+	require.Len(t, entries, 3)
+
+	require.Equal(t, auditCreate, entries[0].Action)
+	require.Equal(t, "POST /files/create", entries[0].RequestID)
+	require.NotEmpty(t, entries[0].Changes)
+
+	require.Equal(t, auditPatch, entries[1].Action)
+	require.Equal(t, "jane", entries[1].Actor)
+	require.Equal(t, "apikey", entries[1].AuthMethod)
+	require.Equal(t, "acme", entries[1].TenantID)
+	require.Len(t, entries[1].Changes, 1)
+	require.Equal(t, "amount", entries[1].Changes[0].Tag)
+	require.JSONEq(t, `{"amount":"000000000200"}`, string(entries[1].Changes[0].After))
+	require.False(t, entries[1].Timestamp.IsZero())
+
+	require.Equal(t, auditDelete, entries[2].Action)
+	require.Equal(t, "DELETE "+path, entries[2].RequestID)
+	for _, c := range entries[2].Changes {
+		require.Empty(t, c.After, c.Tag)
+	}
+}
+
+func TestAuditWireFileRepository(t *testing.T) {
+	sink := newMemoryAuditSink()
+	repo := newAuditWireFileRepository(newMemoryWireFileRepository(), sink)
+
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.ID = base.ID()
+	require.NoError(t, repo.SaveFile(f))
+
+	// saving the file again without a request is an update by the system
+	add := httptest.NewRequest("POST", "/files/"+f.ID+"/FEDWireMessage", nil)
+	require.NoError(t, repo.SaveFile(f))
+
+	// deleting a missing file records nothing
+	require.NoError(t, repo.DeleteFile(base.ID()))
+
+	entries, err := sink.history("", f.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, auditCreate, entries[0].Action)
+	require.Equal(t, "system", entries[0].Actor)
+	require.Equal(t, auditUpdate, entries[1].Action)
+	require.Empty(t, entries[1].Changes)
+
+	// anonymous callers are recorded when authentication is disabled
+	require.NoError(t, tenantRepository(repo, add).SaveFile(f))
+	entries, err = sink.history("", f.ID)
+	require.NoError(t, err)
+	require.Equal(t, "anonymous", entries[2].Actor)
+
+	// failures to record are returned
+	failing := newAuditWireFileRepository(newMemoryWireFileRepository(), failingAuditSink{})
+	require.ErrorContains(t, failing.SaveFile(f), "audit")
+	file, err := failing.GetFile(f.ID)
+	require.NoError(t, err)
+	require.NotNil(t, file)
+	require.ErrorContains(t, failing.DeleteFile(f.ID), "audit")
+}
+
+type failingAuditSink struct{}
+
+func (failingAuditSink) record(entry auditEntry) error {
+	return errors.New("sink is down")
+}
+
+func (failingAuditSink) history(tenantID, fileID string) ([]auditEntry, error) {
+	return nil, errors.New("sink is down")
+}
@@ -0,0 +1,247 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	errNoCredentials = errors.New("missing credentials")
+
+	tenantIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+)
+
+// principal is the authenticated caller of a request
+type principal struct {
+	Subject  string `json:"subject"`
+	TenantID string `json:"tenantId"`
+	// Method is apikey, jwt or mtls
+	Method string `json:"method"`
+}
+
+// authenticator checks the credentials of a request. It returns a nil principal and error when the request has
+// none of the credentials it checks, so the next authenticator can.
+type authenticator interface {
+	authenticate(r *http.Request) (*principal, error)
+}
+
+type principalKey struct{}
+
+func withPrincipal(r *http.Request, p *principal) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), principalKey{}, p))
+}
+
+// requestPrincipal returns the caller of r, which is nil when authentication is disabled
+func requestPrincipal(r *http.Request) *principal {
+	p, _ := r.Context().Value(principalKey{}).(*principal)
+	return p
+}
+
+// requestTenantID returns the tenant of the caller of r, which is empty when authentication is disabled
+func requestTenantID(r *http.Request) string {
+	if p := requestPrincipal(r); p != nil {
+		return p.TenantID
+	}
+	return ""
+}
+
+// tenantRepository returns the repository of the files of the caller of r, bound to r when it records who
+// changes files
+func tenantRepository(repo WireFileRepository, r *http.Request) WireFileRepository {
+	repo = repo.ForTenant(requestTenantID(r))
+	if rr, ok := repo.(requestRepository); ok {
+		return rr.forRequest(r)
+	}
+	return repo
+}
+
+// authMiddleware rejects requests which none of authenticators accept with 401 Unauthorized. GET /ping and CORS
+// preflight requests don't require credentials.
+func authMiddleware(logger log.Logger, authenticators []authenticator) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "OPTIONS" || r.URL.Path == "/ping" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			p, err := authenticate(authenticators, r)
+			if err != nil {
+				logger.LogErrorf("authentication failed: %v", err)
+				unauthorized(w, err)
+				return
+			}
+			next.ServeHTTP(w, withPrincipal(r, p))
+		})
+	}
+}
+
+// authenticate returns the caller of r from the first of authenticators which finds credentials in r
+func authenticate(authenticators []authenticator, r *http.Request) (*principal, error) {
+	for _, a := range authenticators {
+		p, err := a.authenticate(r)
+		if err == nil && p != nil && !tenantIDPattern.MatchString(p.TenantID) {
+			err = fmt.Errorf("invalid tenant %q", p.TenantID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			trace.SpanFromContext(r.Context()).SetAttributes(attribute.String("wire.tenant", p.TenantID))
+			return p, nil
+		}
+	}
+	return nil, errNoCredentials
+}
+
+func unauthorized(w http.ResponseWriter, err error) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="wire"`)
+	writeJSON(w, http.StatusUnauthorized, map[string]string{"error": err.Error()})
+}
+
+// apiKeyAuthenticator accepts the keys of its file in the X-API-Key header
+type apiKeyAuthenticator struct {
+	// keys are the principals of the SHA-256 hash of each key
+	keys map[[sha256.Size]byte]principal
+}
+
+// newAPIKeyAuthenticator reads a file of "<key> <tenant> [<name>]" lines. Blank lines and lines starting with #
+// are skipped.
+func newAPIKeyAuthenticator(path string) (*apiKeyAuthenticator, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	a := &apiKeyAuthenticator{keys: make(map[[sha256.Size]byte]principal)}
+	scanner := bufio.NewScanner(fd)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("%s line %d: expected <key> <tenant> [<name>]", path, n)
+		}
+		if !tenantIDPattern.MatchString(fields[1]) {
+			return nil, fmt.Errorf("%s line %d: invalid tenant %q", path, n, fields[1])
+		}
+		p := principal{Subject: "apikey", TenantID: fields[1], Method: "apikey"}
+		if len(fields) == 3 {
+			p.Subject = fields[2]
+		}
+		a.keys[sha256.Sum256([]byte(fields[0]))] = p
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(a.keys) == 0 {
+		return nil, fmt.Errorf("%s has no API keys", path)
+	}
+	return a, nil
+}
+
+func (a *apiKeyAuthenticator) authenticate(r *http.Request) (*principal, error) {
+	key := r.Header.Get("X-API-Key")
+	if key == "" {
+		return nil, nil
+	}
+	// keys are looked up by hash so the lookup takes no longer for keys sharing a prefix with a valid one
+	p, ok := a.keys[sha256.Sum256([]byte(key))]
+	if !ok {
+		return nil, errors.New("invalid API key")
+	}
+	return &p, nil
+}
+
+// clientCertAuthenticator accepts verified TLS client certificates. The subject common name is the caller and the
+// first organizational unit its tenant.
+type clientCertAuthenticator struct{}
+
+func (clientCertAuthenticator) authenticate(r *http.Request) (*principal, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+	cert := r.TLS.VerifiedChains[0][0]
+	if len(cert.Subject.OrganizationalUnit) == 0 {
+		return nil, fmt.Errorf("client certificate %q has no organizational unit naming its tenant", cert.Subject.CommonName)
+	}
+	return &principal{
+		Subject:  cert.Subject.CommonName,
+		TenantID: cert.Subject.OrganizationalUnit[0],
+		Method:   "mtls",
+	}, nil
+}
+
+// authConfig holds the authentication settings read from the environment
+type authConfig struct {
+	authenticators []authenticator
+	// clientCAs verify client certificates, when mTLS is enabled
+	clientCAs *x509.CertPool
+}
+
+// readAuthConfig reads the WIRE_AUTH_* environment variables. Authentication is disabled when none are set.
+func readAuthConfig(getenv func(string) string) (*authConfig, error) {
+	cfg := &authConfig{}
+	if path := getenv("WIRE_AUTH_API_KEYS_FILE"); path != "" {
+		a, err := newAPIKeyAuthenticator(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading API keys: %v", err)
+		}
+		cfg.authenticators = append(cfg.authenticators, a)
+	}
+	if path := getenv("WIRE_AUTH_JWKS_FILE"); path != "" {
+		a, err := newJWTAuthenticator(path, getenv("WIRE_AUTH_JWT_ISSUER"), getenv("WIRE_AUTH_JWT_AUDIENCE"), getenv("WIRE_AUTH_JWT_TENANT_CLAIM"))
+		if err != nil {
+			return nil, fmt.Errorf("reading JWKS: %v", err)
+		}
+		cfg.authenticators = append(cfg.authenticators, a)
+	}
+	if path := getenv("WIRE_AUTH_CLIENT_CA_FILE"); path != "" {
+		if getenv("HTTPS_CERT_FILE") == "" || getenv("HTTPS_KEY_FILE") == "" {
+			return nil, errors.New("WIRE_AUTH_CLIENT_CA_FILE requires HTTPS_CERT_FILE and HTTPS_KEY_FILE")
+		}
+		bs, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading client CAs: %v", err)
+		}
+		cfg.clientCAs = x509.NewCertPool()
+		if !cfg.clientCAs.AppendCertsFromPEM(bs) {
+			return nil, fmt.Errorf("no certificates found in %s", path)
+		}
+		cfg.authenticators = append(cfg.authenticators, clientCertAuthenticator{})
+	}
+	return cfg, nil
+}
+
+func (cfg *authConfig) enabled() bool {
+	return len(cfg.authenticators) > 0
+}
+
+// configureTLS requests client certificates when mTLS is enabled. They are verified when presented but not
+// required, so callers can authenticate with other methods.
+func (cfg *authConfig) configureTLS(tlsConfig *tls.Config) {
+	if cfg.clientCAs != nil {
+		tlsConfig.ClientCAs = cfg.clientCAs
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+}
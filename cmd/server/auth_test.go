@@ -0,0 +1,300 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T, name, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestAPIKeyAuthenticator(t *testing.T) {
+	path := writeTestFile(t, "keys", "# comment\n\nkey-one acme ops\nkey-two globex\n")
+	a, err := newAPIKeyAuthenticator(path)
+	require.NoError(t, err)
+
+	r := httptest.NewRequest("GET", "/files", nil)
+	p, err := a.authenticate(r)
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	r.Header.Set("X-API-Key", "key-one")
+	p, err = a.authenticate(r)
+	require.NoError(t, err)
+	require.Equal(t, &principal{Subject: "ops", TenantID: "acme", Method: "apikey"}, p)
+
+	r.Header.Set("X-API-Key", "key-two")
+	p, err = a.authenticate(r)
+	require.NoError(t, err)
+	require.Equal(t, "globex", p.TenantID)
+
+	r.Header.Set("X-API-Key", "key-three")
+	_, err = a.authenticate(r)
+	require.Error(t, err)
+
+	for _, contents := range []string{"", "key-one\n", "key-one acme ops extra\n", "key-one ../acme\n"} {
+		_, err := newAPIKeyAuthenticator(writeTestFile(t, "keys", contents))
+		require.Error(t, err, contents)
+	}
+}
+
+// testJWK returns the JWK of the public half of key
+func testJWK(kid string, key crypto.Signer) map[string]string {
+	switch k := key.Public().(type) {
+	case *rsa.PublicKey:
+		return map[string]string{
+			"kty": "RSA", "kid": kid, "use": "sig",
+			"n": base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+			"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		return map[string]string{
+			"kty": "EC", "kid": kid, "crv": k.Curve.Params().Name,
+			"x": base64.RawURLEncoding.EncodeToString(k.X.FillBytes(make([]byte, size))),
+			"y": base64.RawURLEncoding.EncodeToString(k.Y.FillBytes(make([]byte, size))),
+		}
+	}
+	return nil
+}
+
+func writeTestJWKS(t *testing.T, path string, keys ...map[string]string) {
+	t.Helper()
+
+	bs, err := json.Marshal(map[string]interface{}{"keys": keys})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bs, 0600))
+}
+
+// signTestJWT returns a token of claims signed by key with RS256 or ES256
+func signTestJWT(t *testing.T, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	t.Helper()
+
+	alg := "RS256"
+	if _, ok := key.(*ecdsa.PrivateKey); ok {
+		alg = "ES256"
+	}
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(signed))
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		var err error
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		require.NoError(t, err)
+		sig = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeTestJWKS(t, path, testJWK("rsa", rsaKey), testJWK("ec", ecKey))
+	a, err := newJWTAuthenticator(path, "https://issuer.example.com", "wire", "org")
+	require.NoError(t, err)
+
+	now := time.Now()
+	claims := func(overrides map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"sub": "jane",
+			"org": "acme",
+			"iss": "https://issuer.example.com",
+			"aud": []string{"other", "wire"},
+			"exp": now.Add(time.Hour).Unix(),
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(c, k)
+			} else {
+				c[k] = v
+			}
+		}
+		return c
+	}
+	authenticate := func(token string) (*principal, error) {
+		r := httptest.NewRequest("GET", "/files", nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+		return a.authenticate(r)
+	}
+
+	for kid, key := range map[string]crypto.Signer{"rsa": rsaKey, "ec": ecKey} {
+		p, err := authenticate(signTestJWT(t, kid, key, claims(nil)))
+		require.NoError(t, err, kid)
+		require.Equal(t, &principal{Subject: "jane", TenantID: "acme", Method: "jwt"}, p)
+	}
+
+	p, err := a.authenticate(httptest.NewRequest("GET", "/files", nil))
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	valid := signTestJWT(t, "rsa", rsaKey, claims(nil))
+	invalid := map[string]string{
+		"expired":       signTestJWT(t, "rsa", rsaKey, claims(map[string]interface{}{"exp": now.Add(-time.Hour).Unix()})),
+		"no exp":        signTestJWT(t, "rsa", rsaKey, claims(map[string]interface{}{"exp": nil})),
+		"not yet valid": signTestJWT(t, "rsa", rsaKey, claims(map[string]interface{}{"nbf": now.Add(time.Hour).Unix()})),
+		"issuer":        signTestJWT(t, "rsa", rsaKey, claims(map[string]interface{}{"iss": "https://evil.example.com"})),
+		"audience":      signTestJWT(t, "rsa", rsaKey, claims(map[string]interface{}{"aud": "other"})),
+		"no tenant":     signTestJWT(t, "rsa", rsaKey, claims(map[string]interface{}{"org": nil})),
+		"unknown kid":   signTestJWT(t, "other", rsaKey, claims(nil)),
+		"wrong key":     signTestJWT(t, "ec", rsaKey, claims(nil)),
+		"malformed":     "not.a.token",
+		"tampered":      valid[:40] + "x" + valid[41:],
+	}
+	for name, token := range invalid {
+		_, err := authenticate(token)
+		require.Error(t, err, name)
+	}
+
+	// rotated keys are picked up without restarting
+	rotated, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	writeTestJWKS(t, path, testJWK("rotated", rotated))
+	require.NoError(t, os.Chtimes(path, now, now.Add(time.Minute)))
+	p, err = authenticate(signTestJWT(t, "rotated", rotated, claims(nil)))
+	require.NoError(t, err)
+	require.Equal(t, "acme", p.TenantID)
+	_, err = authenticate(signTestJWT(t, "rsa", rsaKey, claims(nil)))
+	require.Error(t, err)
+}
+
+func TestClientCertAuthenticator(t *testing.T) {
+	var a clientCertAuthenticator
+
+	r := httptest.NewRequest("GET", "/files", nil)
+	p, err := a.authenticate(r)
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "payments", OrganizationalUnit: []string{"acme"}}}
+	r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+	p, err = a.authenticate(r)
+	require.NoError(t, err)
+	require.Equal(t, &principal{Subject: "payments", TenantID: "acme", Method: "mtls"}, p)
+
+	cert.Subject.OrganizationalUnit = nil
+	_, err = a.authenticate(r)
+	require.Error(t, err)
+}
+
+func TestReadAuthConfig(t *testing.T) {
+	env := map[string]string{}
+	getenv := func(key string) string { return env[key] }
+
+	cfg, err := readAuthConfig(getenv)
+	require.NoError(t, err)
+	require.False(t, cfg.enabled())
+
+	env["WIRE_AUTH_API_KEYS_FILE"] = writeTestFile(t, "keys", "key-one acme\n")
+	cfg, err = readAuthConfig(getenv)
+	require.NoError(t, err)
+	require.True(t, cfg.enabled())
+
+	tlsConfig := &tls.Config{}
+	cfg.configureTLS(tlsConfig)
+	require.Equal(t, tls.NoClientCert, tlsConfig.ClientAuth)
+
+	env["WIRE_AUTH_CLIENT_CA_FILE"] = writeTestFile(t, "ca.pem", "")
+	_, err = readAuthConfig(getenv)
+	require.ErrorContains(t, err, "HTTPS_CERT_FILE")
+
+	env["HTTPS_CERT_FILE"], env["HTTPS_KEY_FILE"] = "cert.pem", "key.pem"
+	_, err = readAuthConfig(getenv)
+	require.ErrorContains(t, err, "no certificates")
+
+	env["WIRE_AUTH_API_KEYS_FILE"] = filepath.Join(t.TempDir(), "missing")
+	_, err = readAuthConfig(getenv)
+	require.Error(t, err)
+}
+
+func TestAuthMiddleware_tenants(t *testing.T) {
+	a, err := newAPIKeyAuthenticator(writeTestFile(t, "keys", "acme-key acme\nglobex-key globex\n"))
+	require.NoError(t, err)
+
+	repo := newMemoryWireFileRepository()
+	router := mux.NewRouter()
+	addPingRoute(router)
+	router.Use(authMiddleware(log.NewNopLogger(), []authenticator{a}))
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	body, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	request := func(method, path, key string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, nil)
+		if method == "POST" {
+			r = httptest.NewRequest(method, path, bytes.NewReader(body))
+		}
+		if key != "" {
+			r.Header.Set("X-API-Key", key)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	require.Equal(t, http.StatusOK, request("GET", "/ping", "").Code)
+
+	w := request("GET", "/files", "")
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+	require.Equal(t, http.StatusUnauthorized, request("GET", "/files", "other-key").Code)
+
+	w = request("POST", "/files/create", "acme-key")
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var created struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&created))
+
+	require.Equal(t, http.StatusOK, request("GET", "/files/"+created.ID, "acme-key").Code)
+	require.Equal(t, http.StatusNotFound, request("GET", "/files/"+created.ID, "globex-key").Code)
+	require.Equal(t, "[]\n", request("GET", "/files", "globex-key").Body.String())
+
+	// globex can't delete acme's file
+	request("DELETE", "/files/"+created.ID, "globex-key")
+	require.Equal(t, http.StatusOK, request("GET", "/files/"+created.ID, "acme-key").Code)
+
+	file, err := repo.ForTenant("acme").GetFile(created.ID)
+	require.NoError(t, err)
+	require.NotNil(t, file)
+}
@@ -26,8 +26,8 @@ import (
 var (
 	filesCreated = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 		Name: "wire_files_created",
-		Help: "The number of WIRE files created",
-	}, nil) // TODO(adam): add key/value pairs []string{"destination", "origin"}
+		Help: "The number of WIRE files created, by business function code",
+	}, []string{"business_function_code"})
 
 	filesDeleted = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 		Name: "wire_files_deleted",
@@ -38,14 +38,18 @@ var (
 	errNoFEDWireMessageID = errors.New("no FEDWireMessage ID found")
 )
 
-func addFileRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository) {
+// addFileRoutes registers the file endpoints. When dedupe is non-nil messages which duplicate a recently
+// created message are rejected, and when approvals is non-nil the contents of files are only exported once
+// approved.
+func addFileRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository, dedupe *wire.DuplicateDetector, approvals *approvalWorkflow) {
 	r.Methods("GET").Path("/files").HandlerFunc(getFiles(logger, repo))
-	r.Methods("POST").Path("/files/create").HandlerFunc(createFile(logger, repo))
+	r.Methods("POST").Path("/files/create").HandlerFunc(createFile(logger, repo, dedupe))
 	r.Methods("GET").Path("/files/{fileId}").HandlerFunc(getFile(logger, repo))
 	r.Methods("DELETE").Path("/files/{fileId}").HandlerFunc(deleteFile(logger, repo))
-	r.Methods("GET").Path("/files/{fileId}/contents").HandlerFunc(getFileContents(logger, repo))
+	r.Methods("GET").Path("/files/{fileId}/contents").HandlerFunc(getFileContents(logger, repo, approvals))
 	r.Methods("GET").Path("/files/{fileId}/validate").HandlerFunc(validateFile(logger, repo))
-	r.Methods("POST").Path("/files/{fileId}/FEDWireMessage").HandlerFunc(addFEDWireMessageToFile(logger, repo))
+	r.Methods("POST").Path("/files/{fileId}/FEDWireMessage").HandlerFunc(addFEDWireMessageToFile(logger, repo, dedupe))
+	addMessageRoutes(logger, r, repo)
 }
 
 func getFileId(w http.ResponseWriter, r *http.Request) string {
@@ -73,29 +77,49 @@ func getFiles(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		}
 
 		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
 
-		files, err := repo.getFiles() // TODO(adam): implement soft and hard limits
+		query, err := parseFileQuery(r.URL.Query())
+		if err != nil {
+			err = logger.LogErrorf("invalid query: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		page, err := repo.FindFiles(query)
 		if err != nil {
 			err = logger.LogErrorf("error retrieving files: %v", err).Err()
 			moovhttp.Problem(w, err)
 			return
 		}
-		logger.Logf("found %d files", len(files))
+		logger.Logf("found %d of %d files", len(page.Files), page.Total)
 
-		w.Header().Set("X-Total-Count", fmt.Sprintf("%d", len(files)))
+		files := page.Files
+		if files == nil {
+			files = []*wire.File{}
+		}
+		if maskedRequest(r) {
+			for i := range files {
+				files[i] = maskFile(files[i])
+			}
+		}
+		w.Header().Set("X-Total-Count", fmt.Sprintf("%d", page.Total))
+		if page.NextCursor != "" {
+			w.Header().Set("X-Next-Cursor", page.NextCursor)
+		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(files)
 	}
 }
 
-func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+func createFile(logger log.Logger, repo WireFileRepository, dedupe *wire.DuplicateDetector) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
 			logger = logger.Set("requestID", log.String(requestID))
 		}
 
 		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
 
 		file := wire.NewFile()
 		if strings.Contains(r.Header.Get("Content-Type"), "application/json") {
@@ -105,14 +129,16 @@ func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 				return
 			}
 
-			if err := file.Validate(); err != nil {
+			if err := file.ValidateContext(r.Context()); err != nil {
+				recordValidationError(&file.FEDWireMessage, err)
 				err = logger.LogErrorf("file validation failed: %v", err).Err()
 				moovhttp.Problem(w, err)
 				return
 			}
 		} else {
-			f, err := wire.NewReader(r.Body).ReadWithOpts(validateOptsFromQuery(r.URL.Query()))
+			f, err := wire.NewReader(r.Body).ReadContext(r.Context(), validateOptsFromQuery(r.URL.Query()))
 			if err != nil {
+				recordReadErrors(r.Context(), &f, err)
 				err = logger.LogErrorf("error reading file: %v", err).Err()
 				moovhttp.Problem(w, err)
 				return
@@ -124,16 +150,27 @@ func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 			file.ID = base.ID()
 		}
 		logger = logger.Set("fileID", log.String(file.ID))
+		traceFile(r, file)
+
+		if err := checkDuplicate(dedupe, &file.FEDWireMessage); duplicateWarning(err) {
+			logger.Logf("possible duplicate message: %v", err)
+			w.Header().Set(duplicateWarningHeader, err.Error())
+		} else if err != nil {
+			logger.LogErrorf("duplicate message: %v", err)
+			duplicateProblem(w, err)
+			return
+		}
 
-		if err := repo.saveFile(file); err != nil {
+		if err := repo.SaveFile(file); err != nil {
+			forgetDuplicate(dedupe, &file.FEDWireMessage)
 			err = logger.LogErrorf("problem saving file: %v", err).Err()
 			moovhttp.Problem(w, err)
 			return
 		}
 		logger.Log("created file")
 
-		// record a metric for files created
-		filesCreated.Add(1) // TODO(adam): add key/value pairs (like in ACH)
+		// record metrics for files created
+		recordFileCreated(file)
 
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(http.StatusCreated)
@@ -148,6 +185,7 @@ func getFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		}
 
 		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
 
 		fileId := getFileId(w, r)
 		if fileId == "" {
@@ -156,7 +194,7 @@ func getFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		}
 		logger = logger.Set("fileID", log.String(fileId))
 
-		file, err := repo.getFile(fileId)
+		file, err := repo.GetFile(fileId)
 		if err != nil {
 			err = logger.LogErrorf("error retrieving file: %v", err).Err()
 			moovhttp.Problem(w, err)
@@ -169,6 +207,9 @@ func getFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 			return
 		}
 
+		if maskedRequest(r) {
+			file = maskFile(file)
+		}
 		logger.Log("rendering file")
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(http.StatusOK)
@@ -183,6 +224,7 @@ func deleteFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		}
 
 		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
 
 		fileId := getFileId(w, r)
 		if fileId == "" {
@@ -191,7 +233,7 @@ func deleteFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		}
 		logger = logger.Set("fileID", log.String(fileId))
 
-		if err := repo.deleteFile(fileId); err != nil {
+		if err := repo.DeleteFile(fileId); err != nil {
 			err = logger.LogErrorf("error deleting file: %v", err).Err()
 			moovhttp.Problem(w, err)
 			return
@@ -211,13 +253,14 @@ func deleteFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 	}
 }
 
-func getFileContents(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+func getFileContents(logger log.Logger, repo WireFileRepository, approvals *approvalWorkflow) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
 			logger = logger.Set("requestID", log.String(requestID))
 		}
 
 		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
 
 		fileId := getFileId(w, r)
 		if fileId == "" {
@@ -226,7 +269,7 @@ func getFileContents(logger log.Logger, repo WireFileRepository) http.HandlerFun
 		}
 		logger = logger.Set("fileID", log.String(fileId))
 
-		file, err := repo.getFile(fileId)
+		file, err := repo.GetFile(fileId)
 		if err != nil {
 			err = logger.LogErrorf("error retrieving file: %v", err).Err()
 			moovhttp.Problem(w, err)
@@ -237,6 +280,17 @@ func getFileContents(logger log.Logger, repo WireFileRepository) http.HandlerFun
 			http.NotFound(w, r)
 			return
 		}
+		traceFile(r, file)
+		if approval, err := approvals.checkExport(requestTenantID(r), file); err != nil {
+			if errors.Is(err, errNotApproved) {
+				logger.LogErrorf("file contents refused: %v", err)
+				notApprovedProblem(w, err, approval)
+				return
+			}
+			err = logger.LogErrorf("error reading file approval: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
 		logger.Log("rendering file contents")
 
 		writer, err := GetWriter(w, r)
@@ -247,7 +301,7 @@ func getFileContents(logger log.Logger, repo WireFileRepository) http.HandlerFun
 		}
 
 		w.Header().Set("Content-Type", "text/plain")
-		if err := writer.Write(file); err != nil {
+		if err := writer.WriteContext(r.Context(), file); err != nil {
 			err = logger.LogErrorf("problem rendering file contents: %v", err).Err()
 			moovhttp.Problem(w, err)
 			return
@@ -263,6 +317,7 @@ func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		}
 
 		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
 
 		fileId := getFileId(w, r)
 		if fileId == "" {
@@ -271,7 +326,7 @@ func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 		}
 		logger = logger.Set("fileID", log.String(fileId))
 
-		file, err := repo.getFile(fileId)
+		file, err := repo.GetFile(fileId)
 		if err != nil {
 			err = logger.LogErrorf("error retrieving file: %v", err).Err()
 			moovhttp.Problem(w, err)
@@ -283,13 +338,15 @@ func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 			http.NotFound(w, r)
 			return
 		}
+		traceFile(r, file)
 
 		if err := file.Create(); err != nil {
 			err = logger.LogErrorf("problem creating file: %v", err).Err()
 			moovhttp.Problem(w, err)
 			return
 		}
-		if err := file.Validate(); err != nil {
+		if err := file.ValidateContext(r.Context()); err != nil {
+			recordValidationError(&file.FEDWireMessage, err)
 			err = logger.LogErrorf("file was invalid: %v", err).Err()
 			moovhttp.Problem(w, err)
 			return
@@ -307,13 +364,14 @@ func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 	}
 }
 
-func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository, dedupe *wire.DuplicateDetector) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
 			logger = logger.Set("requestID", log.String(requestID))
 		}
 
 		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
 
 		var req wire.FEDWireMessage
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -329,7 +387,7 @@ func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.Ha
 		}
 		logger = logger.Set("fileID", log.String(fileId))
 
-		file, err := repo.getFile(fileId)
+		file, err := repo.GetFile(fileId)
 		if err != nil {
 			err = logger.LogErrorf("error retrieving file: %v", err).Err()
 			moovhttp.Problem(w, err)
@@ -342,8 +400,18 @@ func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.Ha
 			return
 		}
 
+		if err := checkDuplicate(dedupe, &req); duplicateWarning(err) {
+			logger.Logf("possible duplicate message: %v", err)
+			w.Header().Set(duplicateWarningHeader, err.Error())
+		} else if err != nil {
+			logger.LogErrorf("duplicate message: %v", err)
+			duplicateProblem(w, err)
+			return
+		}
+
 		file.FEDWireMessage = file.AddFEDWireMessage(req)
-		if err := repo.saveFile(file); err != nil {
+		if err := repo.SaveFile(file); err != nil {
+			forgetDuplicate(dedupe, &req)
 			err = logger.LogErrorf("error saving file: %v", err).Err()
 			moovhttp.Problem(w, err)
 			return
@@ -356,6 +424,40 @@ func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.Ha
 	}
 }
 
+// checkDuplicate returns a wire.DuplicateErr if dedupe is enabled and fwm duplicates a recent message
+func checkDuplicate(dedupe *wire.DuplicateDetector, fwm *wire.FEDWireMessage) error {
+	if dedupe == nil {
+		return nil
+	}
+	return dedupe.Check(fwm)
+}
+
+// duplicateWarning returns true when err only flags a near-duplicate, which may be a legitimate repeat payment and
+// is accepted with a warning
+func duplicateWarning(err error) bool {
+	var dupErr wire.DuplicateErr
+	return errors.As(err, &dupErr) && dupErr.Kind == wire.NearDuplicateMessage
+}
+
+// duplicateWarningHeader flags responses about a message which may repeat a recent payment
+const duplicateWarningHeader = "X-Duplicate-Warning"
+
+// forgetDuplicate removes fwm from dedupe when it was not saved
+func forgetDuplicate(dedupe *wire.DuplicateDetector, fwm *wire.FEDWireMessage) {
+	if dedupe != nil {
+		dedupe.Forget(fwm)
+	}
+}
+
+// duplicateProblem writes err as a 409 Conflict
+func duplicateProblem(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusConflict)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
+
 // GetWriter returns a new Writer based on request param `type` that writes to w.
 // query param `format`=variable - we set VariableLengthFields to `true`
 // query param `newline`=false - we set NewlineCharacter to ""
@@ -431,3 +533,24 @@ func validateOptsFromQuery(query url.Values) (opts *wire.ValidateOpts) {
 
 	return opts
 }
+
+// maskedRequest returns true when the request asks for masked files with ?masked=true
+func maskedRequest(r *http.Request) bool {
+	masked, _ := strconv.ParseBool(r.URL.Query().Get("masked"))
+	return masked
+}
+
+// maskFile returns a copy of file with the account numbers, identifiers and names masked by wire.DefaultMaskOpts
+func maskFile(file *wire.File) *wire.File {
+	masked := *file
+	masked.FEDWireMessage = *file.FEDWireMessage.Mask(wire.DefaultMaskOpts())
+	return &masked
+}
+
+// maskValidationErrors removes the values which failed from errs, as they may be account numbers or names
+func maskValidationErrors(errs []validationError) []validationError {
+	for i := range errs {
+		errs[i].Value = nil
+	}
+	return errs
+}
@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/moov-io/base"
@@ -49,7 +50,7 @@ func TestFiles_getFiles(t *testing.T) {
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	req := httptest.NewRequest("GET", "/files", nil)
 
 	t.Run("retrieves file", func(t *testing.T) {
@@ -75,6 +76,45 @@ func TestFiles_getFiles(t *testing.T) {
 	})
 }
 
+func TestFiles_getFilesQuery(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	for _, id := range []string{"a", "b", "c"} {
+		f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+		require.NoError(t, err)
+		f.ID = id
+		require.NoError(t, repo.SaveFile(f))
+	}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files?businessFunctionCode=CTR&sort=-amount&limit=2", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, "3", w.Header().Get("X-Total-Count"))
+	var files []*wire.File
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&files))
+	require.Len(t, files, 2)
+
+	cursor := w.Header().Get("X-Next-Cursor")
+	require.NotEmpty(t, cursor)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files?businessFunctionCode=CTR&sort=-amount&limit=2&cursor="+cursor, nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Empty(t, w.Header().Get("X-Next-Cursor"))
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&files))
+	require.Len(t, files, 1)
+
+	// no matches is an empty array
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files?senderABA=000000000", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, "[]\n", w.Body.String())
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files?minAmount=1.00", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+}
+
 func readFile(filename string) (*wire.File, error) {
 	fd, err := os.Open(filepath.Join("..", "..", "test", "testdata", filename))
 	if err != nil {
@@ -87,7 +127,7 @@ func readFile(filename string) (*wire.File, error) {
 func TestFiles_createWithInterfaceData(t *testing.T) {
 	router := mux.NewRouter()
 	repo := &testWireFileRepository{}
-	addFileRoutes(log.NewTestLogger(), router, repo)
+	addFileRoutes(log.NewTestLogger(), router, repo, nil, nil)
 
 	w := httptest.NewRecorder()
 	raw := `FTI0811 XFT811  {1500}30        T {1510}1000{1520}20220128DOVTAL3C000001{2000}000000010000{3100}123456789DOVETAIL BANK US F*{3320}XX22012800000051*{3400}021000089CITIBANK NYC*{3600}CTP{3620}3*3AC4C307-0FFB-4028-BD8E-53D55BDB90E1*{3700}SUSD0,*{4200}D000100002*{5000}T000100011*DRESDEFFXXX*`
@@ -130,7 +170,7 @@ func TestFiles_createFile(t *testing.T) {
 	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("creates file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -159,7 +199,7 @@ func TestFiles_createFile(t *testing.T) {
 func TestFiles_createFileJSON(t *testing.T) {
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("creates file from JSON", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -213,7 +253,7 @@ func TestFiles_createFileJSON(t *testing.T) {
 func TestFiles_createFile_missingSenderSupplied(t *testing.T) {
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	// set up a message with no SenderSupplied field
 	fwm := mockFEDWireMessage()
@@ -350,7 +390,7 @@ func TestFiles_getFile(t *testing.T) {
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("gets file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -386,11 +426,42 @@ func TestFiles_getFile(t *testing.T) {
 	})
 }
 
+func TestFiles_masked(t *testing.T) {
+	repo := newMemoryWireFileRepository()
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.ID = base.ID()
+	f.FEDWireMessage.Beneficiary.Personal.Identifier = "123456789"
+	require.NoError(t, repo.SaveFile(f))
+
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+	addMessageRoutes(log.NewNopLogger(), router, repo)
+	get := func(path string) string {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		require.Equal(t, http.StatusOK, w.Code, w.Body)
+		return w.Body.String()
+	}
+
+	require.Contains(t, get("/files/"+f.ID), `"identifier":"123456789"`)
+	for _, path := range []string{"/files", "/files/" + f.ID, "/files/" + f.ID + "/FEDWireMessage", "/files/" + f.ID + "/FEDWireMessage/beneficiary"} {
+		body := get(path + "?masked=true")
+		require.Contains(t, body, `"identifier":"*****6789"`, path)
+		require.NotContains(t, body, `"name":"Name"`, path)
+	}
+
+	// the stored file is unchanged
+	stored, err := repo.GetFile(f.ID)
+	require.NoError(t, err)
+	require.Equal(t, "123456789", stored.FEDWireMessage.Beneficiary.Personal.Identifier)
+}
+
 func TestFiles_deleteFile(t *testing.T) {
 	req := httptest.NewRequest("DELETE", "/files/foo", nil)
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("deletes file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -423,7 +494,7 @@ func TestFiles_getFileContents(t *testing.T) {
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("gets file contents", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -466,7 +537,7 @@ func TestFiles_getFileContentsWithFormatAndNewLineQueryParams(t *testing.T) {
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	// test with no format no newline=false
 	req := httptest.NewRequest("GET", "/files/foo/contents", nil)
@@ -539,7 +610,7 @@ func TestFiles_validateFile(t *testing.T) {
 	require.NoError(t, err)
 	repo := &testWireFileRepository{file: f}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("validates file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -590,7 +661,7 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 	fwm := mockFEDWireMessage()
 	repo := &testWireFileRepository{file: f}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 
 	t.Run("adds message to file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -649,7 +720,7 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 	req := httptest.NewRequest("DELETE", fmt.Sprintf("/files/foo/FEDWireMessage/%s", FEDWireMessageID), nil)
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
 	router.ServeHTTP(w, req)
 	w.Flush()
 
@@ -668,3 +739,34 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 		t.Errorf("bogus HTTP status: %d: %v", w.Code, w.Body.String())
 	}
 }*/
+
+func TestFiles_createFileDuplicate(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	repo := &testWireFileRepository{}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, wire.NewDuplicateDetector(time.Hour), nil)
+
+	// a failed save does not record the message
+	repo.err = errors.New("bad error")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs)))
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+	repo.err = nil
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs)))
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs)))
+	require.Equal(t, http.StatusConflict, w.Code, w.Body)
+	require.Contains(t, w.Body.String(), "duplicate")
+
+	// a repeat payment with its own IMAD and SenderReference is accepted with a warning
+	near := strings.NewReplacer("Source08000001", "Source08000002", "{3320}Sender Reference", "{3320}Other Reference").Replace(string(bs))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/files/create", strings.NewReader(near)))
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+	require.Contains(t, w.Header().Get(duplicateWarningHeader), "same amount and parties")
+}
@@ -0,0 +1,656 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/moov-io/wire/wirepb"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// grpcServer serves the WireService of wirepb. Each call mirrors a REST route and shares its storage, duplicate
+// detection, approvals, audit trail and metrics.
+type grpcServer struct {
+	wirepb.UnimplementedWireServiceServer
+
+	logger    log.Logger
+	repo      WireFileRepository
+	dedupe    *wire.DuplicateDetector
+	approvals *approvalWorkflow
+	audit     auditSink
+}
+
+// newGRPCServer returns the gRPC server of the WireService. Calls are authenticated by authenticators, as REST
+// requests are, with the x-api-key and authorization metadata standing in for headers. tlsConfig is nil to serve
+// without TLS.
+func newGRPCServer(logger log.Logger, repo WireFileRepository, dedupe *wire.DuplicateDetector, approvals *approvalWorkflow, audit auditSink, authenticators []authenticator, tlsConfig *tls.Config) *grpc.Server {
+	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(grpcInterceptor(logger, authenticators))}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	server := grpc.NewServer(opts...)
+	wirepb.RegisterWireServiceServer(server, &grpcServer{
+		logger:    logger,
+		repo:      repo,
+		dedupe:    dedupe,
+		approvals: approvals,
+		audit:     audit,
+	})
+	return server
+}
+
+type callRequestKey struct{}
+
+// newCallRequest returns the HTTP request standing in for the gRPC call of ctx to fullMethod, so authenticators,
+// tenants and the audit trail treat calls as they treat REST requests. Its headers are the call's metadata.
+func newCallRequest(ctx context.Context, fullMethod string) *http.Request {
+	r := (&http.Request{
+		Method:     "POST",
+		URL:        &url.URL{Path: fullMethod},
+		Proto:      "HTTP/2.0",
+		ProtoMajor: 2,
+		Header:     make(http.Header),
+	}).WithContext(ctx)
+	md, _ := metadata.FromIncomingContext(ctx)
+	for key, values := range md {
+		for _, v := range values {
+			r.Header.Add(key, v)
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if p.Addr != nil {
+			r.RemoteAddr = p.Addr.String()
+		}
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			r.TLS = &info.State
+		}
+	}
+	return r
+}
+
+// callRequest returns the request of the call of ctx set by grpcInterceptor
+func callRequest(ctx context.Context) *http.Request {
+	if r, ok := ctx.Value(callRequestKey{}).(*http.Request); ok {
+		return r
+	}
+	return newCallRequest(ctx, "")
+}
+
+// grpcInterceptor does for calls what the REST middleware does for requests: it continues the caller's trace,
+// authenticates the caller when authenticators are set and records the duration of the call.
+func grpcInterceptor(logger log.Logger, authenticators []authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		r := newCallRequest(ctx, info.FullMethod)
+
+		service, method, _ := strings.Cut(strings.TrimPrefix(info.FullMethod, "/"), "/")
+		attrs := []attribute.KeyValue{
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method),
+		}
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			attrs = append(attrs, attribute.String("wire.request_id", requestID))
+		}
+		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(r.Header))
+		ctx, span := otel.Tracer(tracerName).Start(ctx, service+"/"+method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attrs...),
+		)
+		defer span.End()
+		r = r.WithContext(ctx)
+
+		var resp interface{}
+		var err error
+		if len(authenticators) > 0 {
+			p, authErr := authenticate(authenticators, r)
+			if authErr != nil {
+				logger.LogErrorf("authentication failed: %v", authErr)
+				err = status.Error(codes.Unauthenticated, authErr.Error())
+			} else {
+				r = withPrincipal(r, p)
+			}
+		}
+		if err == nil {
+			resp, err = handler(context.WithValue(r.Context(), callRequestKey{}, r), req)
+		}
+
+		code := status.Code(err)
+		span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(code)))
+		if err != nil {
+			// the message of the status may hold the values which failed, which spans must not export
+			span.SetStatus(otelcodes.Error, code.String())
+		}
+		routeHistogram.With("route", "grpc"+strings.ReplaceAll(info.FullMethod, "/", "-")).Observe(time.Since(start).Seconds())
+		return resp, err
+	}
+}
+
+// callLogger returns the logger of the call of r
+func (s *grpcServer) callLogger(r *http.Request) log.Logger {
+	logger := s.logger.Set("method", log.String(r.URL.Path))
+	if requestID := moovhttp.GetRequestID(r); requestID != "" {
+		logger = logger.Set("requestID", log.String(requestID))
+	}
+	return logger
+}
+
+// getFile returns the file id of the caller's tenant, or a NotFound error
+func (s *grpcServer) getFile(logger log.Logger, repo WireFileRepository, id string) (*wire.File, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, errNoFileId.Error())
+	}
+	file, err := repo.GetFile(id)
+	if err != nil {
+		err = logger.LogErrorf("error retrieving file: %v", err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if file == nil {
+		logger.Log("file not found")
+		return nil, status.Errorf(codes.NotFound, "file %s not found", id)
+	}
+	return file, nil
+}
+
+// fileMessage returns the File message of file
+func fileMessage(logger log.Logger, file *wire.File) (*wirepb.File, error) {
+	out, err := wirepb.FromFile(file)
+	if err != nil {
+		err = logger.LogError(err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return out, nil
+}
+
+func (s *grpcServer) ListFiles(ctx context.Context, req *wirepb.ListFilesRequest) (*wirepb.ListFilesResponse, error) {
+	r := callRequest(ctx)
+	logger := s.callLogger(r)
+	repo := tenantRepository(s.repo, r)
+
+	// the request is checked as the query parameters of GET /files are
+	params := url.Values{}
+	for name, v := range map[string]string{
+		"businessFunctionCode": req.GetBusinessFunctionCode(),
+		"typeSubType":          req.GetTypeSubType(),
+		"senderABA":            req.GetSenderAba(),
+		"receiverABA":          req.GetReceiverAba(),
+		"cycleDate":            req.GetCycleDate(),
+		"beneficiaryName":      req.GetBeneficiaryName(),
+		"sort":                 req.GetSort(),
+		"cursor":               req.GetCursor(),
+	} {
+		if v != "" {
+			params.Set(name, v)
+		}
+	}
+	if req.MinAmount != nil {
+		params.Set("minAmount", strconv.FormatInt(req.GetMinAmount(), 10))
+	}
+	if req.MaxAmount != nil {
+		params.Set("maxAmount", strconv.FormatInt(req.GetMaxAmount(), 10))
+	}
+	if req.Valid != nil {
+		params.Set("valid", strconv.FormatBool(req.GetValid()))
+	}
+	if req.GetLimit() != 0 {
+		params.Set("limit", strconv.Itoa(int(req.GetLimit())))
+	}
+	query, err := parseFileQuery(params)
+	if err != nil {
+		err = logger.LogErrorf("invalid query: %v", err).Err()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	page, err := repo.FindFiles(query)
+	if err != nil {
+		err = logger.LogErrorf("error retrieving files: %v", err).Err()
+		if errors.Is(err, errEncryptedBeneficiaryName) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	logger.Logf("found %d of %d files", len(page.Files), page.Total)
+
+	resp := &wirepb.ListFilesResponse{
+		Total:      int32(page.Total),
+		NextCursor: page.NextCursor,
+	}
+	for _, file := range page.Files {
+		if req.GetMasked() {
+			file = maskFile(file)
+		}
+		msg, err := fileMessage(logger, file)
+		if err != nil {
+			return nil, err
+		}
+		resp.Files = append(resp.Files, msg)
+	}
+	return resp, nil
+}
+
+func (s *grpcServer) CreateFile(ctx context.Context, req *wirepb.CreateFileRequest) (*wirepb.File, error) {
+	r := callRequest(ctx)
+	logger := s.callLogger(r)
+	repo := tenantRepository(s.repo, r)
+
+	file, invalid, err := readCallFile(ctx, req.GetFile(), req.GetContents(), req.GetValidateOptions())
+	if err == nil && invalid != nil {
+		err = fmt.Errorf("file validation failed: %v", invalid)
+	}
+	if err != nil {
+		err = logger.LogErrorf("error reading file: %v", err).Err()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if file.ID == "" {
+		file.ID = base.ID()
+	}
+	logger = logger.Set("fileID", log.String(file.ID))
+	traceFile(r, file)
+
+	if err := checkDuplicate(s.dedupe, &file.FEDWireMessage); duplicateWarning(err) {
+		logger.Logf("possible duplicate message: %v", err)
+		grpc.SetHeader(ctx, metadata.Pairs(strings.ToLower(duplicateWarningHeader), err.Error()))
+	} else if err != nil {
+		logger.LogErrorf("duplicate message: %v", err)
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err := repo.SaveFile(file); err != nil {
+		forgetDuplicate(s.dedupe, &file.FEDWireMessage)
+		err = logger.LogErrorf("problem saving file: %v", err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	logger.Log("created file")
+	recordFileCreated(file)
+
+	return fileMessage(logger, file)
+}
+
+func (s *grpcServer) GetFile(ctx context.Context, req *wirepb.GetFileRequest) (*wirepb.File, error) {
+	r := callRequest(ctx)
+	logger := s.callLogger(r).Set("fileID", log.String(req.GetId()))
+
+	file, err := s.getFile(logger, tenantRepository(s.repo, r), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	traceFile(r, file)
+
+	if req.GetMasked() {
+		file = maskFile(file)
+	}
+	logger.Log("rendering file")
+	return fileMessage(logger, file)
+}
+
+func (s *grpcServer) DeleteFile(ctx context.Context, req *wirepb.DeleteFileRequest) (*wirepb.DeleteFileResponse, error) {
+	r := callRequest(ctx)
+	logger := s.callLogger(r).Set("fileID", log.String(req.GetId()))
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, errNoFileId.Error())
+	}
+	if err := tenantRepository(s.repo, r).DeleteFile(req.GetId()); err != nil {
+		err = logger.LogErrorf("error deleting file: %v", err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	logger.Log("deleted file")
+	filesDeleted.Add(1)
+
+	return &wirepb.DeleteFileResponse{}, nil
+}
+
+func (s *grpcServer) GetFileContents(ctx context.Context, req *wirepb.GetFileContentsRequest) (*wirepb.GetFileContentsResponse, error) {
+	r := callRequest(ctx)
+	logger := s.callLogger(r).Set("fileID", log.String(req.GetId()))
+
+	file, err := s.getFile(logger, tenantRepository(s.repo, r), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if approval, err := s.approvals.checkExport(requestTenantID(r), file); err != nil {
+		if errors.Is(err, errNotApproved) {
+			logger.LogErrorf("file contents refused: %v", err)
+			if approval != nil {
+				return nil, status.Errorf(codes.PermissionDenied, "%v: approval is %s", err, approval.Status)
+			}
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		err = logger.LogErrorf("error reading file approval: %v", err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	logger.Log("rendering file contents")
+
+	opts := []wire.OptionFunc{wire.VariableLengthFields(req.GetVariableLengthFields())}
+	if req.GetNoNewline() {
+		opts = append(opts, wire.NewlineCharacter(""))
+	}
+	var buf bytes.Buffer
+	if err := wire.NewWriter(&buf, opts...).WriteContext(ctx, file); err != nil {
+		err = logger.LogErrorf("problem rendering file contents: %v", err).Err()
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &wirepb.GetFileContentsResponse{Contents: buf.String()}, nil
+}
+
+func (s *grpcServer) ValidateFile(ctx context.Context, req *wirepb.ValidateFileRequest) (*wirepb.ValidateFileResponse, error) {
+	r := callRequest(ctx)
+	logger := s.callLogger(r).Set("fileID", log.String(req.GetId()))
+
+	file, err := s.getFile(logger, tenantRepository(s.repo, r), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := file.Create(); err != nil {
+		err = logger.LogErrorf("problem creating file: %v", err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := file.ValidateContext(ctx); err != nil {
+		recordValidationError(&file.FEDWireMessage, err)
+		logger.LogErrorf("file was invalid: %v", err)
+		return &wirepb.ValidateFileResponse{Error: err.Error()}, nil
+	}
+	logger.Log("validated file")
+	return &wirepb.ValidateFileResponse{}, nil
+}
+
+func (s *grpcServer) AddFEDWireMessage(ctx context.Context, req *wirepb.AddFEDWireMessageRequest) (*wirepb.File, error) {
+	r := callRequest(ctx)
+	logger := s.callLogger(r).Set("fileID", log.String(req.GetId()))
+	repo := tenantRepository(s.repo, r)
+
+	fwm, err := wirepb.ToFEDWireMessage(req.GetFedWireMessage())
+	if err == nil && fwm == nil {
+		err = errors.New("missing fedWireMessage")
+	}
+	if err != nil {
+		err = logger.LogErrorf("error reading message: %v", err).Err()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	file, err := s.getFile(logger, repo, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	traceFile(r, file)
+
+	if err := checkDuplicate(s.dedupe, fwm); duplicateWarning(err) {
+		logger.Logf("possible duplicate message: %v", err)
+		grpc.SetHeader(ctx, metadata.Pairs(strings.ToLower(duplicateWarningHeader), err.Error()))
+	} else if err != nil {
+		logger.LogErrorf("duplicate message: %v", err)
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	file.FEDWireMessage = file.AddFEDWireMessage(*fwm)
+	if err := repo.SaveFile(file); err != nil {
+		forgetDuplicate(s.dedupe, fwm)
+		err = logger.LogErrorf("error saving file: %v", err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	logger.Log("added FEDWireMessage to file")
+
+	return fileMessage(logger, file)
+}
+
+func (s *grpcServer) Validate(ctx context.Context, req *wirepb.ValidateRequest) (*wirepb.ValidateResponse, error) {
+	logger := s.callLogger(callRequest(ctx))
+
+	_, invalid, err := readCallFile(ctx, req.GetFile(), req.GetContents(), req.GetValidateOptions())
+	if err != nil {
+		err = logger.LogError(err).Err()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &wirepb.ValidateResponse{}
+	for _, verr := range newValidationErrors(invalid) {
+		msg := &wirepb.ValidationError{
+			Scope:   verr.Scope,
+			Field:   verr.Field,
+			Message: verr.Message,
+		}
+		if verr.Value != nil {
+			msg.Value = fmt.Sprint(verr.Value)
+		}
+		resp.Errors = append(resp.Errors, msg)
+	}
+	resp.Valid = len(resp.Errors) == 0
+	logger.Logf("validated file: valid=%v", resp.Valid)
+
+	return resp, nil
+}
+
+func (s *grpcServer) Convert(ctx context.Context, req *wirepb.ConvertRequest) (*wirepb.ConvertResponse, error) {
+	logger := s.callLogger(callRequest(ctx))
+
+	file, invalid, err := readCallFile(ctx, req.GetFile(), req.GetContents(), req.GetValidateOptions())
+	if err == nil && invalid != nil {
+		err = fmt.Errorf("file validation failed: %v", invalid)
+	}
+	if err != nil {
+		err = logger.LogError(err).Err()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	logger = logger.Set("to", log.String(req.GetTo().String()))
+
+	switch req.GetTo() {
+	case wirepb.Format_FORMAT_UNSPECIFIED, wirepb.Format_FORMAT_JSON:
+	default:
+		if err := s.approvals.checkConvert(&file.FEDWireMessage); err != nil {
+			logger.LogErrorf("conversion refused: %v", err)
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+
+	var buf bytes.Buffer
+	switch req.GetTo() {
+	case wirepb.Format_FORMAT_UNSPECIFIED, wirepb.Format_FORMAT_JSON:
+		msg, err := fileMessage(logger, file)
+		if err != nil {
+			return nil, err
+		}
+		logger.Log("converted file")
+		return &wirepb.ConvertResponse{Result: &wirepb.ConvertResponse_File{File: msg}}, nil
+	case wirepb.Format_FORMAT_FED, wirepb.Format_FORMAT_VARIABLE:
+		opts := []wire.OptionFunc{wire.VariableLengthFields(req.GetTo() == wirepb.Format_FORMAT_VARIABLE)}
+		if req.GetNoNewline() {
+			opts = append(opts, wire.NewlineCharacter(""))
+		}
+		err = wire.NewWriter(&buf, opts...).WriteContext(ctx, file)
+	case wirepb.Format_FORMAT_ISO20022:
+		err = writeISO20022(&buf, file)
+	default:
+		err = fmt.Errorf("unknown to %v", req.GetTo())
+	}
+	if err != nil {
+		err = logger.LogErrorf("problem converting file: %v", err).Err()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	logger.Log("converted file")
+	return &wirepb.ConvertResponse{Result: &wirepb.ConvertResponse_Contents{Contents: buf.String()}}, nil
+}
+
+func (s *grpcServer) GetFileHistory(ctx context.Context, req *wirepb.GetFileHistoryRequest) (*wirepb.GetFileHistoryResponse, error) {
+	r := callRequest(ctx)
+	logger := s.callLogger(r).Set("fileID", log.String(req.GetId()))
+
+	if s.audit == nil {
+		return nil, status.Error(codes.Unimplemented, "the audit trail is not enabled")
+	}
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, errNoFileId.Error())
+	}
+	entries, err := s.audit.history(requestTenantID(r), req.GetId())
+	if err != nil {
+		err = logger.LogErrorf("error reading file history: %v", err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(entries) == 0 {
+		logger.Log("no file history found")
+		return nil, status.Errorf(codes.NotFound, "no history of file %s found", req.GetId())
+	}
+
+	resp := &wirepb.GetFileHistoryResponse{}
+	for _, entry := range entries {
+		msg := &wirepb.AuditEntry{
+			Id:         entry.ID,
+			FileId:     entry.FileID,
+			TenantId:   entry.TenantID,
+			Action:     entry.Action,
+			Actor:      entry.Actor,
+			AuthMethod: entry.AuthMethod,
+			RequestId:  entry.RequestID,
+			Timestamp:  entry.Timestamp.Format(time.RFC3339Nano),
+		}
+		for _, change := range entry.Changes {
+			msg.Changes = append(msg.Changes, &wirepb.AuditChange{
+				Tag:    change.Tag,
+				Before: string(change.Before),
+				After:  string(change.After),
+			})
+		}
+		resp.Entries = append(resp.Entries, msg)
+	}
+	return resp, nil
+}
+
+func (s *grpcServer) GetFileApproval(ctx context.Context, req *wirepb.GetFileApprovalRequest) (*wirepb.FileApproval, error) {
+	r := callRequest(ctx)
+	logger := s.callLogger(r).Set("fileID", log.String(req.GetId()))
+
+	if s.approvals == nil {
+		return nil, status.Error(codes.Unimplemented, errApprovalsDisabled.Error())
+	}
+	file, err := s.getFile(logger, tenantRepository(s.repo, r), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if _, required := s.approvals.requires(&file.FEDWireMessage); !required {
+		logger.Log("file does not require approval")
+		return nil, status.Errorf(codes.NotFound, "file %s does not require approval", file.ID)
+	}
+	approval, err := s.approvals.store.get(requestTenantID(r), file.ID)
+	if err != nil {
+		err = logger.LogErrorf("error reading file approval: %v", err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if approval == nil {
+		logger.Log("file approval not found")
+		return nil, status.Errorf(codes.NotFound, "approval of file %s not found", file.ID)
+	}
+	return approvalMessage(approval), nil
+}
+
+func (s *grpcServer) ApproveFile(ctx context.Context, req *wirepb.DecideFileRequest) (*wirepb.FileApproval, error) {
+	return s.decideFile(ctx, req, true)
+}
+
+func (s *grpcServer) RejectFile(ctx context.Context, req *wirepb.DecideFileRequest) (*wirepb.FileApproval, error) {
+	return s.decideFile(ctx, req, false)
+}
+
+// decideFile approves or rejects a file pending approval, as decideFile does for requests
+func (s *grpcServer) decideFile(ctx context.Context, req *wirepb.DecideFileRequest, approve bool) (*wirepb.FileApproval, error) {
+	r := callRequest(ctx)
+	logger := s.callLogger(r).Set("fileID", log.String(req.GetId()))
+
+	if s.approvals == nil {
+		return nil, status.Error(codes.Unimplemented, errApprovalsDisabled.Error())
+	}
+	file, err := s.getFile(logger, tenantRepository(s.repo, r), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	approval, err := s.approvals.decide(requestTenantID(r), file, approvalDecision{
+		Approve:   approve,
+		Reason:    strings.TrimSpace(req.GetReason()),
+		Checker:   requestPrincipal(r),
+		RequestID: moovhttp.GetRequestID(r),
+	})
+	switch {
+	case errors.Is(err, errApproverRequired), errors.Is(err, errSelfApproval):
+		logger.LogErrorf("approval refused: %v", err)
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, errNotPending), errors.Is(err, errFileChanged):
+		logger.LogErrorf("approval refused: %v", err)
+		if approval != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v: approval is %s", err, approval.Status)
+		}
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		err = logger.LogErrorf("error deciding file approval: %v", err).Err()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	logger.Logf("file %s by %s", approval.Status, approval.DecidedBy)
+	return approvalMessage(approval), nil
+}
+
+// approvalMessage returns the FileApproval message of approval
+func approvalMessage(approval *fileApproval) *wirepb.FileApproval {
+	out := &wirepb.FileApproval{
+		FileId:               approval.FileID,
+		TenantId:             approval.TenantID,
+		Status:               approval.Status,
+		BusinessFunctionCode: approval.BusinessFunctionCode,
+		Amount:               approval.Amount,
+		Threshold:            approval.Threshold,
+		Digest:               approval.Digest,
+		SubmittedBy:          approval.SubmittedBy,
+		SubmittedAt:          approval.SubmittedAt.Format(time.RFC3339Nano),
+		DecidedBy:            approval.DecidedBy,
+		Reason:               approval.Reason,
+	}
+	if approval.DecidedAt != nil {
+		out.DecidedAt = approval.DecidedAt.Format(time.RFC3339Nano)
+	}
+	return out
+}
+
+// readCallFile returns the file of a call, which is given as msg or in the FED format as contents, as
+// readRequestFile does for requests. Files which can be read but are invalid are returned along with invalid.
+func readCallFile(ctx context.Context, msg *wirepb.File, contents string, opts *wirepb.ValidateOpts) (file *wire.File, invalid error, err error) {
+	validateOpts := wirepb.ToValidateOpts(opts)
+	if msg != nil {
+		file, err := wirepb.ToFile(msg)
+		if err != nil {
+			return nil, nil, err
+		}
+		if validateOpts != nil {
+			file.SetValidation(validateOpts)
+		}
+		err = file.ValidateContext(ctx)
+		recordValidationError(&file.FEDWireMessage, err)
+		return file, err, nil
+	}
+	if contents == "" {
+		return nil, nil, errors.New("missing file or contents")
+	}
+	f, err := wire.NewReader(strings.NewReader(contents)).ReadContext(ctx, validateOpts)
+	recordReadErrors(ctx, &f, err)
+	return &f, err, nil
+}
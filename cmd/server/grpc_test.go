@@ -0,0 +1,338 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire/wirepb"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testGRPCClient serves repo over an in-memory connection until the test ends
+func testGRPCClient(t *testing.T, repo WireFileRepository, authenticators ...authenticator) wirepb.WireServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := newGRPCServer(log.NewNopLogger(), repo, nil, nil, nil, authenticators, nil)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return wirepb.NewWireServiceClient(conn)
+}
+
+func TestGRPC_files(t *testing.T) {
+	client := testGRPCClient(t, newMemoryWireFileRepository())
+	ctx := context.Background()
+	contents := string(readTestdata(t, "fedWireMessage-CustomerTransfer.txt"))
+
+	file, err := client.CreateFile(ctx, &wirepb.CreateFileRequest{
+		Source: &wirepb.CreateFileRequest_Contents{Contents: contents},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, file.GetId())
+	require.Equal(t, "CTR", file.GetFedWireMessage().GetBusinessFunctionCode().GetBusinessFunctionCode())
+
+	_, err = client.CreateFile(ctx, &wirepb.CreateFileRequest{
+		Source: &wirepb.CreateFileRequest_Contents{Contents: "invalid"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	found, err := client.GetFile(ctx, &wirepb.GetFileRequest{Id: file.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, file.GetFedWireMessage().GetAmount().GetAmount(), found.GetFedWireMessage().GetAmount().GetAmount())
+
+	list, err := client.ListFiles(ctx, &wirepb.ListFilesRequest{BusinessFunctionCode: "CTR"})
+	require.NoError(t, err)
+	require.Len(t, list.GetFiles(), 1)
+	require.EqualValues(t, 1, list.GetTotal())
+
+	// names are masked on request
+	name := file.GetFedWireMessage().GetBeneficiary().GetPersonal().GetName()
+	require.NotEmpty(t, name)
+	found, err = client.GetFile(ctx, &wirepb.GetFileRequest{Id: file.GetId(), Masked: true})
+	require.NoError(t, err)
+	require.NotEqual(t, name, found.GetFedWireMessage().GetBeneficiary().GetPersonal().GetName())
+	list, err = client.ListFiles(ctx, &wirepb.ListFilesRequest{Masked: true})
+	require.NoError(t, err)
+	require.NotEqual(t, name, list.GetFiles()[0].GetFedWireMessage().GetBeneficiary().GetPersonal().GetName())
+	list, err = client.ListFiles(ctx, &wirepb.ListFilesRequest{BusinessFunctionCode: "DRC"})
+	require.NoError(t, err)
+	require.Empty(t, list.GetFiles())
+	_, err = client.ListFiles(ctx, &wirepb.ListFilesRequest{Sort: "color"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	out, err := client.GetFileContents(ctx, &wirepb.GetFileContentsRequest{Id: file.GetId(), VariableLengthFields: true})
+	require.NoError(t, err)
+	require.Contains(t, out.GetContents(), "{3600}CTR\n")
+
+	validated, err := client.ValidateFile(ctx, &wirepb.ValidateFileRequest{Id: file.GetId()})
+	require.NoError(t, err)
+	require.Empty(t, validated.GetError())
+
+	file, err = client.AddFEDWireMessage(ctx, &wirepb.AddFEDWireMessageRequest{
+		Id:             file.GetId(),
+		FedWireMessage: file.GetFedWireMessage(),
+	})
+	require.NoError(t, err)
+
+	_, err = client.DeleteFile(ctx, &wirepb.DeleteFileRequest{Id: file.GetId()})
+	require.NoError(t, err)
+	_, err = client.GetFile(ctx, &wirepb.GetFileRequest{Id: file.GetId()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.GetFile(ctx, &wirepb.GetFileRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_stateless(t *testing.T) {
+	client := testGRPCClient(t, newMemoryWireFileRepository())
+	ctx := context.Background()
+	contents := string(readTestdata(t, "fedWireMessage-CustomerTransfer.txt"))
+
+	valid, err := client.Validate(ctx, &wirepb.ValidateRequest{
+		Source: &wirepb.ValidateRequest_Contents{Contents: contents},
+	})
+	require.NoError(t, err)
+	require.True(t, valid.GetValid())
+
+	converted, err := client.Convert(ctx, &wirepb.ConvertRequest{
+		Source: &wirepb.ConvertRequest_Contents{Contents: contents},
+	})
+	require.NoError(t, err)
+	msg := converted.GetFile()
+	require.Equal(t, "CTR", msg.GetFedWireMessage().GetBusinessFunctionCode().GetBusinessFunctionCode())
+
+	// files are validated as messages too
+	msg.FedWireMessage.Amount.Amount = "invalid"
+	invalid, err := client.Validate(ctx, &wirepb.ValidateRequest{
+		Source: &wirepb.ValidateRequest_File{File: msg},
+	})
+	require.NoError(t, err)
+	require.False(t, invalid.GetValid())
+	require.NotEmpty(t, invalid.GetErrors())
+	require.Equal(t, "Amount", invalid.GetErrors()[0].GetField())
+
+	_, err = client.Convert(ctx, &wirepb.ConvertRequest{
+		Source: &wirepb.ConvertRequest_File{File: msg},
+		To:     wirepb.Format_FORMAT_FED,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	converted, err = client.Convert(ctx, &wirepb.ConvertRequest{
+		Source: &wirepb.ConvertRequest_Contents{Contents: contents},
+		To:     wirepb.Format_FORMAT_VARIABLE,
+	})
+	require.NoError(t, err)
+	require.Contains(t, converted.GetContents(), "{3600}CTR\n")
+
+	converted, err = client.Convert(ctx, &wirepb.ConvertRequest{
+		Source: &wirepb.ConvertRequest_Contents{Contents: contents},
+		To:     wirepb.Format_FORMAT_ISO20022,
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(converted.GetContents(), "<?xml"), converted.GetContents())
+
+	_, err = client.Validate(ctx, &wirepb.ValidateRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_convertApprovals(t *testing.T) {
+	server := &grpcServer{
+		logger:    log.NewNopLogger(),
+		repo:      newMemoryWireFileRepository(),
+		approvals: &approvalWorkflow{thresholds: map[string]int64{"*": 0}, store: newMemoryApprovalStore()},
+	}
+	contents := string(readTestdata(t, "fedWireMessage-CustomerTransfer.txt"))
+
+	_, err := server.Convert(context.Background(), &wirepb.ConvertRequest{
+		Source: &wirepb.ConvertRequest_Contents{Contents: contents},
+		To:     wirepb.Format_FORMAT_FED,
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	converted, err := server.Convert(context.Background(), &wirepb.ConvertRequest{
+		Source: &wirepb.ConvertRequest_Contents{Contents: contents},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, converted.GetFile())
+}
+
+func TestGRPC_approvals(t *testing.T) {
+	approvals := &approvalWorkflow{thresholds: map[string]int64{"*": 0}, store: newMemoryApprovalStore()}
+	server := &grpcServer{
+		logger:    log.NewNopLogger(),
+		repo:      newApprovalWireFileRepository(newMemoryWireFileRepository(), approvals),
+		approvals: approvals,
+	}
+	callAs := func(subject string) context.Context {
+		r := withPrincipal(newCallRequest(context.Background(), ""), &principal{Subject: subject, TenantID: "acme", Method: "apikey"})
+		return context.WithValue(r.Context(), callRequestKey{}, r)
+	}
+	maker, checker := callAs("maker"), callAs("checker")
+
+	file, err := server.CreateFile(maker, &wirepb.CreateFileRequest{
+		Source: &wirepb.CreateFileRequest_Contents{Contents: string(readTestdata(t, "fedWireMessage-CustomerTransfer.txt"))},
+	})
+	require.NoError(t, err)
+
+	approval, err := server.GetFileApproval(maker, &wirepb.GetFileApprovalRequest{Id: file.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, approvalPending, approval.GetStatus())
+	require.Equal(t, "maker", approval.GetSubmittedBy())
+	require.Empty(t, approval.GetDecidedAt())
+
+	_, err = server.GetFileContents(maker, &wirepb.GetFileContentsRequest{Id: file.GetId()})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the caller who submitted a file can't approve it
+	_, err = server.ApproveFile(maker, &wirepb.DecideFileRequest{Id: file.GetId()})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	approval, err = server.ApproveFile(checker, &wirepb.DecideFileRequest{Id: file.GetId(), Reason: " checked "})
+	require.NoError(t, err)
+	require.Equal(t, approvalApproved, approval.GetStatus())
+	require.Equal(t, "checker", approval.GetDecidedBy())
+	require.Equal(t, "checked", approval.GetReason())
+	require.NotEmpty(t, approval.GetDecidedAt())
+
+	_, err = server.RejectFile(checker, &wirepb.DecideFileRequest{Id: file.GetId()})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	contents, err := server.GetFileContents(maker, &wirepb.GetFileContentsRequest{Id: file.GetId()})
+	require.NoError(t, err)
+	require.NotEmpty(t, contents.GetContents())
+
+	_, err = server.GetFileApproval(checker, &wirepb.GetFileApprovalRequest{Id: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// servers without thresholds don't serve approvals
+	client := testGRPCClient(t, newMemoryWireFileRepository())
+	_, err = client.ApproveFile(context.Background(), &wirepb.DecideFileRequest{Id: file.GetId()})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestGRPC_history(t *testing.T) {
+	sink := newMemoryAuditSink()
+	server := &grpcServer{
+		logger: log.NewNopLogger(),
+		repo:   newAuditWireFileRepository(newMemoryWireFileRepository(), sink),
+		audit:  sink,
+	}
+	ctx := context.Background()
+
+	file, err := server.CreateFile(ctx, &wirepb.CreateFileRequest{
+		Source: &wirepb.CreateFileRequest_Contents{Contents: string(readTestdata(t, "fedWireMessage-CustomerTransfer.txt"))},
+	})
+	require.NoError(t, err)
+	_, err = server.DeleteFile(ctx, &wirepb.DeleteFileRequest{Id: file.GetId()})
+	require.NoError(t, err)
+
+	// deleted files keep their history
+	history, err := server.GetFileHistory(ctx, &wirepb.GetFileHistoryRequest{Id: file.GetId()})
+	require.NoError(t, err)
+	require.Len(t, history.GetEntries(), 2)
+
+	created := history.GetEntries()[0]
+	require.Equal(t, auditCreate, created.GetAction())
+	require.Equal(t, file.GetId(), created.GetFileId())
+	require.NotEmpty(t, created.GetTimestamp())
+	require.NotEmpty(t, created.GetChanges())
+	require.Empty(t, created.GetChanges()[0].GetBefore())
+	require.NotEmpty(t, created.GetChanges()[0].GetAfter())
+	require.Equal(t, auditDelete, history.GetEntries()[1].GetAction())
+
+	_, err = server.GetFileHistory(ctx, &wirepb.GetFileHistoryRequest{Id: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.GetFileHistory(ctx, &wirepb.GetFileHistoryRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPC_auth(t *testing.T) {
+	a, err := newAPIKeyAuthenticator(writeTestFile(t, "keys", "acme-key acme jane\nglobex-key globex\n"))
+	require.NoError(t, err)
+
+	sink := newMemoryAuditSink()
+	client := testGRPCClient(t, newAuditWireFileRepository(newMemoryWireFileRepository(), sink), a)
+	withKey := func(key string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), "x-api-key", key, "x-request-id", "grpc-"+key)
+	}
+
+	_, err = client.ListFiles(context.Background(), &wirepb.ListFilesRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.ListFiles(withKey("other-key"), &wirepb.ListFilesRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	file, err := client.CreateFile(withKey("acme-key"), &wirepb.CreateFileRequest{
+		Source: &wirepb.CreateFileRequest_Contents{Contents: string(readTestdata(t, "fedWireMessage-CustomerTransfer.txt"))},
+	})
+	require.NoError(t, err)
+
+	// tenants are isolated as they are over REST
+	_, err = client.GetFile(withKey("globex-key"), &wirepb.GetFileRequest{Id: file.GetId()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	list, err := client.ListFiles(withKey("globex-key"), &wirepb.ListFilesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, list.GetFiles())
+
+	_, err = client.AddFEDWireMessage(withKey("acme-key"), &wirepb.AddFEDWireMessageRequest{
+		Id:             file.GetId(),
+		FedWireMessage: file.GetFedWireMessage(),
+	})
+	require.NoError(t, err)
+
+	entries, err := sink.history("acme", file.GetId())
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, auditCreate, entries[0].Action)
+	require.Equal(t, "jane", entries[0].Actor)
+	require.Equal(t, "grpc-acme-key", entries[0].RequestID)
+	require.Equal(t, auditAddMessage, entries[1].Action)
+}
+
+func TestGRPC_tracing(t *testing.T) {
+	exporter := mockTracing(t)
+	client := testGRPCClient(t, newMemoryWireFileRepository())
+
+	contents := strings.Replace(string(readTestdata(t, "fedWireMessage-CustomerTransfer.txt")), "{2000}000001234567", "{2000}00000123456X", 1)
+	_, err := client.CreateFile(context.Background(), &wirepb.CreateFileRequest{
+		Source: &wirepb.CreateFileRequest_Contents{Contents: contents},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	var found bool
+	for _, span := range exporter.GetSpans() {
+		if span.Name != "moov.wire.v1.WireService/CreateFile" {
+			continue
+		}
+		found = true
+		require.Equal(t, trace.SpanKindServer, span.SpanKind)
+		require.Equal(t, otelcodes.Error, span.Status.Code)
+		require.Equal(t, codes.InvalidArgument.String(), span.Status.Description)
+		require.Contains(t, span.Attributes, attribute.Int("rpc.grpc.status_code", int(codes.InvalidArgument)))
+	}
+	require.True(t, found)
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/go-kit/kit/metrics/prometheus"
+	"github.com/gorilla/mux"
 	moovhttp "github.com/moov-io/base/http"
 	"github.com/moov-io/base/log"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
@@ -22,7 +23,19 @@ var (
 	}, []string{"route"})
 )
 
+// routePath returns the path template of the route r matched, such as /files/{fileId}, so the IDs in paths don't
+// make a metric label or span name each. The path of r is returned when no route matched.
+func routePath(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if tmpl, err := route.GetPathTemplate(); err == nil {
+			return tmpl
+		}
+	}
+	return r.URL.Path
+}
+
+// wrapResponseWriter records the response duration of r by route, such as get-files-{fileId}.
 func wrapResponseWriter(logger log.Logger, w http.ResponseWriter, r *http.Request) http.ResponseWriter {
-	route := fmt.Sprintf("%s%s", strings.ToLower(r.Method), strings.Replace(r.URL.Path, "/", "-", -1)) // TODO: filter out random ID's later
+	route := fmt.Sprintf("%s%s", strings.ToLower(r.Method), strings.Replace(routePath(r), "/", "-", -1))
 	return moovhttp.Wrap(logger, routeHistogram.With("route", route), w, r)
 }
@@ -0,0 +1,225 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/wire"
+)
+
+const pacs008Namespace = "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"
+
+var (
+	errISO20022Unsupported = errors.New("ISO 20022 conversion supports customer transfers (CTR and CTP)")
+)
+
+// pacs008Document is a FIToFICustomerCreditTransfer (pacs.008) holding one transfer. It carries the tags a FAIM
+// customer transfer shares with ISO 20022 as a starting point for migrating historical messages, and is not
+// validated against the Fedwire ISO 20022 schemas; see github.com/moov-io/wire20022 for those.
+type pacs008Document struct {
+	XMLName  xml.Name        `xml:"Document"`
+	Xmlns    string          `xml:"xmlns,attr"`
+	Transfer pacs008Transfer `xml:"FIToFICstmrCdtTrf"`
+}
+
+type pacs008Transfer struct {
+	GroupHeader pacs008GroupHeader `xml:"GrpHdr"`
+	Transaction pacs008Transaction `xml:"CdtTrfTxInf"`
+}
+
+type pacs008GroupHeader struct {
+	MessageID      string `xml:"MsgId"`
+	CreatedAt      string `xml:"CreDtTm"`
+	NumberOfTxs    int    `xml:"NbOfTxs"`
+	SettlementInfo struct {
+		Method         string `xml:"SttlmMtd"`
+		ClearingSystem string `xml:"ClrSys>Cd"`
+	} `xml:"SttlmInf"`
+}
+
+type pacs008Transaction struct {
+	InstructionID    string          `xml:"PmtId>InstrId,omitempty"`
+	EndToEndID       string          `xml:"PmtId>EndToEndId"`
+	LocalInstrument  string          `xml:"PmtTpInf>LclInstrm>Prtry,omitempty"`
+	Amount           pacs008Amount   `xml:"IntrBkSttlmAmt"`
+	SettlementDate   string          `xml:"IntrBkSttlmDt,omitempty"`
+	ChargeBearer     string          `xml:"ChrgBr"`
+	InstructingAgent *pacs008Agent   `xml:"InstgAgt,omitempty"`
+	InstructedAgent  *pacs008Agent   `xml:"InstdAgt,omitempty"`
+	Debtor           pacs008Party    `xml:"Dbtr"`
+	DebtorAccount    *pacs008Account `xml:"DbtrAcct,omitempty"`
+	DebtorAgent      *pacs008Agent   `xml:"DbtrAgt,omitempty"`
+	CreditorAgent    *pacs008Agent   `xml:"CdtrAgt,omitempty"`
+	Creditor         pacs008Party    `xml:"Cdtr"`
+	CreditorAccount  *pacs008Account `xml:"CdtrAcct,omitempty"`
+	Remittance       []string        `xml:"RmtInf>Ustrd,omitempty"`
+}
+
+type pacs008Amount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type pacs008Party struct {
+	Name    string   `xml:"Nm,omitempty"`
+	Address []string `xml:"PstlAdr>AdrLine,omitempty"`
+}
+
+type pacs008Agent struct {
+	BIC      string         `xml:"FinInstnId>BICFI,omitempty"`
+	MemberID *pacs008Member `xml:"FinInstnId>ClrSysMmbId,omitempty"`
+	Name     string         `xml:"FinInstnId>Nm,omitempty"`
+	Address  []string       `xml:"FinInstnId>PstlAdr>AdrLine,omitempty"`
+}
+
+type pacs008Member struct {
+	ClearingSystem string `xml:"ClrSysId>Cd"`
+	ID             string `xml:"MmbId"`
+}
+
+type pacs008Account struct {
+	ID string `xml:"Id>Othr>Id"`
+}
+
+// writeISO20022 writes the customer transfer in file as a pacs.008 message
+func writeISO20022(w io.Writer, file *wire.File) error {
+	fwm := &file.FEDWireMessage
+	if fwm.BusinessFunctionCode == nil {
+		return errISO20022Unsupported
+	}
+	switch strings.TrimSpace(fwm.BusinessFunctionCode.BusinessFunctionCode) {
+	case wire.CustomerTransfer, wire.CustomerTransferPlus:
+	default:
+		return errISO20022Unsupported
+	}
+
+	doc := pacs008Document{Xmlns: pacs008Namespace}
+	hdr := &doc.Transfer.GroupHeader
+	hdr.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	hdr.NumberOfTxs = 1
+	hdr.SettlementInfo.Method = "CLRG"
+	hdr.SettlementInfo.ClearingSystem = "FDW"
+
+	tx := &doc.Transfer.Transaction
+	tx.EndToEndID = "NOTPROVIDED"
+	tx.ChargeBearer = "SHAR"
+	if imad := fwm.InputMessageAccountabilityData; imad != nil {
+		hdr.MessageID = imad.InputCycleDate + imad.InputSource + imad.InputSequenceNumber
+		if date, err := time.Parse("20060102", imad.InputCycleDate); err == nil {
+			tx.SettlementDate = date.Format("2006-01-02")
+		}
+	}
+	if hdr.MessageID == "" {
+		hdr.MessageID = file.ID
+	}
+	if fwm.SenderReference != nil {
+		tx.InstructionID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.BeneficiaryReference != nil && strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference) != "" {
+		tx.EndToEndID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	if fwm.LocalInstrument != nil {
+		tx.LocalInstrument = fwm.LocalInstrument.LocalInstrumentCode
+	}
+	if fwm.Charges != nil && fwm.Charges.ChargeDetails == wire.CDBeneficiary {
+		tx.ChargeBearer = "CRED"
+	}
+
+	tx.Amount.Currency = "USD"
+	if fwm.Amount != nil {
+		cents, err := strconv.ParseInt(strings.TrimSpace(fwm.Amount.Amount), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid amount %q", fwm.Amount.Amount)
+		}
+		tx.Amount.Value = fmt.Sprintf("%d.%02d", cents/100, cents%100)
+	}
+
+	if fwm.SenderDepositoryInstitution != nil {
+		tx.InstructingAgent = &pacs008Agent{
+			MemberID: &pacs008Member{ClearingSystem: "USABA", ID: fwm.SenderDepositoryInstitution.SenderABANumber},
+		}
+	}
+	if fwm.ReceiverDepositoryInstitution != nil {
+		tx.InstructedAgent = &pacs008Agent{
+			MemberID: &pacs008Member{ClearingSystem: "USABA", ID: fwm.ReceiverDepositoryInstitution.ReceiverABANumber},
+		}
+	}
+	if fwm.Originator != nil {
+		tx.Debtor, tx.DebtorAccount = pacs008PartyOf(fwm.Originator.Personal)
+	}
+	if fwm.OriginatorFI != nil {
+		tx.DebtorAgent = pacs008AgentOf(fwm.OriginatorFI.FinancialInstitution)
+	}
+	if fwm.BeneficiaryFI != nil {
+		tx.CreditorAgent = pacs008AgentOf(fwm.BeneficiaryFI.FinancialInstitution)
+	}
+	if fwm.Beneficiary != nil {
+		tx.Creditor, tx.CreditorAccount = pacs008PartyOf(fwm.Beneficiary.Personal)
+	}
+	if obi := fwm.OriginatorToBeneficiary; obi != nil {
+		tx.Remittance = addressLines(obi.LineOne, obi.LineTwo, obi.LineThree, obi.LineFour)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// pacs008PartyOf returns the party and, for identifiers which are account numbers, the account of p
+func pacs008PartyOf(p wire.Personal) (pacs008Party, *pacs008Account) {
+	party := pacs008Party{
+		Name:    strings.TrimSpace(p.Name),
+		Address: addressLines(p.Address.AddressLineOne, p.Address.AddressLineTwo, p.Address.AddressLineThree),
+	}
+	switch p.IdentificationCode {
+	case wire.DemandDepositAccountNumber, wire.SWIFTBICORBEIANDAccountNumber, wire.CHIPSIdentifier:
+		if id := strings.TrimSpace(p.Identifier); id != "" {
+			return party, &pacs008Account{ID: id}
+		}
+	}
+	return party, nil
+}
+
+func pacs008AgentOf(fi wire.FinancialInstitution) *pacs008Agent {
+	agent := &pacs008Agent{
+		Name:    strings.TrimSpace(fi.Name),
+		Address: addressLines(fi.Address.AddressLineOne, fi.Address.AddressLineTwo, fi.Address.AddressLineThree),
+	}
+	id := strings.TrimSpace(fi.Identifier)
+	switch fi.IdentificationCode {
+	case wire.SWIFTBankIdentifierCode:
+		agent.BIC = id
+	case wire.FEDRoutingNumber:
+		agent.MemberID = &pacs008Member{ClearingSystem: "USABA", ID: id}
+	case wire.CHIPSParticipant:
+		agent.MemberID = &pacs008Member{ClearingSystem: "USPID", ID: id}
+	}
+	return agent
+}
+
+// addressLines returns the non-empty lines, trimmed
+func addressLines(lines ...string) []string {
+	var out []string
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			out = append(out, line)
+		}
+	}
+	return out
+}
@@ -0,0 +1,258 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// jsonPatchOperation is an operation of a JSON Patch (RFC 6902)
+type jsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// applyJSONPatch applies the JSON Patch (RFC 6902) patch to the JSON document doc
+func applyJSONPatch(doc, patch []byte) ([]byte, error) {
+	var ops []jsonPatchOperation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, fmt.Errorf("invalid JSON patch: %v", err)
+	}
+	var root interface{}
+	if err := json.Unmarshal(doc, &root); err != nil {
+		return nil, err
+	}
+
+	for i, op := range ops {
+		var err error
+		switch op.Op {
+		case "add", "replace", "test":
+			var value interface{}
+			if len(op.Value) == 0 {
+				return nil, fmt.Errorf("operation %d: %s requires a value", i, op.Op)
+			}
+			if err := json.Unmarshal(op.Value, &value); err != nil {
+				return nil, fmt.Errorf("operation %d: %v", i, err)
+			}
+			switch op.Op {
+			case "add":
+				root, err = jsonPointerSet(root, op.Path, value, true)
+			case "replace":
+				root, err = jsonPointerSet(root, op.Path, value, false)
+			case "test":
+				var current interface{}
+				current, err = jsonPointerGet(root, op.Path)
+				if err == nil && !reflect.DeepEqual(current, value) {
+					err = fmt.Errorf("test of %s failed", op.Path)
+				}
+			}
+		case "remove":
+			root, _, err = jsonPointerRemove(root, op.Path)
+		case "move":
+			var value interface{}
+			if root, value, err = jsonPointerRemove(root, op.From); err == nil {
+				root, err = jsonPointerSet(root, op.Path, value, true)
+			}
+		case "copy":
+			var value interface{}
+			if value, err = jsonPointerGet(root, op.From); err == nil {
+				root, err = jsonPointerSet(root, op.Path, deepCopyJSON(value), true)
+			}
+		default:
+			err = fmt.Errorf("unknown op %q", op.Op)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %v", i, err)
+		}
+	}
+	return json.Marshal(root)
+}
+
+// applyMergePatch applies the JSON Merge Patch (RFC 7396) patch to the JSON document doc
+func applyMergePatch(doc, patch []byte) ([]byte, error) {
+	var target, p interface{}
+	if err := json.Unmarshal(doc, &target); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, fmt.Errorf("invalid merge patch: %v", err)
+	}
+	return json.Marshal(mergePatch(target, p))
+}
+
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = make(map[string]interface{})
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+		} else {
+			t[k] = mergePatch(t[k], v)
+		}
+	}
+	return t
+}
+
+// splitJSONPointer returns the unescaped reference tokens of a JSON Pointer (RFC 6901)
+func splitJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(tokens[i], "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func jsonPointerGet(root interface{}, pointer string) (interface{}, error) {
+	tokens, err := splitJSONPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	current := root
+	for _, token := range tokens {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			v, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("%s does not exist", pointer)
+			}
+			current = v
+		case []interface{}:
+			idx, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			current = node[idx]
+		default:
+			return nil, fmt.Errorf("%s does not exist", pointer)
+		}
+	}
+	return current, nil
+}
+
+// jsonPointerSet sets the value at pointer. Members and array elements are inserted when add is true and must
+// already exist otherwise.
+func jsonPointerSet(root interface{}, pointer string, value interface{}, add bool) (interface{}, error) {
+	tokens, err := splitJSONPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	parent, err := jsonPointerGet(root, joinJSONPointer(tokens[:len(tokens)-1]))
+	if err != nil {
+		return nil, err
+	}
+	last := tokens[len(tokens)-1]
+	switch node := parent.(type) {
+	case map[string]interface{}:
+		if _, ok := node[last]; !ok && !add {
+			return nil, fmt.Errorf("%s does not exist", pointer)
+		}
+		node[last] = value
+		return root, nil
+	case []interface{}:
+		var updated []interface{}
+		if add {
+			idx := len(node)
+			if last != "-" {
+				if idx, err = arrayIndex(last, len(node)); err != nil {
+					return nil, err
+				}
+			}
+			updated = append(append(append([]interface{}{}, node[:idx]...), value), node[idx:]...)
+		} else {
+			idx, err := arrayIndex(last, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			node[idx] = value
+			return root, nil
+		}
+		return jsonPointerSet(root, joinJSONPointer(tokens[:len(tokens)-1]), updated, false)
+	}
+	return nil, fmt.Errorf("%s does not exist", pointer)
+}
+
+// jsonPointerRemove removes and returns the value at pointer
+func jsonPointerRemove(root interface{}, pointer string) (interface{}, interface{}, error) {
+	tokens, err := splitJSONPointer(pointer)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, root, nil
+	}
+	value, err := jsonPointerGet(root, pointer)
+	if err != nil {
+		return nil, nil, err
+	}
+	parentPointer := joinJSONPointer(tokens[:len(tokens)-1])
+	parent, _ := jsonPointerGet(root, parentPointer)
+	last := tokens[len(tokens)-1]
+	switch node := parent.(type) {
+	case map[string]interface{}:
+		delete(node, last)
+		return root, value, nil
+	case []interface{}:
+		idx, _ := arrayIndex(last, len(node)-1)
+		updated := append(append([]interface{}{}, node[:idx]...), node[idx+1:]...)
+		root, err = jsonPointerSet(root, parentPointer, updated, false)
+		return root, value, err
+	}
+	return nil, nil, fmt.Errorf("%s does not exist", pointer)
+}
+
+func joinJSONPointer(tokens []string) string {
+	var buf strings.Builder
+	for _, token := range tokens {
+		buf.WriteString("/" + strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+	return buf.String()
+}
+
+func arrayIndex(token string, max int) (int, error) {
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 || idx > max || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	return idx, nil
+}
+
+func deepCopyJSON(v interface{}) interface{} {
+	switch node := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(node))
+		for k, v := range node {
+			out[k] = deepCopyJSON(v)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(node))
+		for i, v := range node {
+			out[i] = deepCopyJSON(v)
+		}
+		return out
+	}
+	return v
+}
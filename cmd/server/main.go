@@ -9,6 +9,8 @@ import (
 	"crypto/tls"
 	"flag"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -28,8 +30,12 @@ import (
 var (
 	httpAddr  = flag.String("http.addr", bind.HTTP("wire"), "HTTP listen address")
 	adminAddr = flag.String("admin.addr", bind.Admin("wire"), "Admin HTTP listen address")
+	grpcAddr  = flag.String("grpc.addr", ":9088", "gRPC listen address, the gRPC server is disabled when empty")
 
 	flagLogFormat = flag.String("log.format", "", "Format for log lines (Options: json, plain")
+
+	flagStorage    = flag.String("storage", os.Getenv("WIRE_STORAGE"), "Where files are stored (Options: memory, filesystem, sqlite, postgres)")
+	flagStorageDSN = flag.String("storage.dsn", os.Getenv("WIRE_STORAGE_DSN"), "Directory, SQLite database file or Postgres connection string of storage")
 )
 
 func main() {
@@ -75,15 +81,101 @@ func main() {
 	}()
 	defer adminServer.Shutdown()
 
-	repo := &memoryWireFileRepository{
-		files: make(map[string]*wire.File),
+	var cipher *fileCipher
+	if path := os.Getenv("WIRE_ENCRYPTION_KEY_FILE"); path != "" {
+		if cipher, err = newFileCipher(path); err != nil {
+			logger.LogErrorf("problem reading encryption keys: %v", err)
+			return
+		}
+		logger.Logf("encrypting stored files with key %s", cipher.primary)
+	}
+	repo, err := newWireFileRepository(logger, *flagStorage, *flagStorageDSN, cipher)
+	if err != nil {
+		logger.LogErrorf("problem setting up storage: %v", err)
+		return
+	}
+	if closer, ok := repo.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	audit, err := newAuditSinkFromEnv(logger, os.Getenv, repo)
+	if err != nil {
+		logger.LogErrorf("problem setting up audit trail: %v", err)
+		return
+	}
+	if closer, ok := audit.(io.Closer); ok {
+		defer closer.Close()
 	}
+	audit = newSealedAuditSink(audit, cipher)
+
+	approvals, err := newApprovalWorkflowFromEnv(logger, os.Getenv, repo, audit)
+	if err != nil {
+		logger.LogErrorf("problem setting up approvals: %v", err)
+		return
+	}
+
+	webhooks, err := newWebhookDispatcherFromEnv(logger, os.Getenv)
+	if err != nil {
+		logger.LogErrorf("problem setting up webhooks: %v", err)
+		return
+	}
+	defer webhooks.Close()
+	repo = newWebhookWireFileRepository(repo, webhooks)
+	if approvals != nil {
+		repo = newApprovalWireFileRepository(repo, approvals)
+	}
+	// the audit repository is outermost so handlers can bind it to their request
+	repo = newAuditWireFileRepository(repo, audit)
+
+	authConfig, err := readAuthConfig(os.Getenv)
+	if err != nil {
+		logger.LogErrorf("invalid authentication config: %v", err)
+		return
+	}
+	if approvals != nil && !authConfig.enabled() {
+		logger.LogErrorf("WIRE_APPROVAL_THRESHOLDS requires authentication to tell approvers from submitters")
+		return
+	}
+
+	shutdownTracing, err := setupTracing(logger, os.Getenv)
+	if err != nil {
+		logger.LogErrorf("problem setting up tracing: %v", err)
+		return
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.LogErrorf("problem flushing traces: %v", err)
+		}
+	}()
 
 	// Setup business HTTP routes
 	router := mux.NewRouter()
 	moovhttp.AddCORSHandler(router)
 	addPingRoute(router)
-	addFileRoutes(logger, router, repo)
+	router.Use(tracingMiddleware)
+	if authConfig.enabled() {
+		logger.Logf("requiring authentication with %d method(s)", len(authConfig.authenticators))
+		router.Use(authMiddleware(logger, authConfig.authenticators))
+	} else {
+		logger.Log("authentication is disabled, all callers share one tenant")
+	}
+	var dedupe *wire.DuplicateDetector
+	if v := os.Getenv("WIRE_DUPLICATE_WINDOW"); v != "" {
+		window, err := time.ParseDuration(v)
+		if err != nil {
+			logger.LogErrorf("invalid WIRE_DUPLICATE_WINDOW: %v", err)
+			return
+		}
+		if window > 0 {
+			logger.Logf("rejecting duplicate messages within %v", window)
+			dedupe = wire.NewDuplicateDetector(window)
+		}
+	}
+	addFileRoutes(logger, router, repo, dedupe, approvals)
+	addApprovalRoutes(logger, router, repo, approvals)
+	addStatelessRoutes(logger, router, approvals)
+	addWebhookRoutes(logger, router, webhooks)
+	addAuditRoutes(logger, router, audit)
 
 	// Start business HTTP server
 	readTimeout, _ := time.ParseDuration("30s")
@@ -103,6 +195,7 @@ func main() {
 		WriteTimeout:      writTimeout,
 		IdleTimeout:       idleTimeout,
 	}
+	authConfig.configureTLS(serve.TLSConfig)
 	shutdownServer := func() {
 		if err := serve.Shutdown(context.TODO()); err != nil {
 			logger.LogErrorf("shutdown error: %v", err)
@@ -132,6 +225,33 @@ func main() {
 		}
 	}()
 
+	// Start business gRPC server
+	if *grpcAddr != "" {
+		var tlsConfig *tls.Config
+		if certFile, keyFile := os.Getenv("HTTPS_CERT_FILE"), os.Getenv("HTTPS_KEY_FILE"); certFile != "" && keyFile != "" {
+			cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+			if err != nil {
+				logger.LogErrorf("problem reading TLS certificate: %v", err)
+				return
+			}
+			tlsConfig = serve.TLSConfig.Clone()
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+		grpcServer := newGRPCServer(logger, repo, dedupe, approvals, audit, authConfig.authenticators, tlsConfig)
+		listener, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			logger.LogErrorf("problem binding gRPC server: %v", err)
+			return
+		}
+		go func() {
+			logger.Logf("binding to %s for gRPC server", *grpcAddr)
+			if err := grpcServer.Serve(listener); err != nil {
+				errs <- logger.LogErrorf("grpc server error: %v", err).Err()
+			}
+		}()
+		defer grpcServer.GracefulStop()
+	}
+
 	// Block/Wait for an error
 	if err := <-errs; err != nil {
 		logger.LogError(err)
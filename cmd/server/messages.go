@@ -0,0 +1,332 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gorilla/mux"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+var (
+	errNoTag = errors.New("no tag found")
+)
+
+// addMessageRoutes registers the endpoints which read and edit the FEDWireMessage of a file and its tags. Edits
+// are saved even when they leave the message invalid, so a message can be repaired one tag at a time, and each
+// response carries the validation errors of the result.
+func addMessageRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository) {
+	r.Methods("GET").Path("/files/{fileId}/FEDWireMessage").HandlerFunc(getMessage(logger, repo))
+	r.Methods("PUT").Path("/files/{fileId}/FEDWireMessage").HandlerFunc(replaceMessage(logger, repo))
+	r.Methods("GET").Path("/files/{fileId}/FEDWireMessage/{tag}").HandlerFunc(getMessageTag(logger, repo))
+	r.Methods("PUT").Path("/files/{fileId}/FEDWireMessage/{tag}").HandlerFunc(replaceMessageTag(logger, repo))
+	r.Methods("PATCH").Path("/files/{fileId}/FEDWireMessage/{tag}").HandlerFunc(patchMessageTag(logger, repo))
+	r.Methods("DELETE").Path("/files/{fileId}/FEDWireMessage/{tag}").HandlerFunc(deleteMessageTag(logger, repo))
+}
+
+// messageTags maps the lower cased JSON name of each FEDWireMessage tag, such as "beneficiary", to its field index
+var messageTags = func() map[string]int {
+	tags := make(map[string]int)
+	typ := reflect.TypeOf(wire.FEDWireMessage{})
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		// only the tags, which are pointers, are edited: not the ID nor the validation options of the message
+		if !field.IsExported() || field.Type.Kind() != reflect.Ptr || name == "" || name == "-" || field.Type == reflect.TypeOf(&wire.ValidateOpts{}) {
+			continue
+		}
+		tags[strings.ToLower(name)] = i
+	}
+	return tags
+}()
+
+// validationError is a structured validation error. Scope is "tag" for errors of the edited tag on its own and
+// "message" for errors of the whole message.
+type validationError struct {
+	Scope   string      `json:"scope"`
+	Field   string      `json:"field,omitempty"`
+	Value   interface{} `json:"value,omitempty"`
+	Message string      `json:"message"`
+}
+
+// messageResponse is returned by the message endpoints
+type messageResponse struct {
+	FEDWireMessage *wire.FEDWireMessage `json:"fedWireMessage,omitempty"`
+	Tag            string               `json:"tag,omitempty"`
+	Value          interface{}          `json:"value,omitempty"`
+	Valid          bool                 `json:"valid"`
+	Errors         []validationError    `json:"errors,omitempty"`
+}
+
+func newValidationError(scope string, err error) validationError {
+	verr := validationError{Scope: scope, Message: err.Error()}
+	var fe *wire.FieldError
+	if errors.As(err, &fe) {
+		verr.Field = fe.FieldName
+		verr.Value = fe.Value
+		if fe.Err != nil {
+			verr.Message = fe.Err.Error()
+		}
+	}
+	return verr
+}
+
+// validateMessage returns the errors of tag, when it is non-nil, and of the message in file
+func validateMessage(file *wire.File, tag interface{}) []validationError {
+	var out []validationError
+	if v, ok := tag.(interface{ Validate() error }); ok && !reflect.ValueOf(tag).IsNil() {
+		if err := v.Validate(); err != nil {
+			out = append(out, newValidationError("tag", err))
+		}
+	}
+	if err := file.Validate(); err != nil {
+		verr := newValidationError("message", err)
+		if len(out) == 0 || out[0].Message != verr.Message || out[0].Field != verr.Field {
+			out = append(out, verr)
+		}
+	}
+	return out
+}
+
+// loadFile returns the file of the request, writing the response and returning nil when it can't be found
+func loadFile(logger log.Logger, repo WireFileRepository, w http.ResponseWriter, r *http.Request) (log.Logger, *wire.File) {
+	fileId := getFileId(w, r)
+	if fileId == "" {
+		logger.LogError(errNoFileId)
+		return logger, nil
+	}
+	logger = logger.Set("fileID", log.String(fileId))
+
+	file, err := repo.GetFile(fileId)
+	if err != nil {
+		err = logger.LogErrorf("error retrieving file: %v", err).Err()
+		moovhttp.Problem(w, err)
+		return logger, nil
+	}
+	if file == nil {
+		logger.Log("file not found")
+		http.NotFound(w, r)
+		return logger, nil
+	}
+	return logger, file
+}
+
+// messageTag returns the field of the tag in the request path
+func messageTag(w http.ResponseWriter, r *http.Request, file *wire.File) (string, reflect.Value) {
+	name, ok := mux.Vars(r)["tag"]
+	if !ok || name == "" {
+		moovhttp.Problem(w, errNoTag)
+		return "", reflect.Value{}
+	}
+	idx, ok := messageTags[strings.ToLower(name)]
+	if !ok {
+		moovhttp.Problem(w, fmt.Errorf("unknown tag %q", name))
+		return "", reflect.Value{}
+	}
+	return name, reflect.ValueOf(&file.FEDWireMessage).Elem().Field(idx)
+}
+
+func writeMessageResponse(w http.ResponseWriter, resp messageResponse) {
+	resp.Valid = len(resp.Errors) == 0
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+func getMessage(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
+
+		logger, file := loadFile(logger, repo, w, r)
+		if file == nil {
+			return
+		}
+
+		errs := validateMessage(file, nil)
+		if maskedRequest(r) {
+			file, errs = maskFile(file), maskValidationErrors(errs)
+		}
+		logger.Log("rendering FEDWireMessage")
+		writeMessageResponse(w, messageResponse{
+			FEDWireMessage: &file.FEDWireMessage,
+			Errors:         errs,
+		})
+	}
+}
+
+func replaceMessage(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
+
+		logger, file := loadFile(logger, repo, w, r)
+		if file == nil {
+			return
+		}
+
+		var req wire.FEDWireMessage
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			err = logger.LogErrorf("error reading request body: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		file.AddFEDWireMessage(req)
+		if err := repo.SaveFile(file); err != nil {
+			err = logger.LogErrorf("error saving file: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		logger.Log("replaced FEDWireMessage")
+		writeMessageResponse(w, messageResponse{
+			FEDWireMessage: &file.FEDWireMessage,
+			Errors:         validateMessage(file, nil),
+		})
+	}
+}
+
+func getMessageTag(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
+
+		logger, file := loadFile(logger, repo, w, r)
+		if file == nil {
+			return
+		}
+		name, field := messageTag(w, r, file)
+		if name == "" {
+			return
+		}
+		if field.IsNil() {
+			logger.Logf("%s not found", name)
+			http.NotFound(w, r)
+			return
+		}
+
+		errs := validateMessage(file, field.Interface())
+		if maskedRequest(r) {
+			_, field = messageTag(w, r, maskFile(file))
+			errs = maskValidationErrors(errs)
+		}
+		writeMessageResponse(w, messageResponse{
+			Tag:    name,
+			Value:  field.Interface(),
+			Errors: errs,
+		})
+	}
+}
+
+func replaceMessageTag(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+	return editMessageTag(logger, repo, "replaced", func(_ *http.Request, _, body []byte) ([]byte, error) {
+		return body, nil
+	})
+}
+
+// patchMessageTag applies a JSON Patch (RFC 6902) when the Content-Type is application/json-patch+json and a
+// JSON Merge Patch (RFC 7396) otherwise
+func patchMessageTag(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+	return editMessageTag(logger, repo, "patched", func(r *http.Request, current, body []byte) ([]byte, error) {
+		if strings.Contains(r.Header.Get("Content-Type"), "application/json-patch+json") {
+			return applyJSONPatch(current, body)
+		}
+		return applyMergePatch(current, body)
+	})
+}
+
+func deleteMessageTag(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+	return editMessageTag(logger, repo, "deleted", func(_ *http.Request, _, _ []byte) ([]byte, error) {
+		return []byte("null"), nil
+	})
+}
+
+// editMessageTag returns a handler which replaces a tag with the JSON edit returns, given the current JSON of the
+// tag and the request body. A null result removes the tag.
+func editMessageTag(logger log.Logger, repo WireFileRepository, action string, edit func(r *http.Request, current, body []byte) ([]byte, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+		repo := tenantRepository(repo, r)
+
+		logger, file := loadFile(logger, repo, w, r)
+		if file == nil {
+			return
+		}
+		name, field := messageTag(w, r, file)
+		if name == "" {
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			err = logger.LogErrorf("error reading request body: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		current := []byte("{}")
+		if !field.IsNil() {
+			if current, err = json.Marshal(field.Interface()); err != nil {
+				err = logger.LogErrorf("error encoding %s: %v", name, err).Err()
+				moovhttp.Problem(w, err)
+				return
+			}
+		}
+		updated, err := edit(r, current, body)
+		if err != nil {
+			err = logger.LogErrorf("error editing %s: %v", name, err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		value := reflect.Zero(field.Type())
+		if strings.TrimSpace(string(updated)) != "null" {
+			value = reflect.New(field.Type().Elem())
+			if err := json.Unmarshal(updated, value.Interface()); err != nil {
+				err = logger.LogErrorf("error reading %s: %v", name, err).Err()
+				moovhttp.Problem(w, err)
+				return
+			}
+		}
+		field.Set(value)
+
+		if err := repo.SaveFile(file); err != nil {
+			err = logger.LogErrorf("error saving file: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		logger.Logf("%s %s", action, name)
+		resp := messageResponse{Tag: name, Errors: validateMessage(file, value.Interface())}
+		if !value.IsNil() {
+			resp.Value = value.Interface()
+		}
+		writeMessageResponse(w, resp)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/stretchr/testify/require"
+)
+
+type testMessageResponse struct {
+	Tag    string            `json:"tag"`
+	Value  json.RawMessage   `json:"value"`
+	Valid  bool              `json:"valid"`
+	Errors []validationError `json:"errors"`
+}
+
+func setupMessageRoutes(t *testing.T) (*mux.Router, *memoryWireFileRepository, string) {
+	t.Helper()
+
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.ID = "foo"
+	repo := newMemoryWireFileRepository()
+	require.NoError(t, repo.SaveFile(f))
+
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+	return router, repo, f.ID
+}
+
+func serveMessageRequest(t *testing.T, router *mux.Router, method, path, contentType, body string) (int, testMessageResponse) {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	w.Flush()
+
+	var resp testMessageResponse
+	if w.Code == http.StatusOK {
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	}
+	return w.Code, resp
+}
+
+func TestMessages_getMessage(t *testing.T) {
+	router, _, _ := setupMessageRoutes(t)
+
+	req := httptest.NewRequest("GET", "/files/foo/FEDWireMessage", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Contains(t, w.Body.String(), `"fedWireMessage":{`)
+	require.Contains(t, w.Body.String(), `"valid":true`)
+
+	code, _ := serveMessageRequest(t, router, "GET", "/files/missing/FEDWireMessage", "", "")
+	require.Equal(t, http.StatusNotFound, code)
+}
+
+func TestMessages_replaceMessage(t *testing.T) {
+	router, repo, id := setupMessageRoutes(t)
+
+	code, resp := serveMessageRequest(t, router, "PUT", "/files/foo/FEDWireMessage", "application/json", `{"amount":{"amount":"000000000100"}}`)
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, resp.Valid)
+	require.Equal(t, "message", resp.Errors[0].Scope)
+
+	file, err := repo.GetFile(id)
+	require.NoError(t, err)
+	require.Equal(t, "000000000100", file.FEDWireMessage.Amount.Amount)
+	require.Nil(t, file.FEDWireMessage.Beneficiary)
+
+	code, _ = serveMessageRequest(t, router, "PUT", "/files/foo/FEDWireMessage", "application/json", `{`)
+	require.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestMessages_getMessageTag(t *testing.T) {
+	router, _, _ := setupMessageRoutes(t)
+
+	code, resp := serveMessageRequest(t, router, "GET", "/files/foo/FEDWireMessage/beneficiary", "", "")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "beneficiary", resp.Tag)
+	require.True(t, resp.Valid)
+	require.Contains(t, string(resp.Value), `"name":"Name"`)
+
+	// tag names are case insensitive
+	code, _ = serveMessageRequest(t, router, "GET", "/files/foo/FEDWireMessage/SenderReference", "", "")
+	require.Equal(t, http.StatusOK, code)
+
+	code, _ = serveMessageRequest(t, router, "GET", "/files/foo/FEDWireMessage/serviceMessage", "", "")
+	require.Equal(t, http.StatusNotFound, code)
+
+	code, _ = serveMessageRequest(t, router, "GET", "/files/foo/FEDWireMessage/validateOptions", "", "")
+	require.Equal(t, http.StatusBadRequest, code)
+	code, _ = serveMessageRequest(t, router, "GET", "/files/foo/FEDWireMessage/unknown", "", "")
+	require.Equal(t, http.StatusBadRequest, code)
+
+	// the ID of the message isn't a tag
+	for _, method := range []string{"GET", "PUT", "PATCH", "DELETE"} {
+		code, _ = serveMessageRequest(t, router, method, "/files/foo/FEDWireMessage/id", "application/json", `"other"`)
+		require.Equal(t, http.StatusBadRequest, code, method)
+	}
+}
+
+func TestMessages_replaceMessageTag(t *testing.T) {
+	router, repo, id := setupMessageRoutes(t)
+
+	code, resp := serveMessageRequest(t, router, "PUT", "/files/foo/FEDWireMessage/amount", "application/json", `{"amount":"00000012345A"}`)
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, resp.Valid)
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, validationError{Scope: "tag", Field: "Amount", Value: "00000012345A", Message: "is an incorrect amount format"}, resp.Errors[0])
+
+	code, resp = serveMessageRequest(t, router, "PUT", "/files/foo/FEDWireMessage/amount", "application/json", `{"amount":"000000012345"}`)
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, resp.Valid, resp.Errors)
+
+	file, err := repo.GetFile(id)
+	require.NoError(t, err)
+	require.Equal(t, "{2000}000000012345", file.FEDWireMessage.Amount.String())
+}
+
+func TestMessages_patchMessageTag(t *testing.T) {
+	router, repo, id := setupMessageRoutes(t)
+
+	// JSON Merge Patch
+	code, resp := serveMessageRequest(t, router, "PATCH", "/files/foo/FEDWireMessage/beneficiary", "application/merge-patch+json", `{"personal":{"name":"Jane Doe"}}`)
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, resp.Valid, resp.Errors)
+	file, err := repo.GetFile(id)
+	require.NoError(t, err)
+	require.Equal(t, "Jane Doe", file.FEDWireMessage.Beneficiary.Personal.Name)
+	require.Equal(t, "Address One", file.FEDWireMessage.Beneficiary.Personal.Address.AddressLineOne)
+
+	// JSON Patch
+	patch := `[{"op":"test","path":"/personal/name","value":"Jane Doe"},{"op":"replace","path":"/personal/identificationCode","value":"Z"}]`
+	code, resp = serveMessageRequest(t, router, "PATCH", "/files/foo/FEDWireMessage/beneficiary", "application/json-patch+json", patch)
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, resp.Valid)
+	require.Equal(t, "tag", resp.Errors[0].Scope)
+	require.Equal(t, "IdentificationCode", resp.Errors[0].Field)
+
+	// failed test operations leave the tag unchanged
+	patch = `[{"op":"test","path":"/personal/name","value":"John Doe"},{"op":"remove","path":"/personal/name"}]`
+	code, _ = serveMessageRequest(t, router, "PATCH", "/files/foo/FEDWireMessage/beneficiary", "application/json-patch+json", patch)
+	require.Equal(t, http.StatusBadRequest, code)
+	file, err = repo.GetFile(id)
+	require.NoError(t, err)
+	require.Equal(t, "Jane Doe", file.FEDWireMessage.Beneficiary.Personal.Name)
+
+	// patching a missing tag adds it
+	code, resp = serveMessageRequest(t, router, "PATCH", "/files/foo/FEDWireMessage/localInstrument", "application/merge-patch+json", `{"LocalInstrumentCode":"ANSI"}`)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "localInstrument", resp.Tag)
+	file, err = repo.GetFile(id)
+	require.NoError(t, err)
+	require.NotNil(t, file.FEDWireMessage.LocalInstrument)
+}
+
+func TestMessages_deleteMessageTag(t *testing.T) {
+	router, repo, id := setupMessageRoutes(t)
+
+	code, resp := serveMessageRequest(t, router, "DELETE", "/files/foo/FEDWireMessage/amount", "", "")
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, resp.Valid)
+	require.Equal(t, validationError{Scope: "message", Field: "Amount", Message: "is a required field"}, resp.Errors[0])
+
+	file, err := repo.GetFile(id)
+	require.NoError(t, err)
+	require.Nil(t, file.FEDWireMessage.Amount)
+}
+
+func TestJSONPatch(t *testing.T) {
+	doc := `{"a":{"b":[1,2,3]},"c":"d"}`
+	tests := []struct {
+		patch, want string
+	}{
+		{`[{"op":"add","path":"/a/b/1","value":9}]`, `{"a":{"b":[1,9,2,3]},"c":"d"}`},
+		{`[{"op":"add","path":"/a/b/-","value":4}]`, `{"a":{"b":[1,2,3,4]},"c":"d"}`},
+		{`[{"op":"remove","path":"/a/b/0"}]`, `{"a":{"b":[2,3]},"c":"d"}`},
+		{`[{"op":"replace","path":"/c","value":"e"}]`, `{"a":{"b":[1,2,3]},"c":"e"}`},
+		{`[{"op":"move","from":"/c","path":"/e"}]`, `{"a":{"b":[1,2,3]},"e":"d"}`},
+		{`[{"op":"copy","from":"/a/b","path":"/f"}]`, `{"a":{"b":[1,2,3]},"c":"d","f":[1,2,3]}`},
+		{`[{"op":"add","path":"/g~1h","value":true}]`, `{"a":{"b":[1,2,3]},"c":"d","g/h":true}`},
+	}
+	for _, tc := range tests {
+		got, err := applyJSONPatch([]byte(doc), []byte(tc.patch))
+		require.NoError(t, err, tc.patch)
+		require.JSONEq(t, tc.want, string(got), tc.patch)
+	}
+
+	for _, patch := range []string{
+		`[{"op":"replace","path":"/x","value":1}]`,
+		`[{"op":"remove","path":"/a/b/5"}]`,
+		`[{"op":"test","path":"/c","value":"x"}]`,
+		`[{"op":"unknown","path":"/c"}]`,
+		`[{"op":"add","path":"c","value":1}]`,
+		`{}`,
+	} {
+		_, err := applyJSONPatch([]byte(doc), []byte(patch))
+		require.Error(t, err, patch)
+	}
+
+	got, err := applyMergePatch([]byte(doc), []byte(`{"a":{"b":null,"x":1},"c":null}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"a":{"x":1}}`, string(got))
+}
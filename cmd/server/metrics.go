@@ -0,0 +1,140 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/go-kit/kit/metrics/prometheus"
+	"github.com/moov-io/base"
+	"github.com/moov-io/wire"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// The business metrics are registered with the default Prometheus registry, which the admin server exposes on
+// GET /metrics. Business function codes are labeled as other when they aren't one of the Fedwire codes, so labels
+// stay bounded whatever clients send.
+var (
+	parseErrors = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Name: "wire_parse_errors",
+		Help: "The number of tags of FED files which failed to parse, by tag",
+	}, []string{"tag"})
+
+	validationErrors = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Name: "wire_validation_errors",
+		Help: "The number of messages which failed validation, by business function code and error type",
+	}, []string{"business_function_code", "error_type"})
+
+	amountProcessed = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Name: "wire_amount_processed_cents",
+		Help: "The total Amount of the messages of created files in cents, by business function code",
+	}, []string{"business_function_code"})
+
+	messageAmounts = prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+		Name:    "wire_message_amount_dollars",
+		Help:    "Histogram of the Amount of the messages of created files in dollars, by business function code",
+		Buckets: stdprometheus.ExponentialBuckets(100, 10, 7),
+	}, []string{"business_function_code"})
+)
+
+// metricBusinessFunctionCode returns the business function code of fwm as a metric label
+func metricBusinessFunctionCode(fwm *wire.FEDWireMessage) string {
+	if fwm == nil || fwm.BusinessFunctionCode == nil {
+		return "none"
+	}
+	code := strings.TrimSpace(fwm.BusinessFunctionCode.BusinessFunctionCode)
+	if wire.PermittedTags(code) == nil {
+		return "other"
+	}
+	return code
+}
+
+// validationErrorType names the kind of a validation error
+func validationErrorType(err error) string {
+	var fieldErr *wire.FieldError
+	var tagLength wire.TagWrongLengthErr
+	var fieldLength wire.FieldWrongLengthErr
+	var bfcProperty wire.ErrBusinessFunctionCodeProperty
+	var propertyForProperty wire.ErrInvalidPropertyForProperty
+	var invalidTag wire.ErrInvalidTag
+
+	switch {
+	case errors.Is(err, wire.ErrFieldRequired), errors.Is(err, wire.ErrFieldInclusion), errors.Is(err, wire.ErrConstructor):
+		return "required"
+	case errors.Is(err, wire.ErrNotPermitted), errors.As(err, &bfcProperty):
+		return "not_permitted"
+	case errors.As(err, &propertyForProperty):
+		return "invalid_combination"
+	case errors.As(err, &tagLength), errors.As(err, &fieldLength), errors.Is(err, wire.ErrValidLength):
+		return "length"
+	case errors.As(err, &invalidTag):
+		return "invalid_tag"
+	case errors.As(err, &fieldErr):
+		return "field"
+	}
+	return "other"
+}
+
+// recordValidationError counts err, when it is non-nil, as a validation failure of fwm
+func recordValidationError(fwm *wire.FEDWireMessage, err error) {
+	if err == nil {
+		return
+	}
+	var el base.ErrorList
+	if !errors.As(err, &el) {
+		el = base.ErrorList{err}
+	}
+	bfc := metricBusinessFunctionCode(fwm)
+	for _, err := range el {
+		validationErrors.With("business_function_code", bfc, "error_type", validationErrorType(err)).Add(1)
+	}
+}
+
+// recordReadErrors counts the errors of reading file in the FED format. Tags which failed to parse are counted by
+// tag, and files which parsed but are invalid are counted as validation failures.
+func recordReadErrors(ctx context.Context, file *wire.File, err error) {
+	if err == nil {
+		return
+	}
+	var el base.ErrorList
+	if !errors.As(err, &el) {
+		el = base.ErrorList{err}
+	}
+	// the reader only validates files which parsed, and returns the validation error as text
+	if len(el) == 1 && file != nil {
+		if verr := file.ValidateContext(ctx); verr != nil && strings.Contains(el[0].Error(), verr.Error()) {
+			recordValidationError(&file.FEDWireMessage, verr)
+			return
+		}
+	}
+	for _, err := range el {
+		tag := "unknown"
+		var pe *base.ParseError
+		if errors.As(err, &pe) && pe.Record != "" {
+			tag = pe.Record
+		}
+		parseErrors.With("tag", tag).Add(1)
+	}
+}
+
+// recordFileCreated counts a created file, along with the Amount of its message
+func recordFileCreated(file *wire.File) {
+	fwm := &file.FEDWireMessage
+	bfc := metricBusinessFunctionCode(fwm)
+	filesCreated.With("business_function_code", bfc).Add(1)
+
+	if fwm.Amount == nil {
+		return
+	}
+	cents, err := strconv.ParseInt(strings.TrimSpace(fwm.Amount.Amount), 10, 64)
+	if err != nil || cents <= 0 {
+		return
+	}
+	amountProcessed.With("business_function_code", bfc).Add(float64(cents))
+	messageAmounts.With("business_function_code", bfc).Observe(float64(cents) / 100)
+}
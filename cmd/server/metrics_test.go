@@ -0,0 +1,142 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+// metricValue returns the value of the counter, or the sample count of the histogram, name with labels from the
+// default registry, or 0 when it has not been recorded
+func metricValue(t *testing.T, name string, labels map[string]string) float64 {
+	t.Helper()
+
+	families, err := stdprometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	metrics:
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if labels[label.GetName()] != label.GetValue() {
+					continue metrics
+				}
+			}
+			if m.GetHistogram() != nil {
+				return float64(m.GetHistogram().GetSampleCount())
+			}
+			return m.GetCounter().GetValue()
+		}
+	}
+	return 0
+}
+
+func TestMetricBusinessFunctionCode(t *testing.T) {
+	require.Equal(t, "none", metricBusinessFunctionCode(&wire.FEDWireMessage{}))
+	require.Equal(t, "CTR", metricBusinessFunctionCode(&wire.FEDWireMessage{
+		BusinessFunctionCode: &wire.BusinessFunctionCode{BusinessFunctionCode: "CTR"},
+	}))
+	require.Equal(t, "other", metricBusinessFunctionCode(&wire.FEDWireMessage{
+		BusinessFunctionCode: &wire.BusinessFunctionCode{BusinessFunctionCode: base.ID()},
+	}))
+}
+
+func TestValidationErrorType(t *testing.T) {
+	for err, want := range map[error]string{
+		&wire.FieldError{FieldName: "Amount", Err: wire.ErrFieldRequired}:                      "required",
+		&wire.FieldError{FieldName: "Amount", Err: wire.ErrNonAmount}:                          "field",
+		wire.NewErrBusinessFunctionCodeProperty("LocalInstrument", "ANSI", "CTR"):              "not_permitted",
+		&wire.FieldError{FieldName: "Adjustment", Err: wire.ErrNotPermitted}:                   "not_permitted",
+		wire.NewErrInvalidPropertyForProperty("TypeCode", "10", "SubTypeCode", "99"):           "invalid_combination",
+		wire.NewTagWrongLengthErr(10, 5):                                                       "length",
+		&wire.FieldError{FieldName: "Name", Err: wire.NewFieldWrongLengthErr(35, 40)}:          "length",
+		wire.NewErrInvalidTag("{9999}"):                                                        "invalid_tag",
+		fmt.Errorf("file validation failed: %w", &wire.FieldError{Err: wire.ErrFieldRequired}): "required",
+		errors.New("something else"):                                                           "other",
+	} {
+		require.Equal(t, want, validationErrorType(err), err.Error())
+	}
+}
+
+func TestRecordReadErrors(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+
+	// a tag which fails to parse
+	amount := map[string]string{"tag": "Amount"}
+	before := metricValue(t, "wire_parse_errors", amount)
+	badAmount := strings.Replace(string(bs), "{2000}000001234567", "{2000}00000123456X", 1)
+	f, err := wire.NewReader(strings.NewReader(badAmount)).Read()
+	require.Error(t, err)
+	recordReadErrors(context.Background(), &f, err)
+	require.Equal(t, before+1, metricValue(t, "wire_parse_errors", amount))
+
+	// a file which parses but is invalid
+	required := map[string]string{"business_function_code": "CTR", "error_type": "required"}
+	before = metricValue(t, "wire_validation_errors", required)
+	var lines []string
+	for _, line := range strings.Split(string(bs), "\n") {
+		if !strings.HasPrefix(line, "{3100}") {
+			lines = append(lines, line)
+		}
+	}
+	f, err = wire.NewReader(strings.NewReader(strings.Join(lines, "\n"))).Read()
+	require.Error(t, err)
+	parseBefore := metricValue(t, "wire_parse_errors", map[string]string{"tag": "unknown"})
+	recordReadErrors(context.Background(), &f, err)
+	require.Equal(t, before+1, metricValue(t, "wire_validation_errors", required))
+	require.Equal(t, parseBefore, metricValue(t, "wire_parse_errors", map[string]string{"tag": "unknown"}))
+
+	recordReadErrors(context.Background(), &f, nil)
+	require.Equal(t, before+1, metricValue(t, "wire_validation_errors", required))
+}
+
+func TestFiles_metrics(t *testing.T) {
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, newMemoryWireFileRepository(), nil, nil)
+
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+
+	ctr := map[string]string{"business_function_code": "CTR"}
+	created := metricValue(t, "wire_files_created", ctr)
+	amount := metricValue(t, "wire_amount_processed_cents", ctr)
+	amounts := metricValue(t, "wire_message_amount_dollars", ctr)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/files/create", strings.NewReader(string(bs))))
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	require.Equal(t, created+1, metricValue(t, "wire_files_created", ctr))
+	require.Equal(t, amount+1234567, metricValue(t, "wire_amount_processed_cents", ctr))
+	require.Equal(t, amounts+1, metricValue(t, "wire_message_amount_dollars", ctr))
+
+	// durations are recorded by route rather than path
+	route := map[string]string{"route": "get-files-{fileId}"}
+	before := metricValue(t, "http_response_duration_seconds", route)
+	for i := 0; i < 2; i++ {
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/files/"+base.ID(), nil))
+		require.Equal(t, http.StatusNotFound, w.Code)
+	}
+	require.Equal(t, before+2, metricValue(t, "http_response_duration_seconds", route))
+}
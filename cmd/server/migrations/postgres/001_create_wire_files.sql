@@ -0,0 +1,8 @@
+CREATE TABLE wire_files (
+    file_id TEXT PRIMARY KEY,
+    file JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX wire_files_created_at_idx ON wire_files (created_at);
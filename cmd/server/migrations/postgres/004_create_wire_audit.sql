@@ -0,0 +1,19 @@
+CREATE TABLE wire_audit (
+    seq BIGSERIAL PRIMARY KEY,
+    entry_id TEXT NOT NULL UNIQUE,
+    tenant_id TEXT NOT NULL,
+    file_id TEXT NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL,
+    entry JSONB NOT NULL
+);
+
+CREATE INDEX wire_audit_file_idx ON wire_audit (tenant_id, file_id, seq);
+
+CREATE FUNCTION wire_audit_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'wire_audit is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER wire_audit_append_only BEFORE UPDATE OR DELETE ON wire_audit
+    FOR EACH ROW EXECUTE FUNCTION wire_audit_append_only();
@@ -0,0 +1,7 @@
+CREATE TABLE wire_approvals (
+    tenant_id TEXT NOT NULL,
+    file_id TEXT NOT NULL,
+    approval JSONB NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (tenant_id, file_id)
+);
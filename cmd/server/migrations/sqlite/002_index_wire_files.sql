@@ -0,0 +1,18 @@
+ALTER TABLE wire_files ADD COLUMN created_nanos BIGINT;
+ALTER TABLE wire_files ADD COLUMN business_function_code TEXT;
+ALTER TABLE wire_files ADD COLUMN type_sub_type TEXT;
+ALTER TABLE wire_files ADD COLUMN amount BIGINT;
+ALTER TABLE wire_files ADD COLUMN sender_aba TEXT;
+ALTER TABLE wire_files ADD COLUMN receiver_aba TEXT;
+ALTER TABLE wire_files ADD COLUMN cycle_date TEXT;
+ALTER TABLE wire_files ADD COLUMN beneficiary_name TEXT;
+ALTER TABLE wire_files ADD COLUMN valid BOOLEAN;
+
+DROP INDEX IF EXISTS wire_files_created_at_idx;
+CREATE INDEX wire_files_created_nanos_idx ON wire_files (created_nanos, file_id);
+CREATE INDEX wire_files_amount_idx ON wire_files (amount, file_id);
+CREATE INDEX wire_files_cycle_date_idx ON wire_files (cycle_date, file_id);
+CREATE INDEX wire_files_business_function_code_idx ON wire_files (business_function_code);
+CREATE INDEX wire_files_type_sub_type_idx ON wire_files (type_sub_type);
+CREATE INDEX wire_files_sender_aba_idx ON wire_files (sender_aba);
+CREATE INDEX wire_files_receiver_aba_idx ON wire_files (receiver_aba);
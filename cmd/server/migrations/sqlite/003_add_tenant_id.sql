@@ -0,0 +1,8 @@
+ALTER TABLE wire_files ADD COLUMN tenant_id TEXT NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS wire_files_created_nanos_idx;
+DROP INDEX IF EXISTS wire_files_amount_idx;
+DROP INDEX IF EXISTS wire_files_cycle_date_idx;
+CREATE INDEX wire_files_tenant_created_nanos_idx ON wire_files (tenant_id, created_nanos, file_id);
+CREATE INDEX wire_files_tenant_amount_idx ON wire_files (tenant_id, amount, file_id);
+CREATE INDEX wire_files_tenant_cycle_date_idx ON wire_files (tenant_id, cycle_date, file_id);
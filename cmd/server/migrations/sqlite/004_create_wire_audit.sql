@@ -0,0 +1,20 @@
+CREATE TABLE wire_audit (
+    seq INTEGER PRIMARY KEY AUTOINCREMENT,
+    entry_id TEXT NOT NULL UNIQUE,
+    tenant_id TEXT NOT NULL,
+    file_id TEXT NOT NULL,
+    recorded_at TIMESTAMP NOT NULL,
+    entry TEXT NOT NULL
+);
+
+CREATE INDEX wire_audit_file_idx ON wire_audit (tenant_id, file_id, seq);
+
+CREATE TRIGGER wire_audit_no_update BEFORE UPDATE ON wire_audit
+BEGIN
+    SELECT RAISE(ABORT, 'wire_audit is append-only');
+END;
+
+CREATE TRIGGER wire_audit_no_delete BEFORE DELETE ON wire_audit
+BEGIN
+    SELECT RAISE(ABORT, 'wire_audit is append-only');
+END;
@@ -0,0 +1,7 @@
+CREATE TABLE wire_approvals (
+    tenant_id TEXT NOT NULL,
+    file_id TEXT NOT NULL,
+    approval TEXT NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (tenant_id, file_id)
+);
@@ -0,0 +1,182 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+// addStatelessRoutes registers the endpoints which validate and convert the file in the request body without
+// storing it
+func addStatelessRoutes(logger log.Logger, r *mux.Router, approvals *approvalWorkflow) {
+	r.Methods("POST").Path("/validate").HandlerFunc(validateRequestFile(logger))
+	r.Methods("POST").Path("/convert").HandlerFunc(convertRequestFile(logger, approvals))
+}
+
+// readRequestFile reads the file in the request body, as JSON when from is json and in the FED format when from is
+// fed. An empty from is json for application/json requests and fed otherwise. Files which can be read but are
+// invalid are returned along with invalid, while err is set for bodies which can't be read.
+func readRequestFile(r *http.Request, from string) (file *wire.File, invalid error, err error) {
+	if from == "" {
+		from = "fed"
+		if strings.Contains(r.Header.Get("Content-Type"), "application/json") {
+			from = "json"
+		}
+	}
+	opts := validateOptsFromQuery(r.URL.Query())
+
+	switch from {
+	case "fed":
+		f, err := wire.NewReader(r.Body).ReadContext(r.Context(), opts)
+		recordReadErrors(r.Context(), &f, err)
+		return &f, err, nil
+	case "json":
+		file := wire.NewFile()
+		if err := json.NewDecoder(r.Body).Decode(file); err != nil {
+			return nil, nil, fmt.Errorf("error reading request body: %v", err)
+		}
+		file.SetValidation(opts)
+		err := file.ValidateContext(r.Context())
+		recordValidationError(&file.FEDWireMessage, err)
+		return file, err, nil
+	}
+	return nil, nil, fmt.Errorf("unknown from %q (Options: fed, json)", from)
+}
+
+// newValidationErrors returns each error of invalid, which is nil for valid files
+func newValidationErrors(invalid error) []validationError {
+	var out []validationError
+	var el base.ErrorList
+	if errors.As(invalid, &el) {
+		for _, err := range el {
+			out = append(out, newValidationError("message", err))
+		}
+	} else if invalid != nil {
+		out = append(out, newValidationError("message", invalid))
+	}
+	return out
+}
+
+// validateResponse is returned by POST /validate
+type validateResponse struct {
+	Valid  bool              `json:"valid"`
+	Errors []validationError `json:"errors,omitempty"`
+}
+
+func validateRequestFile(logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		_, invalid, err := readRequestFile(r, r.URL.Query().Get("from"))
+		if err != nil {
+			err = logger.LogError(err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		resp := validateResponse{Errors: newValidationErrors(invalid)}
+		resp.Valid = len(resp.Errors) == 0
+		logger.Logf("validated file: valid=%v", resp.Valid)
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// convertRequestFile writes the file in the request body in the format of the to query parameter, which is json,
+// fed, variable or iso20022. Only valid files are converted.
+func convertRequestFile(logger log.Logger, approvals *approvalWorkflow) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		query := r.URL.Query()
+		to := query.Get("to")
+		switch to {
+		case "":
+			to = "json"
+		case "json", "fed", "variable", "iso20022":
+		default:
+			moovhttp.Problem(w, logger.LogErrorf("unknown to %q (Options: json, fed, variable, iso20022)", to).Err())
+			return
+		}
+		newline := true
+		if v := query.Get("newline"); v != "" {
+			var err error
+			if newline, err = strconv.ParseBool(v); err != nil {
+				moovhttp.Problem(w, logger.LogErrorf("invalid newline %q", v).Err())
+				return
+			}
+		}
+
+		file, invalid, err := readRequestFile(r, query.Get("from"))
+		if err == nil && invalid != nil {
+			err = fmt.Errorf("file validation failed: %v", invalid)
+		}
+		if err != nil {
+			err = logger.LogError(err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		logger = logger.Set("to", log.String(to))
+
+		if to == "json" {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(file)
+			logger.Log("converted file")
+			return
+		}
+
+		if err := approvals.checkConvert(&file.FEDWireMessage); err != nil {
+			logger.LogErrorf("conversion refused: %v", err)
+			writeJSON(w, http.StatusForbidden, map[string]interface{}{"error": err.Error()})
+			return
+		}
+
+		// render into a buffer so failures can still be returned as problems
+		var buf bytes.Buffer
+		contentType := "text/plain"
+		if to == "iso20022" {
+			contentType = "application/xml; charset=utf-8"
+			err = writeISO20022(&buf, file)
+		} else {
+			opts := []wire.OptionFunc{wire.VariableLengthFields(to == "variable")}
+			if !newline {
+				opts = append(opts, wire.NewlineCharacter(""))
+			}
+			err = wire.NewWriter(&buf, opts...).WriteContext(r.Context(), file)
+		}
+		if err != nil {
+			err = logger.LogErrorf("problem converting file: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(http.StatusOK)
+		w.Write(buf.Bytes())
+		logger.Log("converted file")
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/stretchr/testify/require"
+)
+
+func serveStatelessRequest(t *testing.T, path, contentType string, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+	return serveApprovedStatelessRequest(t, nil, path, contentType, body)
+}
+
+func serveApprovedStatelessRequest(t *testing.T, approvals *approvalWorkflow, path, contentType string, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+
+	router := mux.NewRouter()
+	addStatelessRoutes(log.NewNopLogger(), router, approvals)
+
+	req := httptest.NewRequest("POST", path, bytes.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	w.Flush()
+	return w
+}
+
+func readTestdata(t *testing.T, filename string) []byte {
+	t.Helper()
+
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", filename))
+	require.NoError(t, err)
+	return bs
+}
+
+func TestStateless_validate(t *testing.T) {
+	fed := readTestdata(t, "fedWireMessage-CustomerTransfer.txt")
+
+	w := serveStatelessRequest(t, "/validate", "", fed)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	var resp validateResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.True(t, resp.Valid, resp.Errors)
+
+	invalid := bytes.Replace(fed, []byte("{2000}000001234567"), []byte("{2000}00000123456A"), 1)
+	w = serveStatelessRequest(t, "/validate", "", invalid)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	resp = validateResponse{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.False(t, resp.Valid)
+	require.NotEmpty(t, resp.Errors)
+	require.Equal(t, "Amount", resp.Errors[0].Field)
+
+	// JSON requests
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.FEDWireMessage.InputMessageAccountabilityData = nil
+	bs, err := json.Marshal(f)
+	require.NoError(t, err)
+
+	w = serveStatelessRequest(t, "/validate", "application/json", bs)
+	resp = validateResponse{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.False(t, resp.Valid)
+	require.Equal(t, "InputMessageAccountabilityData", resp.Errors[0].Field)
+
+	w = serveStatelessRequest(t, "/validate?skipMandatoryIMAD=true", "application/json", bs)
+	resp = validateResponse{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.True(t, resp.Valid, resp.Errors)
+
+	w = serveStatelessRequest(t, "/validate?from=json", "", []byte("{"))
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	w = serveStatelessRequest(t, "/validate?from=xml", "", fed)
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+}
+
+func TestStateless_convert(t *testing.T) {
+	fed := readTestdata(t, "fedWireMessage-CustomerTransfer.txt")
+
+	w := serveStatelessRequest(t, "/convert?from=fed&to=json", "", fed)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Contains(t, w.Body.String(), `"amount":"000001234567"`)
+	jsonFile := w.Body.Bytes()
+
+	w = serveStatelessRequest(t, "/convert?from=json&to=fed", "", jsonFile)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, "text/plain", w.Header().Get("Content-Type"))
+	require.Contains(t, w.Body.String(), "{2000}000001234567\n")
+
+	w = serveStatelessRequest(t, "/convert?to=variable&newline=false", "application/json", jsonFile)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.NotContains(t, w.Body.String(), "\n")
+	require.Contains(t, w.Body.String(), "{3320}Sender Reference*")
+
+	w = serveStatelessRequest(t, "/convert?to=iso20022", "", fed)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, "application/xml; charset=utf-8", w.Header().Get("Content-Type"))
+	var doc pacs008Document
+	require.NoError(t, xml.Unmarshal(w.Body.Bytes(), &doc))
+	require.Equal(t, pacs008Namespace, doc.Xmlns)
+	tx := doc.Transfer.Transaction
+	require.Equal(t, pacs008Amount{Currency: "USD", Value: "12345.67"}, tx.Amount)
+	require.Equal(t, "2019-04-10", tx.SettlementDate)
+	require.Equal(t, "Sender Reference", tx.InstructionID)
+	require.Equal(t, "121042882", tx.InstructingAgent.MemberID.ID)
+	require.Equal(t, "231380104", tx.InstructedAgent.MemberID.ID)
+	require.Equal(t, "Name", tx.Creditor.Name)
+
+	// invalid files and unsupported conversions are rejected
+	invalid := bytes.Replace(fed, []byte("{2000}000001234567"), []byte("{2000}00000123456A"), 1)
+	w = serveStatelessRequest(t, "/convert?to=fed", "", invalid)
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	w = serveStatelessRequest(t, "/convert?to=pdf", "", fed)
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	w = serveStatelessRequest(t, "/convert?to=iso20022", "", readTestdata(t, "fedWireMessage-BankTransfer.txt"))
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	require.True(t, strings.Contains(w.Body.String(), "customer transfers"), w.Body)
+}
+
+func TestStateless_convertApprovals(t *testing.T) {
+	fed := readTestdata(t, "fedWireMessage-CustomerTransfer.txt")
+	approvals := &approvalWorkflow{thresholds: map[string]int64{"*": 0}, store: newMemoryApprovalStore()}
+
+	// messages which require approval are only exported once approved
+	for _, to := range []string{"fed", "variable", "iso20022"} {
+		w := serveApprovedStatelessRequest(t, approvals, "/convert?to="+to, "", fed)
+		require.Equal(t, http.StatusForbidden, w.Code, w.Body)
+		require.Contains(t, w.Body.String(), "approval threshold")
+	}
+
+	w := serveApprovedStatelessRequest(t, approvals, "/convert?to=json", "", fed)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+
+	approvals.thresholds = map[string]int64{"*": 5000000}
+	w = serveApprovedStatelessRequest(t, approvals, "/convert?to=fed", "", fed)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+}
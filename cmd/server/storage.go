@@ -1,61 +1,209 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
-	"github.com/moov-io/wire"
+	"fmt"
+	"strings"
 	"sync"
+	"time"
+
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
 )
 
+// WireFileRepository stores the files of the server. GetFile returns a nil file without an error when the file
+// does not exist. FindFiles filters on indexed fields of the files rather than reading every file.
+//
+// ForTenant returns the repository of a tenant's files, which records the tenant on the files it saves and never
+// returns the files of other tenants. The repository of the empty tenant is used when authentication is disabled.
 type WireFileRepository interface {
-	getFiles() ([]*wire.File, error)
-	getFile(fileId string) (*wire.File, error)
+	GetFiles() ([]*wire.File, error)
+	GetFile(fileId string) (*wire.File, error)
+	FindFiles(query FileQuery) (*FilePage, error)
+
+	SaveFile(file *wire.File) error
+	DeleteFile(fileId string) error
 
-	saveFile(file *wire.File) error
-	deleteFile(fileId string) error
+	ForTenant(tenantID string) WireFileRepository
+}
+
+// newWireFileRepository returns the WireFileRepository of storageType. dsn is the directory of filesystem storage,
+// the database file of sqlite and the connection string of postgres. Files are encrypted with cipher when it is
+// non-nil, and stored files it would encrypt differently are encrypted again.
+func newWireFileRepository(logger log.Logger, storageType, dsn string, cipher *fileCipher) (WireFileRepository, error) {
+	switch strings.ToLower(storageType) {
+	case "", "memory":
+		logger.Log("storing files in memory")
+		repo := newMemoryWireFileRepository()
+		repo.cipher = cipher
+		return repo, nil
+	case "filesystem":
+		logger.Logf("storing files in directory %s", dsn)
+		repo, err := newFilesystemWireFileRepository(dsn)
+		if err != nil {
+			return nil, err
+		}
+		repo.cipher = cipher
+		return repo, resealFiles(logger, repo)
+	case "sqlite", "postgres":
+		logger.Logf("storing files in %s", storageType)
+		repo, err := newSQLWireFileRepository(strings.ToLower(storageType), dsn)
+		if err != nil {
+			return nil, err
+		}
+		repo.cipher = cipher
+		if err := resealFiles(logger, repo); err != nil {
+			repo.Close()
+			return nil, err
+		}
+		return repo, nil
+	}
+	return nil, fmt.Errorf("unknown storage type %q", storageType)
+}
+
+// resealFiles encrypts the stored files of repo which aren't encrypted with its first key, when it has one
+func resealFiles(logger log.Logger, repo interface{ reseal() (int, error) }) error {
+	n, err := repo.reseal()
+	if err != nil {
+		return fmt.Errorf("encrypting stored files: %v", err)
+	}
+	if n > 0 {
+		logger.Logf("encrypted %d stored files", n)
+	}
+	return nil
 }
 
+// memoryWireFileRepository keeps the JSON of each file, encrypted when cipher is set
 type memoryWireFileRepository struct {
-	mu    sync.Mutex
-	files map[string]*wire.File
+	mu      sync.Mutex
+	files   map[string][]byte
+	indexes map[string]*fileIndex
+	cipher  *fileCipher
+	tenant  string
+
+	// tenants holds the repositories of each tenant, on the repository of the empty tenant
+	root      *memoryWireFileRepository
+	tenantsMu sync.Mutex
+	tenants   map[string]*memoryWireFileRepository
 }
 
-func (r *memoryWireFileRepository) getFiles() ([]*wire.File, error) {
+func newMemoryWireFileRepository() *memoryWireFileRepository {
+	r := &memoryWireFileRepository{
+		files:   make(map[string][]byte),
+		indexes: make(map[string]*fileIndex),
+		tenants: make(map[string]*memoryWireFileRepository),
+	}
+	r.root = r
+	return r
+}
+
+func (r *memoryWireFileRepository) ForTenant(tenantID string) WireFileRepository {
+	if tenantID == "" {
+		return r.root
+	}
+	root := r.root
+	root.tenantsMu.Lock()
+	defer root.tenantsMu.Unlock()
+
+	tenant, ok := root.tenants[tenantID]
+	if !ok {
+		tenant = newMemoryWireFileRepository()
+		tenant.root = root
+		tenant.cipher = root.cipher
+		tenant.tenant = tenantID
+		root.tenants[tenantID] = tenant
+	}
+	return tenant
+}
+
+// file decodes the file fileID stored as bs
+func (r *memoryWireFileRepository) file(fileID string, bs []byte) (*wire.File, error) {
+	bs, err := r.cipher.open(bs, fileAdditionalData(r.tenant, fileID))
+	if err != nil {
+		return nil, err
+	}
+	return decodeStoredFile(bs)
+}
+
+func (r *memoryWireFileRepository) GetFiles() ([]*wire.File, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	var out []*wire.File
-	for _, v := range r.files {
-		f := *v
-		out = append(out, &f)
+	for id, bs := range r.files {
+		file, err := r.file(id, bs)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, file)
 	}
 	return out, nil
 }
 
-func (r *memoryWireFileRepository) getFile(fileId string) (*wire.File, error) {
+func (r *memoryWireFileRepository) GetFile(fileId string) (*wire.File, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	for i := range r.files {
-		if r.files[i].ID == fileId {
-			f := *r.files[i]
-			return &f, nil
-		}
+	bs, ok := r.files[fileId]
+	if !ok {
+		return nil, nil
 	}
-	return nil, nil
+	return r.file(fileId, bs)
 }
 
-func (r *memoryWireFileRepository) saveFile(file *wire.File) error {
+func (r *memoryWireFileRepository) SaveFile(file *wire.File) error {
+	if file.ID == "" {
+		return errors.New("empty Wire File ID")
+	}
+	bs, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+	if bs, err = r.cipher.seal(bs, fileAdditionalData(r.tenant, file.ID)); err != nil {
+		return err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if file.ID == "" {
-		return errors.New("empty Wire File ID")
+	createdAt := time.Now()
+	if idx, ok := r.indexes[file.ID]; ok {
+		createdAt = idx.CreatedAt
 	}
-	r.files[file.ID] = file
+	r.files[file.ID] = bs
+	r.indexes[file.ID] = r.cipher.index(newFileIndex(file, createdAt))
 	return nil
 }
 
-func (r *memoryWireFileRepository) deleteFile(fileId string) error {
+func (r *memoryWireFileRepository) FindFiles(query FileQuery) (*FilePage, error) {
+	if err := r.cipher.checkQuery(query); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	indexes := make([]*fileIndex, 0, len(r.indexes))
+	for _, idx := range r.indexes {
+		indexes = append(indexes, idx)
+	}
+	ids, next, total, err := pageIndexes(query, indexes)
+	if err != nil {
+		return nil, err
+	}
+	page := &FilePage{NextCursor: next, Total: total}
+	for _, id := range ids {
+		file, err := r.file(id, r.files[id])
+		if err != nil {
+			return nil, err
+		}
+		page.Files = append(page.Files, file)
+	}
+	return page, nil
+}
+
+func (r *memoryWireFileRepository) DeleteFile(fileId string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -64,6 +212,16 @@ func (r *memoryWireFileRepository) deleteFile(fileId string) error {
 	}
 
 	delete(r.files, fileId)
+	delete(r.indexes, fileId)
 
 	return nil
 }
+
+// decodeStoredFile reads a file stored as JSON
+func decodeStoredFile(bs []byte) (*wire.File, error) {
+	file := wire.NewFile()
+	if err := json.Unmarshal(bs, file); err != nil {
+		return nil, fmt.Errorf("decoding stored file: %v", err)
+	}
+	return file, nil
+}
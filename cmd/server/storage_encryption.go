@@ -0,0 +1,224 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// sealedFileVersion is the version of the sealedFile format. Files of version 1 aren't bound to their tenant and
+// ID, and are sealed again by reseal.
+const sealedFileVersion = 2
+
+var (
+	errNoEncryptionKey = errors.New("stored file is encrypted but no encryption key is configured")
+
+	errEncryptedBeneficiaryName = errors.New("beneficiaryName can't be filtered on while stored files are encrypted")
+)
+
+// fileCipher encrypts stored files with envelope encryption. Each file is encrypted with its own random AES-256-GCM
+// data key, which is stored with the file encrypted by a key of the key file. Keys are named so they can be
+// rotated: files are encrypted with the first key, and any key of the file decrypts them. The tenant and ID of each
+// file are authenticated with it, so an encrypted file can't be moved to another file or tenant.
+//
+// A nil *fileCipher leaves files in clear text.
+type fileCipher struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// sealedFile is the JSON stored in place of an encrypted file. DataKey and Ciphertext start with their nonce.
+type sealedFile struct {
+	Version    int    `json:"wireEnvelope"`
+	KeyID      string `json:"keyId"`
+	DataKey    []byte `json:"dataKey"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// newFileCipher reads a file of "<key id> <base64 encoded 32 byte key>" lines. Blank lines and lines starting with #
+// are skipped, and the first key encrypts files.
+func newFileCipher(path string) (*fileCipher, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	c := &fileCipher{keys: make(map[string]cipher.AEAD)}
+	scanner := bufio.NewScanner(fd)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s line %d: expected <key id> <key>", path, n)
+		}
+		key, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil || len(key) != 32 {
+			return nil, fmt.Errorf("%s line %d: key must be 32 bytes encoded in base64", path, n)
+		}
+		if _, ok := c.keys[fields[0]]; ok {
+			return nil, fmt.Errorf("%s line %d: duplicate key id %q", path, n, fields[0])
+		}
+		if c.keys[fields[0]], err = newGCM(key); err != nil {
+			return nil, err
+		}
+		if c.primary == "" {
+			c.primary = fields[0]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if c.primary == "" {
+		return nil, fmt.Errorf("%s has no keys", path)
+	}
+	return c, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// gcmSeal encrypts plaintext, prefixing the result with a random nonce
+func gcmSeal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func gcmOpen(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	return aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], additionalData)
+}
+
+// fileAdditionalData binds the ciphertext of a stored file to its tenant and ID
+func fileAdditionalData(tenantID, fileID string) []byte {
+	return []byte(tenantID + "\x00" + fileID)
+}
+
+// seal encrypts the JSON of a stored file, authenticating additionalData along with it
+func (c *fileCipher) seal(plaintext, additionalData []byte) ([]byte, error) {
+	if c == nil {
+		return plaintext, nil
+	}
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := gcmSeal(aead, plaintext, additionalData)
+	if err != nil {
+		return nil, err
+	}
+	// the key ID is authenticated so a data key can't be moved to another key's envelope
+	wrapped, err := gcmSeal(c.keys[c.primary], dataKey, []byte(c.primary))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(sealedFile{Version: sealedFileVersion, KeyID: c.primary, DataKey: wrapped, Ciphertext: ciphertext})
+}
+
+// readSealedFile returns the envelope of bs, or nil when bs is a file stored in clear text
+func readSealedFile(bs []byte) *sealedFile {
+	var sealed sealedFile
+	if err := json.Unmarshal(bs, &sealed); err != nil || sealed.Version == 0 {
+		return nil
+	}
+	return &sealed
+}
+
+// open returns the JSON of a stored file, decrypting it when it was sealed with additionalData. Files stored in
+// clear text, such as those saved before encryption was enabled, are returned as they are.
+func (c *fileCipher) open(bs, additionalData []byte) ([]byte, error) {
+	sealed := readSealedFile(bs)
+	if sealed == nil {
+		return bs, nil
+	}
+	if c == nil {
+		return nil, errNoEncryptionKey
+	}
+	switch sealed.Version {
+	case 1:
+		additionalData = nil
+	case sealedFileVersion:
+	default:
+		return nil, fmt.Errorf("unsupported encrypted file version %d", sealed.Version)
+	}
+	kek, ok := c.keys[sealed.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("stored file is encrypted with unknown key %q", sealed.KeyID)
+	}
+	dataKey, err := gcmOpen(kek, sealed.DataKey, []byte(sealed.KeyID))
+	if err != nil {
+		return nil, fmt.Errorf("decrypting data key: %v", err)
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := gcmOpen(aead, sealed.Ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting stored file: %v", err)
+	}
+	return plaintext, nil
+}
+
+// current reports whether bs is stored as it would be sealed now: encrypted with the first key in the current
+// version when encryption is enabled, and in clear text otherwise
+func (c *fileCipher) current(bs []byte) bool {
+	sealed := readSealedFile(bs)
+	if c == nil {
+		return sealed == nil
+	}
+	return sealed != nil && sealed.KeyID == c.primary && sealed.Version == sealedFileVersion
+}
+
+// reseal returns bs sealed with the first key
+func (c *fileCipher) reseal(bs, additionalData []byte) ([]byte, error) {
+	plaintext, err := c.open(bs, additionalData)
+	if err != nil {
+		return nil, err
+	}
+	return c.seal(plaintext, additionalData)
+}
+
+// index returns idx without the fields which would disclose encrypted data
+func (c *fileCipher) index(idx *fileIndex) *fileIndex {
+	if c != nil {
+		idx.BeneficiaryName = ""
+	}
+	return idx
+}
+
+// checkQuery rejects filters on the fields index leaves out
+func (c *fileCipher) checkQuery(query FileQuery) error {
+	if c != nil && query.BeneficiaryName != "" {
+		return errEncryptedBeneficiaryName
+	}
+	return nil
+}
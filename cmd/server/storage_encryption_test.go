@@ -0,0 +1,283 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	"github.com/moov-io/base/log"
+	"github.com/stretchr/testify/require"
+)
+
+// testKeyLine returns the key file line of a random key
+func testKeyLine(t *testing.T, id string) string {
+	t.Helper()
+
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return fmt.Sprintf("%s %s\n", id, base64.StdEncoding.EncodeToString(key))
+}
+
+// writeTestKeys writes a key file with a random key for each of ids and returns its path
+func writeTestKeys(t *testing.T, ids ...string) string {
+	t.Helper()
+
+	contents := "# test keys\n\n"
+	for _, id := range ids {
+		contents += testKeyLine(t, id)
+	}
+	return writeTestFile(t, "keys", contents)
+}
+
+func TestNewFileCipher(t *testing.T) {
+	path := writeTestKeys(t, "2024", "2023")
+	c, err := newFileCipher(path)
+	require.NoError(t, err)
+	require.Equal(t, "2024", c.primary)
+	require.Len(t, c.keys, 2)
+
+	for _, contents := range []string{
+		"",
+		"2024\n",
+		"2024 not-base64!\n",
+		"2024 " + base64.StdEncoding.EncodeToString([]byte("short")) + "\n",
+		testKeyLine(t, "2024") + testKeyLine(t, "2024"),
+	} {
+		_, err := newFileCipher(writeTestFile(t, "keys", contents))
+		require.Error(t, err, contents)
+	}
+	_, err = newFileCipher(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
+
+func TestFileCipher(t *testing.T) {
+	c, err := newFileCipher(writeTestKeys(t, "a"))
+	require.NoError(t, err)
+
+	plaintext := []byte(`{"id":"foo","fedWireMessage":{"beneficiary":{"personal":{"identifier":"123456789"}}}}`)
+	ad := fileAdditionalData("acme", "foo")
+	sealed, err := c.seal(plaintext, ad)
+	require.NoError(t, err)
+	require.NotContains(t, string(sealed), "123456789")
+	require.True(t, c.current(sealed))
+	require.False(t, c.current(plaintext))
+
+	opened, err := c.open(sealed, ad)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, opened)
+
+	// files can't be moved to another file or tenant
+	_, err = c.open(sealed, fileAdditionalData("acme", "bar"))
+	require.ErrorContains(t, err, "decrypting stored file")
+	_, err = c.open(sealed, fileAdditionalData("globex", "foo"))
+	require.ErrorContains(t, err, "decrypting stored file")
+
+	// files sealed by version 1 aren't bound to their file, and are sealed again
+	v1, err := c.seal(plaintext, nil)
+	require.NoError(t, err)
+	v1 = bytes.Replace(v1, []byte(`"wireEnvelope":2`), []byte(`"wireEnvelope":1`), 1)
+	require.False(t, c.current(v1))
+	opened, err = c.open(v1, ad)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, opened)
+	resealed, err := c.reseal(v1, ad)
+	require.NoError(t, err)
+	require.True(t, c.current(resealed))
+
+	// files stored in clear text are read as they are
+	opened, err = c.open(plaintext, ad)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, opened)
+
+	// without the key
+	var none *fileCipher
+	_, err = none.open(sealed, ad)
+	require.ErrorIs(t, err, errNoEncryptionKey)
+	other, err := newFileCipher(writeTestKeys(t, "b"))
+	require.NoError(t, err)
+	_, err = other.open(sealed, ad)
+	require.ErrorContains(t, err, `unknown key "a"`)
+
+	// changes are detected
+	tampered := bytes.Replace(sealed, []byte(`"keyId":"a"`), []byte(`"keyId":"b"`), 1)
+	other.keys["b"] = c.keys["a"]
+	_, err = other.open(tampered, ad)
+	require.ErrorContains(t, err, "data key")
+
+	// nil ciphers store files in clear text
+	bs, err := none.seal(plaintext, ad)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, bs)
+	require.True(t, none.current(plaintext))
+	require.False(t, none.current(sealed))
+}
+
+func TestEncryptedStorage(t *testing.T) {
+	sqliteFiles := func(repo WireFileRepository) [][]byte {
+		rows, err := repo.(*sqlWireFileRepository).db.Query(`SELECT file FROM wire_files`)
+		require.NoError(t, err)
+		defer rows.Close()
+		var out [][]byte
+		for rows.Next() {
+			var bs []byte
+			require.NoError(t, rows.Scan(&bs))
+			out = append(out, bs)
+		}
+		return out
+	}
+
+	for _, tc := range []struct {
+		storageType string
+		dsn         func(t *testing.T) string
+		stored      func(t *testing.T, dsn string, repo WireFileRepository) [][]byte
+	}{
+		{
+			storageType: "filesystem",
+			dsn:         func(t *testing.T) string { return t.TempDir() },
+			stored: func(t *testing.T, dsn string, _ WireFileRepository) [][]byte {
+				paths, err := filepath.Glob(filepath.Join(dsn, "*.json"))
+				require.NoError(t, err)
+				tenantPaths, err := filepath.Glob(filepath.Join(dsn, "tenants", "*", "*.json"))
+				require.NoError(t, err)
+				var out [][]byte
+				for _, path := range append(paths, tenantPaths...) {
+					bs, err := os.ReadFile(path)
+					require.NoError(t, err)
+					out = append(out, bs)
+				}
+				return out
+			},
+		},
+		{
+			storageType: "sqlite",
+			dsn:         func(t *testing.T) string { return filepath.Join(t.TempDir(), "wire.db") },
+			stored: func(t *testing.T, _ string, repo WireFileRepository) [][]byte {
+				return sqliteFiles(repo)
+			},
+		},
+	} {
+		t.Run(tc.storageType, func(t *testing.T) {
+			logger := log.NewNopLogger()
+			dsn := tc.dsn(t)
+			open := func(cipher *fileCipher) WireFileRepository {
+				repo, err := newWireFileRepository(logger, tc.storageType, dsn, cipher)
+				require.NoError(t, err)
+				if c, ok := repo.(io.Closer); ok {
+					t.Cleanup(func() { c.Close() })
+				}
+				return repo
+			}
+
+			// files saved before encryption was enabled
+			f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+			require.NoError(t, err)
+			identifier := f.FEDWireMessage.Beneficiary.Personal.Identifier
+			f.ID = base.ID()
+			repo := open(nil)
+			require.NoError(t, repo.SaveFile(f))
+			// tenants whose directory name is escaped are resealed too
+			tenantFile := *f
+			tenantFile.ID = base.ID()
+			require.NoError(t, repo.ForTenant("acme/eu").SaveFile(&tenantFile))
+			for _, bs := range tc.stored(t, dsn, repo) {
+				require.Contains(t, string(bs), identifier)
+			}
+
+			// are encrypted once a key is configured
+			oldKey := testKeyLine(t, "old")
+			old, err := newFileCipher(writeTestFile(t, "keys", oldKey))
+			require.NoError(t, err)
+			repo = open(old)
+			stored := tc.stored(t, dsn, repo)
+			require.Len(t, stored, 2)
+			for _, bs := range stored {
+				require.NotContains(t, string(bs), identifier)
+				require.True(t, old.current(bs))
+			}
+			file, err := repo.GetFile(f.ID)
+			require.NoError(t, err)
+			require.Equal(t, f.FEDWireMessage.Amount.Amount, file.FEDWireMessage.Amount.Amount)
+			file, err = repo.ForTenant("acme/eu").GetFile(tenantFile.ID)
+			require.NoError(t, err)
+			require.NotNil(t, file)
+
+			// the index holds no beneficiary names
+			page, err := repo.FindFiles(FileQuery{Sort: "amount"})
+			require.NoError(t, err)
+			require.Equal(t, 1, page.Total)
+			_, err = repo.FindFiles(FileQuery{BeneficiaryName: f.FEDWireMessage.Beneficiary.Personal.Name})
+			require.ErrorIs(t, err, errEncryptedBeneficiaryName)
+
+			// rotating keys encrypts the files with the new key
+			rotated, err := newFileCipher(writeTestFile(t, "keys", testKeyLine(t, "new")+oldKey))
+			require.NoError(t, err)
+			repo = open(rotated)
+			for _, bs := range tc.stored(t, dsn, repo) {
+				require.True(t, rotated.current(bs))
+			}
+			file, err = repo.GetFile(f.ID)
+			require.NoError(t, err)
+			require.NotNil(t, file)
+
+			// one tenant's file can't be swapped into the row of another
+			if sqlRepo, ok := repo.(*sqlWireFileRepository); ok {
+				_, err := sqlRepo.db.Exec(`UPDATE wire_files SET file = (SELECT file FROM wire_files WHERE file_id = ?) WHERE file_id = ?`, tenantFile.ID, f.ID)
+				require.NoError(t, err)
+				_, err = repo.GetFile(f.ID)
+				require.ErrorContains(t, err, "decrypting stored file")
+				require.NoError(t, repo.SaveFile(f))
+			}
+
+			// files can't be read without the key
+			_, err = newWireFileRepository(logger, tc.storageType, dsn, old)
+			require.ErrorContains(t, err, `unknown key "new"`)
+			_, err = open(nil).GetFile(f.ID)
+			require.ErrorContains(t, err, errNoEncryptionKey.Error())
+		})
+	}
+}
+
+func TestEncryptedStorage_memory(t *testing.T) {
+	c, err := newFileCipher(writeTestKeys(t, "a"))
+	require.NoError(t, err)
+	repo, err := newWireFileRepository(log.NewNopLogger(), "memory", "", c)
+	require.NoError(t, err)
+
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.ID = base.ID()
+	require.NoError(t, repo.SaveFile(f))
+	for _, bs := range repo.(*memoryWireFileRepository).files {
+		require.NotContains(t, string(bs), f.FEDWireMessage.Beneficiary.Personal.Identifier)
+	}
+	file, err := repo.GetFile(f.ID)
+	require.NoError(t, err)
+	require.Equal(t, f.FEDWireMessage.Beneficiary.Personal.Identifier, file.FEDWireMessage.Beneficiary.Personal.Identifier)
+
+	// filtering on beneficiary names is rejected
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, nil, nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files?beneficiaryName=jane", nil))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "encrypted")
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), f.ID)
+}
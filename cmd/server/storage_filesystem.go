@@ -0,0 +1,332 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/wire"
+)
+
+// filesystemWireFileRepository stores each file as JSON in dir, named by its ID. Several servers can share dir, as
+// files are replaced by renaming so readers never see a partial write.
+//
+// The fields FindFiles filters on are kept in memory and only read again from files which were modified since,
+// which keeps the index current with files written by other servers.
+//
+// The files of each tenant are kept in a tenants/<tenant> directory below dir. Files are encrypted when cipher is
+// set.
+type filesystemWireFileRepository struct {
+	mu     sync.Mutex
+	dir    string
+	tenant string
+	cipher *fileCipher
+
+	indexMu sync.Mutex
+	indexes map[string]cachedFileIndex
+
+	root      *filesystemWireFileRepository
+	tenantsMu sync.Mutex
+	tenants   map[string]*filesystemWireFileRepository
+}
+
+type cachedFileIndex struct {
+	modTime time.Time
+	size    int64
+	index   *fileIndex
+}
+
+// storedFile is the JSON written for each file
+type storedFile struct {
+	*wire.File
+	CreatedAt time.Time `json:"createdAt"`
+	TenantID  string    `json:"tenantId,omitempty"`
+}
+
+func newFilesystemWireFileRepository(dir string) (*filesystemWireFileRepository, error) {
+	if dir == "" {
+		return nil, errors.New("filesystem storage requires a directory")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("creating storage directory: %v", err)
+	}
+	r := &filesystemWireFileRepository{
+		dir:     dir,
+		indexes: make(map[string]cachedFileIndex),
+		tenants: make(map[string]*filesystemWireFileRepository),
+	}
+	r.root = r
+	return r, nil
+}
+
+func (r *filesystemWireFileRepository) ForTenant(tenantID string) WireFileRepository {
+	if tenantID == "" {
+		return r.root
+	}
+	root := r.root
+	root.tenantsMu.Lock()
+	defer root.tenantsMu.Unlock()
+
+	tenant, ok := root.tenants[tenantID]
+	if !ok {
+		name := url.PathEscape(tenantID)
+		if name == "." || name == ".." {
+			name = strings.ReplaceAll(name, ".", "%2E")
+		}
+		tenant = &filesystemWireFileRepository{
+			dir:     filepath.Join(root.dir, "tenants", name),
+			tenant:  tenantID,
+			cipher:  root.cipher,
+			indexes: make(map[string]cachedFileIndex),
+			root:    root,
+		}
+		root.tenants[tenantID] = tenant
+	}
+	return tenant
+}
+
+// path returns the path of fileId, rejecting IDs which would escape dir
+func (r *filesystemWireFileRepository) path(fileId string) (string, error) {
+	if fileId == "" {
+		return "", errors.New("empty Wire File ID")
+	}
+	if strings.ContainsAny(fileId, `/\`) || fileId == "." || fileId == ".." {
+		return "", fmt.Errorf("invalid Wire File ID %q", fileId)
+	}
+	return filepath.Join(r.dir, fileId+".json"), nil
+}
+
+func (r *filesystemWireFileRepository) GetFiles() ([]*wire.File, error) {
+	paths, err := filepath.Glob(filepath.Join(r.dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var out []*wire.File
+	for _, path := range paths {
+		stored, err := r.read(path)
+		if err != nil {
+			return nil, err
+		}
+		if stored != nil {
+			out = append(out, stored.File)
+		}
+	}
+	return out, nil
+}
+
+func (r *filesystemWireFileRepository) GetFile(fileId string) (*wire.File, error) {
+	path, err := r.path(fileId)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := r.read(path)
+	if stored == nil || err != nil {
+		return nil, err
+	}
+	return stored.File, nil
+}
+
+// read returns the file at path, or nil when it was deleted
+func (r *filesystemWireFileRepository) read(path string) (*storedFile, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if bs, err = r.cipher.open(bs, fileAdditionalData(r.tenant, strings.TrimSuffix(filepath.Base(path), ".json"))); err != nil {
+		return nil, fmt.Errorf("reading %s: %v", filepath.Base(path), err)
+	}
+	stored := &storedFile{File: wire.NewFile()}
+	if err := json.Unmarshal(bs, stored); err != nil {
+		return nil, fmt.Errorf("decoding stored file: %v", err)
+	}
+	return stored, nil
+}
+
+func (r *filesystemWireFileRepository) FindFiles(query FileQuery) (*FilePage, error) {
+	if err := r.cipher.checkQuery(query); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(r.dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	r.indexMu.Lock()
+	seen := make(map[string]bool)
+	var indexes []*fileIndex
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok || entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue // deleted since listing the directory
+		}
+		seen[id] = true
+		cached, ok := r.indexes[id]
+		if !ok || !cached.modTime.Equal(info.ModTime()) || cached.size != info.Size() {
+			stored, err := r.read(filepath.Join(r.dir, entry.Name()))
+			if err != nil {
+				r.indexMu.Unlock()
+				return nil, err
+			}
+			if stored == nil {
+				continue
+			}
+			createdAt := stored.CreatedAt
+			if createdAt.IsZero() {
+				createdAt = info.ModTime()
+			}
+			cached = cachedFileIndex{modTime: info.ModTime(), size: info.Size(), index: r.cipher.index(newFileIndex(stored.File, createdAt))}
+			r.indexes[id] = cached
+		}
+		indexes = append(indexes, cached.index)
+	}
+	for id := range r.indexes {
+		if !seen[id] {
+			delete(r.indexes, id)
+		}
+	}
+	r.indexMu.Unlock()
+
+	ids, next, total, err := pageIndexes(query, indexes)
+	if err != nil {
+		return nil, err
+	}
+	page := &FilePage{NextCursor: next, Total: total}
+	for _, id := range ids {
+		file, err := r.GetFile(id)
+		if err != nil {
+			return nil, err
+		}
+		if file != nil {
+			page.Files = append(page.Files, file)
+		}
+	}
+	return page, nil
+}
+
+func (r *filesystemWireFileRepository) SaveFile(file *wire.File) error {
+	path, err := r.path(file.ID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := storedFile{File: file, CreatedAt: time.Now().UTC(), TenantID: r.tenant}
+	if existing, err := r.read(path); err == nil && existing != nil && !existing.CreatedAt.IsZero() {
+		stored.CreatedAt = existing.CreatedAt
+	}
+	bs, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+	if bs, err = r.cipher.seal(bs, fileAdditionalData(r.tenant, file.ID)); err != nil {
+		return err
+	}
+	return r.write(path, bs)
+}
+
+// write replaces the file at path with bs
+func (r *filesystemWireFileRepository) write(path string, bs []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".tmp-"+strings.TrimSuffix(filepath.Base(path), ".json")+"-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(bs); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+func (r *filesystemWireFileRepository) DeleteFile(fileId string) error {
+	path, err := r.path(fileId)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// reseal encrypts the files of every tenant which aren't encrypted with the first key of cipher, returning how many
+// it encrypted. Nothing is done without a cipher.
+func (r *filesystemWireFileRepository) reseal() (int, error) {
+	if r.cipher == nil {
+		return 0, nil
+	}
+	paths, err := filepath.Glob(filepath.Join(r.root.dir, "*.json"))
+	if err != nil {
+		return 0, err
+	}
+	tenantPaths, err := filepath.Glob(filepath.Join(r.root.dir, "tenants", "*", "*.json"))
+	if err != nil {
+		return 0, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	for _, path := range append(paths, tenantPaths...) {
+		bs, err := os.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return n, err
+		}
+		if r.cipher.current(bs) {
+			continue
+		}
+		// files of tenants are kept in tenants/<tenant>, with the tenant escaped by ForTenant
+		tenantID := ""
+		if dir := filepath.Dir(path); dir != r.root.dir {
+			if tenantID, err = url.PathUnescape(filepath.Base(dir)); err != nil {
+				return n, fmt.Errorf("%s: %v", path, err)
+			}
+		}
+		if bs, err = r.cipher.reseal(bs, fileAdditionalData(tenantID, strings.TrimSuffix(filepath.Base(path), ".json"))); err != nil {
+			return n, fmt.Errorf("%s: %v", path, err)
+		}
+		if err := r.write(path, bs); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
@@ -0,0 +1,281 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/wire"
+)
+
+const (
+	defaultFilesLimit = 100
+	maxFilesLimit     = 1000
+)
+
+var (
+	errInvalidCursor = errors.New("invalid cursor")
+)
+
+// FileQuery filters, sorts and pages the files returned by FindFiles. Empty fields don't filter.
+type FileQuery struct {
+	BusinessFunctionCode string
+	// TypeSubType is the TypeCode and SubTypeCode, such as 1000
+	TypeSubType string
+	// MinAmount and MaxAmount are in cents, as in the Amount tag
+	MinAmount   *int64
+	MaxAmount   *int64
+	SenderABA   string
+	ReceiverABA string
+	// CycleDate is the IMAD InputCycleDate, as CCYYMMDD
+	CycleDate string
+	// BeneficiaryName matches beneficiary names containing it, ignoring case
+	BeneficiaryName string
+	Valid           *bool
+
+	// Sort is createdAt, amount or cycleDate. Ties are ordered by file ID.
+	Sort       string
+	Descending bool
+	// Cursor is the NextCursor of the previous page
+	Cursor string
+	Limit  int
+}
+
+// FilePage is a page of files. NextCursor is empty on the last page.
+type FilePage struct {
+	Files      []*wire.File
+	NextCursor string
+	// Total is the number of files matching the query across all pages
+	Total int
+}
+
+// parseFileQuery reads a FileQuery from the query parameters of GET /files
+func parseFileQuery(params url.Values) (FileQuery, error) {
+	q := FileQuery{
+		BusinessFunctionCode: strings.TrimSpace(params.Get("businessFunctionCode")),
+		TypeSubType:          strings.TrimSpace(params.Get("typeSubType")),
+		SenderABA:            strings.TrimSpace(params.Get("senderABA")),
+		ReceiverABA:          strings.TrimSpace(params.Get("receiverABA")),
+		CycleDate:            strings.TrimSpace(params.Get("cycleDate")),
+		BeneficiaryName:      strings.TrimSpace(params.Get("beneficiaryName")),
+		Sort:                 "createdAt",
+		Cursor:               params.Get("cursor"),
+		Limit:                defaultFilesLimit,
+	}
+	for name, dst := range map[string]**int64{"minAmount": &q.MinAmount, "maxAmount": &q.MaxAmount} {
+		if v := params.Get(name); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || n < 0 {
+				return q, fmt.Errorf("invalid %s %q, amounts are in cents", name, v)
+			}
+			*dst = &n
+		}
+	}
+	if v := params.Get("valid"); v != "" {
+		valid, err := strconv.ParseBool(v)
+		if err != nil {
+			return q, fmt.Errorf("invalid valid %q", v)
+		}
+		q.Valid = &valid
+	}
+	if v := params.Get("sort"); v != "" {
+		q.Sort, q.Descending = strings.TrimPrefix(v, "-"), strings.HasPrefix(v, "-")
+		switch q.Sort {
+		case "createdAt", "amount", "cycleDate":
+		default:
+			return q, fmt.Errorf("invalid sort %q (Options: createdAt, amount, cycleDate)", v)
+		}
+	}
+	if v := params.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return q, fmt.Errorf("invalid limit %q", v)
+		}
+		if n > maxFilesLimit {
+			n = maxFilesLimit
+		}
+		q.Limit = n
+	}
+	if q.Cursor != "" {
+		if _, err := q.decodeCursor(); err != nil {
+			return q, err
+		}
+	}
+	return q, nil
+}
+
+// fileIndex holds the fields of a file which FindFiles filters and sorts on
+type fileIndex struct {
+	ID                   string
+	CreatedAt            time.Time
+	BusinessFunctionCode string
+	TypeSubType          string
+	Amount               int64
+	SenderABA            string
+	ReceiverABA          string
+	CycleDate            string
+	// BeneficiaryName is upper case
+	BeneficiaryName string
+	Valid           bool
+}
+
+func newFileIndex(file *wire.File, createdAt time.Time) *fileIndex {
+	fwm := &file.FEDWireMessage
+	idx := &fileIndex{
+		ID:        file.ID,
+		CreatedAt: createdAt,
+		Valid:     file.Validate() == nil,
+	}
+	if fwm.BusinessFunctionCode != nil {
+		idx.BusinessFunctionCode = strings.TrimSpace(fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	if fwm.TypeSubType != nil {
+		idx.TypeSubType = fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
+	}
+	if fwm.Amount != nil {
+		idx.Amount, _ = strconv.ParseInt(strings.TrimSpace(fwm.Amount.Amount), 10, 64)
+	}
+	if fwm.SenderDepositoryInstitution != nil {
+		idx.SenderABA = strings.TrimSpace(fwm.SenderDepositoryInstitution.SenderABANumber)
+	}
+	if fwm.ReceiverDepositoryInstitution != nil {
+		idx.ReceiverABA = strings.TrimSpace(fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	}
+	if fwm.InputMessageAccountabilityData != nil {
+		idx.CycleDate = strings.TrimSpace(fwm.InputMessageAccountabilityData.InputCycleDate)
+	}
+	if fwm.Beneficiary != nil {
+		idx.BeneficiaryName = strings.ToUpper(strings.TrimSpace(fwm.Beneficiary.Personal.Name))
+	}
+	return idx
+}
+
+// matches returns if idx passes the filters of q, ignoring the cursor
+func (q FileQuery) matches(idx *fileIndex) bool {
+	switch {
+	case q.BusinessFunctionCode != "" && idx.BusinessFunctionCode != q.BusinessFunctionCode,
+		q.TypeSubType != "" && idx.TypeSubType != q.TypeSubType,
+		q.MinAmount != nil && idx.Amount < *q.MinAmount,
+		q.MaxAmount != nil && idx.Amount > *q.MaxAmount,
+		q.SenderABA != "" && idx.SenderABA != q.SenderABA,
+		q.ReceiverABA != "" && idx.ReceiverABA != q.ReceiverABA,
+		q.CycleDate != "" && idx.CycleDate != q.CycleDate,
+		q.BeneficiaryName != "" && !strings.Contains(idx.BeneficiaryName, strings.ToUpper(q.BeneficiaryName)),
+		q.Valid != nil && idx.Valid != *q.Valid:
+		return false
+	}
+	return true
+}
+
+// sortKey returns the value idx is sorted by, formatted so keys sort as strings
+func (q FileQuery) sortKey(idx *fileIndex) string {
+	switch q.Sort {
+	case "amount":
+		return fmt.Sprintf("%019d", idx.Amount)
+	case "cycleDate":
+		return idx.CycleDate
+	}
+	return fmt.Sprintf("%019d", idx.CreatedAt.UnixNano())
+}
+
+// fileCursor is the position after the last file of a page
+type fileCursor struct {
+	Sort string `json:"s"`
+	Key  string `json:"k"`
+	ID   string `json:"id"`
+}
+
+func (q FileQuery) encodeCursor(idx *fileIndex) string {
+	sort := q.Sort
+	if q.Descending {
+		sort = "-" + sort
+	}
+	bs, _ := json.Marshal(fileCursor{Sort: sort, Key: q.sortKey(idx), ID: idx.ID})
+	return base64.RawURLEncoding.EncodeToString(bs)
+}
+
+// decodeCursor returns the cursor of q, which must have been created with the same sort
+func (q FileQuery) decodeCursor() (*fileCursor, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(q.Cursor)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	var c fileCursor
+	if err := json.Unmarshal(bs, &c); err != nil || c.ID == "" {
+		return nil, errInvalidCursor
+	}
+	sort := q.Sort
+	if q.Descending {
+		sort = "-" + sort
+	}
+	if c.Sort != sort {
+		return nil, fmt.Errorf("cursor was created for sort %s", c.Sort)
+	}
+	return &c, nil
+}
+
+// pageIndexes filters, sorts and pages indexes, returning the IDs of the page. It's used by repositories which
+// keep their indexes in memory.
+func pageIndexes(q FileQuery, indexes []*fileIndex) (ids []string, next string, total int, err error) {
+	var cursor *fileCursor
+	if q.Cursor != "" {
+		if cursor, err = q.decodeCursor(); err != nil {
+			return nil, "", 0, err
+		}
+	}
+
+	var matched []*fileIndex
+	for _, idx := range indexes {
+		if q.matches(idx) {
+			matched = append(matched, idx)
+		}
+	}
+	less := func(a, b *fileIndex) bool {
+		ka, kb := q.sortKey(a), q.sortKey(b)
+		if ka != kb {
+			return ka < kb
+		}
+		return a.ID < b.ID
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if q.Descending {
+			return less(matched[j], matched[i])
+		}
+		return less(matched[i], matched[j])
+	})
+
+	start := 0
+	if cursor != nil {
+		start = sort.Search(len(matched), func(i int) bool {
+			key := q.sortKey(matched[i])
+			if q.Descending {
+				return key < cursor.Key || (key == cursor.Key && matched[i].ID < cursor.ID)
+			}
+			return key > cursor.Key || (key == cursor.Key && matched[i].ID > cursor.ID)
+		})
+	}
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultFilesLimit
+	}
+	end := start + limit
+	if end < len(matched) {
+		next = q.encodeCursor(matched[end-1])
+	} else {
+		end = len(matched)
+	}
+	for _, idx := range matched[start:end] {
+		ids = append(ids, idx.ID)
+	}
+	return ids, next, len(matched), nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFileQuery(t *testing.T) {
+	q, err := parseFileQuery(url.Values{})
+	require.NoError(t, err)
+	require.Equal(t, "createdAt", q.Sort)
+	require.Equal(t, defaultFilesLimit, q.Limit)
+	require.Nil(t, q.MinAmount)
+	require.Nil(t, q.Valid)
+
+	q, err = parseFileQuery(url.Values{
+		"businessFunctionCode": {"CTR"},
+		"minAmount":            {"0"},
+		"maxAmount":            {"500"},
+		"valid":                {"false"},
+		"sort":                 {"-amount"},
+		"limit":                {"5000"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "CTR", q.BusinessFunctionCode)
+	require.Equal(t, int64(0), *q.MinAmount)
+	require.Equal(t, int64(500), *q.MaxAmount)
+	require.False(t, *q.Valid)
+	require.Equal(t, "amount", q.Sort)
+	require.True(t, q.Descending)
+	require.Equal(t, maxFilesLimit, q.Limit)
+
+	for _, params := range []url.Values{
+		{"minAmount": {"1.00"}},
+		{"maxAmount": {"-1"}},
+		{"valid": {"maybe"}},
+		{"sort": {"name"}},
+		{"limit": {"0"}},
+		{"cursor": {"not a cursor"}},
+	} {
+		_, err := parseFileQuery(params)
+		require.Error(t, err, params)
+	}
+
+	// cursors only continue the sort they were created for
+	cursor := FileQuery{Sort: "amount"}.encodeCursor(&fileIndex{ID: "a", Amount: 1})
+	_, err = parseFileQuery(url.Values{"sort": {"amount"}, "cursor": {cursor}})
+	require.NoError(t, err)
+	_, err = parseFileQuery(url.Values{"sort": {"-amount"}, "cursor": {cursor}})
+	require.Error(t, err)
+}
+
+// testFindFiles saves files to the empty repo and checks the filters, sorts and pages of FindFiles
+func testFindFiles(t *testing.T, repo WireFileRepository) {
+	t.Helper()
+
+	amounts := []string{"000000000500", "000000001000", "000000001000", "000000020000", "000000300000"}
+	for i, amount := range amounts {
+		f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+		require.NoError(t, err)
+		f.ID = fmt.Sprintf("find-%d", i)
+		f.FEDWireMessage.Amount.Amount = amount
+		f.FEDWireMessage.InputMessageAccountabilityData.InputCycleDate = fmt.Sprintf("2019041%d", 4-i)
+		f.FEDWireMessage.Beneficiary.Personal.Name = fmt.Sprintf("Jane Doe %d", i)
+		if i == 4 {
+			f.FEDWireMessage.Beneficiary.Personal.Name = "John 100% Smith"
+			f.FEDWireMessage.SenderDepositoryInstitution.SenderABANumber = "231380104"
+			f.FEDWireMessage.BusinessFunctionCode.BusinessFunctionCode = "XYZ" // invalid
+		}
+		require.NoError(t, repo.SaveFile(f))
+		time.Sleep(time.Millisecond) // order createdAt
+	}
+	defer func() {
+		for i := range amounts {
+			require.NoError(t, repo.DeleteFile(fmt.Sprintf("find-%d", i)))
+		}
+	}()
+
+	ids := func(page *FilePage) []string {
+		var out []string
+		for _, f := range page.Files {
+			out = append(out, f.ID)
+		}
+		return out
+	}
+	find := func(q FileQuery) *FilePage {
+		t.Helper()
+		page, err := repo.FindFiles(q)
+		require.NoError(t, err)
+		return page
+	}
+	min, max := int64(1000), int64(20000)
+	valid := false
+
+	tests := []struct {
+		query FileQuery
+		want  []string
+	}{
+		{FileQuery{}, []string{"find-0", "find-1", "find-2", "find-3", "find-4"}},
+		{FileQuery{Sort: "createdAt", Descending: true}, []string{"find-4", "find-3", "find-2", "find-1", "find-0"}},
+		{FileQuery{Sort: "cycleDate"}, []string{"find-4", "find-3", "find-2", "find-1", "find-0"}},
+		{FileQuery{Sort: "amount", Descending: true}, []string{"find-4", "find-3", "find-2", "find-1", "find-0"}},
+		{FileQuery{MinAmount: &min, MaxAmount: &max}, []string{"find-1", "find-2", "find-3"}},
+		{FileQuery{BusinessFunctionCode: "CTR"}, []string{"find-0", "find-1", "find-2", "find-3"}},
+		{FileQuery{TypeSubType: "1000", SenderABA: "231380104"}, []string{"find-4"}},
+		{FileQuery{ReceiverABA: "231380104"}, []string{"find-0", "find-1", "find-2", "find-3", "find-4"}},
+		{FileQuery{CycleDate: "20190412"}, []string{"find-2"}},
+		{FileQuery{BeneficiaryName: "jane doe"}, []string{"find-0", "find-1", "find-2", "find-3"}},
+		{FileQuery{BeneficiaryName: "0%"}, []string{"find-4"}},
+		{FileQuery{Valid: &valid}, []string{"find-4"}},
+		{FileQuery{SenderABA: "000000000"}, nil},
+	}
+	for _, tc := range tests {
+		page := find(tc.query)
+		require.Equal(t, tc.want, ids(page), "%+v", tc.query)
+		require.Equal(t, len(tc.want), page.Total, "%+v", tc.query)
+		require.Empty(t, page.NextCursor)
+	}
+
+	// page through amounts, where find-1 and find-2 tie
+	for _, descending := range []bool{false, true} {
+		q := FileQuery{Sort: "amount", Descending: descending, Limit: 2}
+		var got []string
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, 3)
+			page := find(q)
+			require.Equal(t, 5, page.Total)
+			got = append(got, ids(page)...)
+			if page.NextCursor == "" {
+				break
+			}
+			q.Cursor = page.NextCursor
+		}
+		want := []string{"find-0", "find-1", "find-2", "find-3", "find-4"}
+		if descending {
+			want = []string{"find-4", "find-3", "find-2", "find-1", "find-0"}
+		}
+		require.Equal(t, want, got)
+	}
+
+	// files saved again keep their place
+	file, err := repo.GetFile("find-0")
+	require.NoError(t, err)
+	require.NoError(t, repo.SaveFile(file))
+	require.Equal(t, "find-0", ids(find(FileQuery{Limit: 1}))[0])
+
+	_, err = repo.FindFiles(FileQuery{Cursor: "AAAA"})
+	require.Error(t, err)
+}
+
+func TestNewFileIndex(t *testing.T) {
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.ID = "foo"
+
+	createdAt := time.Now()
+	idx := newFileIndex(f, createdAt)
+	require.Equal(t, &fileIndex{
+		ID:                   "foo",
+		CreatedAt:            createdAt,
+		BusinessFunctionCode: "CTR",
+		TypeSubType:          "1000",
+		Amount:               1234567,
+		SenderABA:            "121042882",
+		ReceiverABA:          "231380104",
+		CycleDate:            "20190410",
+		BeneficiaryName:      "NAME",
+		Valid:                true,
+	}, idx)
+
+	require.Equal(t, &fileIndex{ID: "bar", CreatedAt: createdAt}, newFileIndex(&wire.File{ID: "bar"}, createdAt))
+}
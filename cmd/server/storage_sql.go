@@ -0,0 +1,452 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+
+	"github.com/moov-io/wire"
+)
+
+//go:embed migrations
+var migrations embed.FS
+
+var (
+	errFileIDInUse = errors.New("Wire File ID is in use by another tenant")
+)
+
+// sqlWireFileRepository stores files as JSON in the wire_files table of a SQLite or Postgres database. Each row
+// records the tenant of the file, and file IDs are unique across tenants. Files are encrypted when cipher is set.
+type sqlWireFileRepository struct {
+	db     *sql.DB
+	driver string
+	tenant string
+	cipher *fileCipher
+}
+
+// newSQLWireFileRepository opens the database and applies any migrations it is missing
+func newSQLWireFileRepository(driver, dsn string) (*sqlWireFileRepository, error) {
+	if dsn == "" {
+		return nil, fmt.Errorf("%s storage requires a data source name", driver)
+	}
+	if driver == "sqlite" && !strings.Contains(dsn, "?") {
+		// wait on other connections rather than failing with SQLITE_BUSY
+		dsn += "?_pragma=busy_timeout(5000)"
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if driver == "sqlite" {
+		db.SetMaxOpenConns(1)
+	}
+	r := &sqlWireFileRepository{db: db, driver: driver}
+	if err := r.migrate(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("migrating %s: %v", driver, err)
+	}
+	if err := r.reindex(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("indexing %s: %v", driver, err)
+	}
+	return r, nil
+}
+
+// migrate applies the migrations/<driver>/*.sql files in order, recording each version in wire_migrations.
+// On Postgres an advisory lock keeps replicas starting together from applying a migration twice.
+func (r *sqlWireFileRepository) migrate() error {
+	if _, err := r.db.Exec(`CREATE TABLE IF NOT EXISTS wire_migrations (version INTEGER PRIMARY KEY, applied_at TIMESTAMP NOT NULL)`); err != nil {
+		return err
+	}
+
+	dir := path.Join("migrations", r.driver)
+	entries, err := fs.ReadDir(migrations, dir)
+	if err != nil {
+		return err
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	for _, entry := range entries {
+		number, _, _ := strings.Cut(entry.Name(), "_")
+		version, err := strconv.Atoi(number)
+		if err != nil {
+			return fmt.Errorf("migration %s has no version number", entry.Name())
+		}
+		stmt, err := migrations.ReadFile(path.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		if err := r.applyMigration(version, string(stmt)); err != nil {
+			return fmt.Errorf("migration %s: %v", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
+func (r *sqlWireFileRepository) applyMigration(version int, stmt string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if r.driver == "postgres" {
+		if _, err := tx.Exec(`SELECT pg_advisory_xact_lock(7461096)`); err != nil {
+			return err
+		}
+	}
+	var applied int
+	if err := tx.QueryRow(r.rebind(`SELECT COUNT(*) FROM wire_migrations WHERE version = ?`), version).Scan(&applied); err != nil {
+		return err
+	}
+	if applied > 0 {
+		return nil
+	}
+	if _, err := tx.Exec(stmt); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(r.rebind(`INSERT INTO wire_migrations (version, applied_at) VALUES (?, ?)`), version, time.Now().UTC()); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// rebind replaces ? placeholders with the $1 style Postgres uses
+func (r *sqlWireFileRepository) rebind(query string) string {
+	if r.driver != "postgres" {
+		return query
+	}
+	var buf strings.Builder
+	n := 0
+	for _, c := range query {
+		if c == '?' {
+			n++
+			buf.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		buf.WriteRune(c)
+	}
+	return buf.String()
+}
+
+func (r *sqlWireFileRepository) ForTenant(tenantID string) WireFileRepository {
+	return &sqlWireFileRepository{db: r.db, driver: r.driver, tenant: tenantID, cipher: r.cipher}
+}
+
+// decode reads the file fileID stored in the file column
+func (r *sqlWireFileRepository) decode(fileID string, bs []byte) (*wire.File, error) {
+	return decodeSQLFile(r.cipher, r.tenant, fileID, bs)
+}
+
+func decodeSQLFile(cipher *fileCipher, tenantID, fileID string, bs []byte) (*wire.File, error) {
+	bs, err := cipher.open(bs, fileAdditionalData(tenantID, fileID))
+	if err != nil {
+		return nil, err
+	}
+	return decodeStoredFile(bs)
+}
+
+func (r *sqlWireFileRepository) GetFiles() ([]*wire.File, error) {
+	rows, err := r.db.Query(r.rebind(`SELECT file_id, file FROM wire_files WHERE tenant_id = ? ORDER BY created_nanos, file_id`), r.tenant)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*wire.File
+	for rows.Next() {
+		var id string
+		var bs []byte
+		if err := rows.Scan(&id, &bs); err != nil {
+			return nil, err
+		}
+		file, err := r.decode(id, bs)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, file)
+	}
+	return out, rows.Err()
+}
+
+func (r *sqlWireFileRepository) GetFile(fileId string) (*wire.File, error) {
+	var bs []byte
+	err := r.db.QueryRow(r.rebind(`SELECT file FROM wire_files WHERE file_id = ? AND tenant_id = ?`), fileId, r.tenant).Scan(&bs)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.decode(fileId, bs)
+}
+
+func (r *sqlWireFileRepository) SaveFile(file *wire.File) error {
+	if file.ID == "" {
+		return errors.New("empty Wire File ID")
+	}
+	bs, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+	if bs, err = r.cipher.seal(bs, fileAdditionalData(r.tenant, file.ID)); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	idx := r.cipher.index(newFileIndex(file, now))
+	query := `INSERT INTO wire_files (file_id, tenant_id, file, created_at, updated_at, created_nanos, ` + indexColumns + `)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (file_id) DO UPDATE SET file = excluded.file, updated_at = excluded.updated_at,
+business_function_code = excluded.business_function_code, type_sub_type = excluded.type_sub_type,
+amount = excluded.amount, sender_aba = excluded.sender_aba, receiver_aba = excluded.receiver_aba,
+cycle_date = excluded.cycle_date, beneficiary_name = excluded.beneficiary_name, valid = excluded.valid
+WHERE wire_files.tenant_id = excluded.tenant_id`
+	args := append([]interface{}{file.ID, r.tenant, string(bs), now, now, now.UnixNano()}, indexValues(idx)...)
+	res, err := r.db.Exec(r.rebind(query), args...)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return errFileIDInUse
+	}
+	return nil
+}
+
+// indexColumns are the columns FindFiles filters on, in the order of indexValues
+const indexColumns = `business_function_code, type_sub_type, amount, sender_aba, receiver_aba, cycle_date, beneficiary_name, valid`
+
+func indexValues(idx *fileIndex) []interface{} {
+	return []interface{}{idx.BusinessFunctionCode, idx.TypeSubType, idx.Amount, idx.SenderABA, idx.ReceiverABA,
+		idx.CycleDate, idx.BeneficiaryName, idx.Valid}
+}
+
+// reindex fills the index columns of files saved before they were added
+func (r *sqlWireFileRepository) reindex() error {
+	rows, err := r.db.Query(`SELECT file_id, tenant_id, file, created_at FROM wire_files WHERE created_nanos IS NULL`)
+	if err != nil {
+		return err
+	}
+	var indexes []*fileIndex
+	for rows.Next() {
+		var id, tenantID string
+		var bs []byte
+		var createdAt time.Time
+		if err := rows.Scan(&id, &tenantID, &bs, &createdAt); err != nil {
+			rows.Close()
+			return err
+		}
+		file, err := decodeSQLFile(r.cipher, tenantID, id, bs)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		file.ID = id
+		indexes = append(indexes, newFileIndex(file, createdAt))
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	query := r.rebind(`UPDATE wire_files SET created_nanos = ?, business_function_code = ?, type_sub_type = ?, amount = ?,
+sender_aba = ?, receiver_aba = ?, cycle_date = ?, beneficiary_name = ?, valid = ? WHERE file_id = ?`)
+	for _, idx := range indexes {
+		args := append([]interface{}{idx.CreatedAt.UnixNano()}, indexValues(idx)...)
+		if _, err := r.db.Exec(query, append(args, idx.ID)...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortColumns are the columns of each FileQuery sort
+var sortColumns = map[string]string{
+	"":          "created_nanos",
+	"createdAt": "created_nanos",
+	"amount":    "amount",
+	"cycleDate": "cycle_date",
+}
+
+func (r *sqlWireFileRepository) FindFiles(query FileQuery) (*FilePage, error) {
+	if err := r.cipher.checkQuery(query); err != nil {
+		return nil, err
+	}
+	where := []string{"tenant_id = ?"}
+	args := []interface{}{r.tenant}
+	filter := func(clause string, values ...interface{}) {
+		where = append(where, clause)
+		args = append(args, values...)
+	}
+	if query.BusinessFunctionCode != "" {
+		filter("business_function_code = ?", query.BusinessFunctionCode)
+	}
+	if query.TypeSubType != "" {
+		filter("type_sub_type = ?", query.TypeSubType)
+	}
+	if query.MinAmount != nil {
+		filter("amount >= ?", *query.MinAmount)
+	}
+	if query.MaxAmount != nil {
+		filter("amount <= ?", *query.MaxAmount)
+	}
+	if query.SenderABA != "" {
+		filter("sender_aba = ?", query.SenderABA)
+	}
+	if query.ReceiverABA != "" {
+		filter("receiver_aba = ?", query.ReceiverABA)
+	}
+	if query.CycleDate != "" {
+		filter("cycle_date = ?", query.CycleDate)
+	}
+	if query.BeneficiaryName != "" {
+		escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(strings.ToUpper(query.BeneficiaryName))
+		filter(`beneficiary_name LIKE ? ESCAPE '\'`, "%"+escaped+"%")
+	}
+	if query.Valid != nil {
+		filter("valid = ?", *query.Valid)
+	}
+
+	page := &FilePage{}
+	if err := r.db.QueryRow(r.rebind(`SELECT COUNT(*) FROM wire_files`+whereClause(where)), args...).Scan(&page.Total); err != nil {
+		return nil, err
+	}
+
+	column := sortColumns[query.Sort]
+	op, direction := ">", "ASC"
+	if query.Descending {
+		op, direction = "<", "DESC"
+	}
+	if query.Cursor != "" {
+		cursor, err := query.decodeCursor()
+		if err != nil {
+			return nil, err
+		}
+		var key interface{} = cursor.Key
+		if column != "cycle_date" {
+			n, err := strconv.ParseInt(cursor.Key, 10, 64)
+			if err != nil {
+				return nil, errInvalidCursor
+			}
+			key = n
+		}
+		filter(fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND file_id %[2]s ?))", column, op), key, key, cursor.ID)
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultFilesLimit
+	}
+
+	stmt := fmt.Sprintf(`SELECT file_id, file, created_nanos, amount, cycle_date FROM wire_files%s ORDER BY %s %s, file_id %s LIMIT %d`,
+		whereClause(where), column, direction, direction, limit+1)
+	rows, err := r.db.Query(r.rebind(stmt), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var last *fileIndex
+	for rows.Next() {
+		idx := &fileIndex{}
+		var bs []byte
+		var createdNanos int64
+		if err := rows.Scan(&idx.ID, &bs, &createdNanos, &idx.Amount, &idx.CycleDate); err != nil {
+			return nil, err
+		}
+		if len(page.Files) == limit {
+			page.NextCursor = query.encodeCursor(last)
+			break
+		}
+		file, err := r.decode(idx.ID, bs)
+		if err != nil {
+			return nil, err
+		}
+		idx.CreatedAt = time.Unix(0, createdNanos)
+		page.Files = append(page.Files, file)
+		last = idx
+	}
+	return page, rows.Err()
+}
+
+// reseal encrypts the files of every tenant which aren't encrypted with the first key of cipher, returning how many
+// it encrypted. Nothing is done without a cipher.
+func (r *sqlWireFileRepository) reseal() (int, error) {
+	if r.cipher == nil {
+		return 0, nil
+	}
+	outdated := `json_extract(file, '$.keyId') IS NOT ? OR json_extract(file, '$.wireEnvelope') IS NOT ?`
+	var version interface{} = sealedFileVersion
+	if r.driver == "postgres" {
+		outdated = `file->>'keyId' IS DISTINCT FROM ? OR file->>'wireEnvelope' IS DISTINCT FROM ?`
+		version = strconv.Itoa(sealedFileVersion)
+	}
+	rows, err := r.db.Query(r.rebind(`SELECT file_id, tenant_id, file FROM wire_files WHERE `+outdated), r.cipher.primary, version)
+	if err != nil {
+		return 0, err
+	}
+	type storedRow struct {
+		tenantID string
+		file     []byte
+	}
+	files := make(map[string]storedRow)
+	for rows.Next() {
+		var id string
+		var row storedRow
+		if err := rows.Scan(&id, &row.tenantID, &row.file); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		files[id] = row
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	n := 0
+	query := r.rebind(`UPDATE wire_files SET file = ?, beneficiary_name = NULL WHERE file_id = ?`)
+	for id, row := range files {
+		sealed, err := r.cipher.reseal(row.file, fileAdditionalData(row.tenantID, id))
+		if err != nil {
+			return n, fmt.Errorf("file %s: %v", id, err)
+		}
+		if _, err := r.db.Exec(query, string(sealed), id); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+func whereClause(where []string) string {
+	if len(where) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(where, " AND ")
+}
+
+func (r *sqlWireFileRepository) DeleteFile(fileId string) error {
+	if fileId == "" {
+		return errors.New("empty Wire File ID")
+	}
+	_, err := r.db.Exec(r.rebind(`DELETE FROM wire_files WHERE file_id = ? AND tenant_id = ?`), fileId, r.tenant)
+	return err
+}
+
+func (r *sqlWireFileRepository) Close() error {
+	return r.db.Close()
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gorilla/mux"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the spans of HTTP requests
+const tracerName = "github.com/moov-io/wire/cmd/server"
+
+// setupTracing exports spans to the exporter named by WIRE_TRACING_EXPORTER, stdout or otlp, and returns a func
+// flushing the spans left on shutdown. Tracing is disabled when WIRE_TRACING_EXPORTER is empty.
+func setupTracing(logger log.Logger, getenv func(string) string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	ctx := context.Background()
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch name := strings.ToLower(strings.TrimSpace(getenv("WIRE_TRACING_EXPORTER"))); name {
+	case "":
+		return func(context.Context) error { return nil }, nil
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case "otlp":
+		// the endpoint, headers and TLS of the exporter are set with the OTEL_EXPORTER_OTLP_* variables
+		exporter, err = otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("unknown WIRE_TRACING_EXPORTER %q (Options: stdout, otlp)", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("problem creating trace exporter: %v", err)
+	}
+
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES override the defaults
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", "wire"),
+			attribute.String("service.version", wire.Version),
+		),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading trace resource: %v", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	logger.Logf("exporting traces to %s", getenv("WIRE_TRACING_EXPORTER"))
+
+	return provider.Shutdown, nil
+}
+
+// tracingMiddleware starts a span for each request, continuing the trace of the caller's traceparent header.
+// Handlers pass the context of the request on, so reading, validating and writing files are its children.
+func tracingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+		route := routePath(r)
+		attrs := []attribute.KeyValue{
+			attribute.String("http.request.method", r.Method),
+			attribute.String("http.route", route),
+			attribute.String("url.path", r.URL.Path),
+		}
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			attrs = append(attrs, attribute.String("wire.request_id", requestID))
+		}
+		if fileID := mux.Vars(r)["fileId"]; fileID != "" {
+			attrs = append(attrs, attribute.String("wire.file_id", fileID))
+		}
+		ctx, span := otel.Tracer(tracerName).Start(ctx, r.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attrs...),
+		)
+		defer span.End()
+
+		sw := &statusResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.response.status_code", sw.status))
+		if sw.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(sw.status))
+		}
+	})
+}
+
+// statusResponseWriter records the status code written to a response
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status int
+	wrote  bool
+}
+
+func (w *statusResponseWriter) WriteHeader(code int) {
+	if !w.wrote {
+		w.status, w.wrote = code, true
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusResponseWriter) Write(b []byte) (int, error) {
+	w.wrote = true
+	return w.ResponseWriter.Write(b)
+}
+
+// traceFile adds the ID and business function code of file to the span of r
+func traceFile(r *http.Request, file *wire.File) {
+	attrs := []attribute.KeyValue{attribute.String("wire.file_id", file.ID)}
+	if bfc := file.FEDWireMessage.BusinessFunctionCode; bfc != nil {
+		attrs = append(attrs, attribute.String("wire.business_function_code", strings.TrimSpace(bfc.BusinessFunctionCode)))
+	}
+	trace.SpanFromContext(r.Context()).SetAttributes(attrs...)
+}
@@ -0,0 +1,124 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	"github.com/moov-io/base/log"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// mockTracing records spans in memory until the test ends
+func mockTracing(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		provider.Shutdown(context.Background())
+	})
+	return exporter
+}
+
+func TestSetupTracing(t *testing.T) {
+	logger := log.NewNopLogger()
+	env := func(exporter string) func(string) string {
+		return func(key string) string {
+			if key == "WIRE_TRACING_EXPORTER" {
+				return exporter
+			}
+			return ""
+		}
+	}
+
+	shutdown, err := setupTracing(logger, env(""))
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+
+	_, err = setupTracing(logger, env("zipkin"))
+	require.ErrorContains(t, err, "unknown WIRE_TRACING_EXPORTER")
+
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+	for _, name := range []string{"stdout", "OTLP"} {
+		shutdown, err := setupTracing(logger, env(name))
+		require.NoError(t, err, name)
+		_, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider)
+		require.True(t, ok, name)
+		require.NoError(t, shutdown(context.Background()), name)
+	}
+}
+
+func TestTracingMiddleware(t *testing.T) {
+	exporter := mockTracing(t)
+	shutdown, err := setupTracing(log.NewNopLogger(), func(string) string { return "" })
+	require.NoError(t, err)
+	defer shutdown(context.Background())
+
+	router := mux.NewRouter()
+	router.Use(tracingMiddleware)
+	addFileRoutes(log.NewNopLogger(), router, newMemoryWireFileRepository(), nil, nil)
+
+	bs, err := os.ReadFile(filepath.Join("..", "..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+
+	// the caller's trace is continued
+	traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+	req := httptest.NewRequest("POST", "/files/create", strings.NewReader(string(bs)))
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	spans := map[string]tracetest.SpanStub{}
+	for _, span := range exporter.GetSpans() {
+		if span.SpanContext.TraceID().String() == traceID {
+			spans[span.Name] = span
+		}
+	}
+	server, ok := spans["POST /files/create"]
+	require.True(t, ok)
+	require.Equal(t, trace.SpanKindServer, server.SpanKind)
+	require.Equal(t, "00f067aa0ba902b7", server.Parent.SpanID().String())
+	require.Contains(t, server.Attributes, attribute.Int("http.response.status_code", http.StatusCreated))
+	require.Contains(t, server.Attributes, attribute.String("wire.business_function_code", "CTR"))
+
+	read, ok := spans["wire.Reader.Read"]
+	require.True(t, ok)
+	require.Equal(t, server.SpanContext.SpanID(), read.Parent.SpanID())
+	require.Contains(t, spans, "wire.FEDWireMessage.verify")
+
+	// routes are named by their path template
+	fileID := base.ID()
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/"+fileID, nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	var found bool
+	for _, span := range exporter.GetSpans() {
+		if span.Name == "GET /files/{fileId}" {
+			found = true
+			require.Contains(t, span.Attributes, attribute.String("wire.file_id", fileID))
+			require.Contains(t, span.Attributes, attribute.Int("http.response.status_code", http.StatusNotFound))
+		}
+	}
+	require.True(t, found)
+}
@@ -0,0 +1,639 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+// Webhook event types. Each saved file produces file.created or file.updated followed by file.validated or
+// file.invalid, which carries the validation errors of the file.
+const (
+	eventFileCreated   = "file.created"
+	eventFileUpdated   = "file.updated"
+	eventFileValidated = "file.validated"
+	eventFileInvalid   = "file.invalid"
+	eventFileDeleted   = "file.deleted"
+)
+
+var webhookEventTypes = []string{eventFileCreated, eventFileUpdated, eventFileValidated, eventFileInvalid, eventFileDeleted}
+
+const (
+	// webhookSignatureHeader holds "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<unix seconds>.<body>">"
+	webhookSignatureHeader = "X-Wire-Signature"
+
+	defaultWebhookMaxAttempts = 5
+	defaultWebhookBackoff     = time.Second
+	// maxWebhookDeliveries is how many of the latest delivery attempts are kept for each subscription
+	maxWebhookDeliveries = 100
+)
+
+var (
+	errNoWebhookId = errors.New("no Webhook ID found")
+)
+
+// webhookSubscription is a URL events are POSTed to. Secret signs each payload and is only returned when the
+// subscription is created.
+type webhookSubscription struct {
+	ID     string `json:"id"`
+	URL    string `json:"url"`
+	Secret string `json:"secret,omitempty"`
+	// TenantID is the tenant which created the subscription, which only receives the events of its files
+	TenantID string `json:"-"`
+	// Events are the event types sent to URL, or every type when empty
+	Events    []string  `json:"events,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (s *webhookSubscription) wants(event webhookEvent) bool {
+	if s.TenantID != event.TenantID {
+		return false
+	}
+	if len(s.Events) == 0 {
+		return true
+	}
+	for _, e := range s.Events {
+		if e == event.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookEvent is the payload POSTed to subscriptions. It holds no contents of the file, such as account numbers and
+// names, which subscribers read from the API by FileID.
+type webhookEvent struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"createdAt"`
+	TenantID  string    `json:"tenantId,omitempty"`
+	FileID    string    `json:"fileId"`
+	// Errors are the validation errors of file.invalid events, without the values which failed
+	Errors []validationError `json:"errors,omitempty"`
+}
+
+// webhookDelivery is an attempt to deliver an event
+type webhookDelivery struct {
+	ID             string    `json:"id"`
+	SubscriptionID string    `json:"subscriptionId"`
+	EventID        string    `json:"eventId"`
+	EventType      string    `json:"eventType"`
+	Attempt        int       `json:"attempt"`
+	StatusCode     int       `json:"statusCode,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	Delivered      bool      `json:"delivered"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// webhookDispatcher holds the webhook subscriptions, which are kept in memory, and delivers events to them.
+// Failed deliveries are retried up to maxAttempts times, waiting backoff before the first retry and doubling it
+// before each following one.
+//
+// So callers can't have the server send requests into its own network, webhooks are only delivered over https to
+// public addresses. When allowedHosts is set, URLs must have one of its hosts instead, which may have any address.
+type webhookDispatcher struct {
+	logger       log.Logger
+	client       *http.Client
+	maxAttempts  int
+	backoff      time.Duration
+	allowedHosts map[string]bool
+	allowHTTP    bool
+
+	mu            sync.Mutex
+	subscriptions map[string]*webhookSubscription
+	deliveries    map[string][]webhookDelivery
+
+	wg     sync.WaitGroup
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newWebhookDispatcher(logger log.Logger, maxAttempts int, backoff time.Duration) *webhookDispatcher {
+	if maxAttempts <= 0 {
+		maxAttempts = defaultWebhookMaxAttempts
+	}
+	d := &webhookDispatcher{
+		logger:        logger,
+		maxAttempts:   maxAttempts,
+		backoff:       backoff,
+		subscriptions: make(map[string]*webhookSubscription),
+		deliveries:    make(map[string][]webhookDelivery),
+		closed:        make(chan struct{}),
+	}
+	// addresses are checked once resolved, so hosts can't resolve to another address after subscribing
+	dialer := &net.Dialer{Timeout: 5 * time.Second, Control: d.checkAddress}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	d.client = &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: transport,
+		// redirects could lead to any host, so they are failed deliveries
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	return d
+}
+
+// newWebhookDispatcherFromEnv reads WIRE_WEBHOOK_MAX_ATTEMPTS, WIRE_WEBHOOK_BACKOFF, WIRE_WEBHOOK_ALLOWED_HOSTS and
+// WIRE_WEBHOOK_ALLOW_HTTP
+func newWebhookDispatcherFromEnv(logger log.Logger, getenv func(string) string) (*webhookDispatcher, error) {
+	maxAttempts, backoff := defaultWebhookMaxAttempts, defaultWebhookBackoff
+	if v := getenv("WIRE_WEBHOOK_MAX_ATTEMPTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid WIRE_WEBHOOK_MAX_ATTEMPTS %q", v)
+		}
+		maxAttempts = n
+	}
+	if v := getenv("WIRE_WEBHOOK_BACKOFF"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("invalid WIRE_WEBHOOK_BACKOFF %q", v)
+		}
+		backoff = d
+	}
+	d := newWebhookDispatcher(logger, maxAttempts, backoff)
+	if v := getenv("WIRE_WEBHOOK_ALLOWED_HOSTS"); v != "" {
+		d.allowedHosts = make(map[string]bool)
+		for _, host := range strings.Split(v, ",") {
+			if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+				d.allowedHosts[host] = true
+			}
+		}
+	}
+	if v := getenv("WIRE_WEBHOOK_ALLOW_HTTP"); v != "" {
+		allow, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid WIRE_WEBHOOK_ALLOW_HTTP %q", v)
+		}
+		d.allowHTTP = allow
+	}
+	return d, nil
+}
+
+// checkURL returns an error when webhooks can't be delivered to u
+func (d *webhookDispatcher) checkURL(u *url.URL) error {
+	if u.Scheme != "https" && (u.Scheme != "http" || !d.allowHTTP) {
+		return fmt.Errorf("webhook URL %q must use https", u.Redacted())
+	}
+	host := strings.ToLower(u.Hostname())
+	if len(d.allowedHosts) > 0 {
+		if !d.allowedHosts[host] {
+			return fmt.Errorf("webhook host %q is not allowed", host)
+		}
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && !publicAddress(ip) {
+		return fmt.Errorf("webhook address %s is not public", ip)
+	}
+	return nil
+}
+
+// checkAddress refuses connections to addresses which aren't public, unless the hosts of webhooks are allowed
+func (d *webhookDispatcher) checkAddress(_, address string, _ syscall.RawConn) error {
+	if len(d.allowedHosts) > 0 {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !publicAddress(ip) {
+		return fmt.Errorf("webhook address %s is not public", host)
+	}
+	return nil
+}
+
+// publicAddress returns false for loopback, private, link-local (such as cloud metadata at 169.254.169.254),
+// multicast and unspecified addresses
+func publicAddress(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified()
+}
+
+func (d *webhookDispatcher) subscribe(sub webhookSubscription) (*webhookSubscription, error) {
+	u, err := url.Parse(sub.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid webhook URL %q", sub.URL)
+	}
+	if err := d.checkURL(u); err != nil {
+		return nil, err
+	}
+	for _, e := range sub.Events {
+		known := false
+		for _, t := range webhookEventTypes {
+			known = known || e == t
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown event %q", e)
+		}
+	}
+	if sub.Secret == "" {
+		bs := make([]byte, 32)
+		if _, err := rand.Read(bs); err != nil {
+			return nil, err
+		}
+		sub.Secret = hex.EncodeToString(bs)
+	}
+	sub.ID = base.ID()
+	sub.CreatedAt = time.Now().UTC()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.subscriptions[sub.ID] = &sub
+	return &sub, nil
+}
+
+// getSubscriptions returns the subscriptions of a tenant without their secrets, oldest first
+func (d *webhookDispatcher) getSubscriptions(tenantID string) []webhookSubscription {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	out := make([]webhookSubscription, 0, len(d.subscriptions))
+	for _, sub := range d.subscriptions {
+		if sub.TenantID != tenantID {
+			continue
+		}
+		s := *sub
+		s.Secret = ""
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].CreatedAt.Before(out[j].CreatedAt) || (out[i].CreatedAt.Equal(out[j].CreatedAt) && out[i].ID < out[j].ID)
+	})
+	return out
+}
+
+// getSubscription returns the subscription of a tenant without its secret, or nil
+func (d *webhookDispatcher) getSubscription(tenantID, id string) *webhookSubscription {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	sub, ok := d.subscriptions[id]
+	if !ok || sub.TenantID != tenantID {
+		return nil
+	}
+	s := *sub
+	s.Secret = ""
+	return &s
+}
+
+func (d *webhookDispatcher) unsubscribe(tenantID, id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if sub, ok := d.subscriptions[id]; ok && sub.TenantID == tenantID {
+		delete(d.subscriptions, id)
+		delete(d.deliveries, id)
+	}
+}
+
+// getDeliveries returns the latest delivery attempts of a subscription, newest first
+func (d *webhookDispatcher) getDeliveries(id string) []webhookDelivery {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	deliveries := d.deliveries[id]
+	out := make([]webhookDelivery, len(deliveries))
+	for i := range deliveries {
+		out[i] = deliveries[len(deliveries)-1-i]
+	}
+	return out
+}
+
+func (d *webhookDispatcher) record(delivery webhookDelivery) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.subscriptions[delivery.SubscriptionID]; !ok {
+		return // unsubscribed during delivery
+	}
+	deliveries := append(d.deliveries[delivery.SubscriptionID], delivery)
+	if len(deliveries) > maxWebhookDeliveries {
+		deliveries = deliveries[len(deliveries)-maxWebhookDeliveries:]
+	}
+	d.deliveries[delivery.SubscriptionID] = deliveries
+}
+
+// publish delivers event to each subscription wanting it in the background
+func (d *webhookDispatcher) publish(event webhookEvent) {
+	if d == nil {
+		return
+	}
+	event.ID = base.ID()
+	event.CreatedAt = time.Now().UTC()
+	body, err := json.Marshal(event)
+	if err != nil {
+		d.logger.LogErrorf("problem encoding %s event: %v", event.Type, err)
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	select {
+	case <-d.closed:
+		return
+	default:
+	}
+	for _, sub := range d.subscriptions {
+		if sub.wants(event) {
+			d.wg.Add(1)
+			go d.deliver(*sub, event, body)
+		}
+	}
+}
+
+func (d *webhookDispatcher) deliver(sub webhookSubscription, event webhookEvent, body []byte) {
+	defer d.wg.Done()
+
+	logger := d.logger.With(log.Fields{
+		"webhookID": log.String(sub.ID),
+		"eventID":   log.String(event.ID),
+		"event":     log.String(event.Type),
+	})
+	backoff := d.backoff
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		delivery := webhookDelivery{
+			ID:             base.ID(),
+			SubscriptionID: sub.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Attempt:        attempt,
+			Timestamp:      time.Now().UTC(),
+		}
+		status, err := d.post(sub, event, body, delivery.Timestamp)
+		delivery.StatusCode = status
+		if err == nil && (status < 200 || status > 299) {
+			err = fmt.Errorf("unexpected status %d", status)
+		}
+		if err != nil {
+			delivery.Error = err.Error()
+		}
+		delivery.Delivered = err == nil
+		d.record(delivery)
+		if err == nil {
+			return
+		}
+		logger.LogErrorf("webhook delivery attempt %d of %d failed: %v", attempt, d.maxAttempts, err)
+
+		if attempt < d.maxAttempts {
+			select {
+			case <-time.After(backoff):
+			case <-d.closed:
+				return
+			}
+			backoff *= 2
+		}
+	}
+}
+
+func (d *webhookDispatcher) post(sub webhookSubscription, event webhookEvent, body []byte, now time.Time) (int, error) {
+	req, err := http.NewRequest("POST", sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Wire-Event", event.Type)
+	req.Header.Set("X-Wire-Event-ID", event.ID)
+	req.Header.Set(webhookSignatureHeader, signWebhook(sub.Secret, now, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	return resp.StatusCode, nil
+}
+
+// signWebhook returns the X-Wire-Signature of body sent at now. Receivers recompute the HMAC-SHA256 of
+// "<t>.<body>" with the secret of their subscription and compare it to v1.
+func signWebhook(secret string, now time.Time, body []byte) string {
+	ts := strconv.FormatInt(now.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%s,v1=%s", ts, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// Close stops retrying deliveries and waits for those in flight
+func (d *webhookDispatcher) Close() error {
+	d.once.Do(func() {
+		d.mu.Lock()
+		close(d.closed)
+		d.mu.Unlock()
+	})
+	d.wg.Wait()
+	return nil
+}
+
+// webhookWireFileRepository publishes an event for each file saved or deleted through it
+type webhookWireFileRepository struct {
+	WireFileRepository
+	webhooks *webhookDispatcher
+	tenant   string
+}
+
+func newWebhookWireFileRepository(repo WireFileRepository, webhooks *webhookDispatcher) *webhookWireFileRepository {
+	return &webhookWireFileRepository{WireFileRepository: repo, webhooks: webhooks}
+}
+
+func (r *webhookWireFileRepository) ForTenant(tenantID string) WireFileRepository {
+	return &webhookWireFileRepository{
+		WireFileRepository: r.WireFileRepository.ForTenant(tenantID),
+		webhooks:           r.webhooks,
+		tenant:             tenantID,
+	}
+}
+
+func (r *webhookWireFileRepository) SaveFile(file *wire.File) error {
+	existing, err := r.WireFileRepository.GetFile(file.ID)
+	if err != nil {
+		existing = nil // the save reports storage errors
+	}
+	if err := r.WireFileRepository.SaveFile(file); err != nil {
+		return err
+	}
+
+	eventType := eventFileCreated
+	if existing != nil {
+		eventType = eventFileUpdated
+	}
+	r.webhooks.publish(webhookEvent{Type: eventType, TenantID: r.tenant, FileID: file.ID})
+
+	if errs := validateMessage(file, nil); len(errs) > 0 {
+		r.webhooks.publish(webhookEvent{Type: eventFileInvalid, TenantID: r.tenant, FileID: file.ID, Errors: maskValidationErrors(errs)})
+	} else {
+		r.webhooks.publish(webhookEvent{Type: eventFileValidated, TenantID: r.tenant, FileID: file.ID})
+	}
+	return nil
+}
+
+func (r *webhookWireFileRepository) DeleteFile(fileId string) error {
+	existing, err := r.WireFileRepository.GetFile(fileId)
+	if err != nil {
+		existing = nil
+	}
+	if err := r.WireFileRepository.DeleteFile(fileId); err != nil {
+		return err
+	}
+	if existing != nil {
+		r.webhooks.publish(webhookEvent{Type: eventFileDeleted, TenantID: r.tenant, FileID: fileId})
+	}
+	return nil
+}
+
+// Close closes the wrapped repository
+func (r *webhookWireFileRepository) Close() error {
+	if closer, ok := r.WireFileRepository.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func addWebhookRoutes(logger log.Logger, r *mux.Router, webhooks *webhookDispatcher) {
+	r.Methods("GET").Path("/webhooks").HandlerFunc(getWebhooks(logger, webhooks))
+	r.Methods("POST").Path("/webhooks").HandlerFunc(createWebhook(logger, webhooks))
+	r.Methods("GET").Path("/webhooks/{webhookId}").HandlerFunc(getWebhook(logger, webhooks))
+	r.Methods("DELETE").Path("/webhooks/{webhookId}").HandlerFunc(deleteWebhook(logger, webhooks))
+	r.Methods("GET").Path("/webhooks/{webhookId}/deliveries").HandlerFunc(getWebhookDeliveries(logger, webhooks))
+}
+
+func getWebhookId(w http.ResponseWriter, r *http.Request) string {
+	v, ok := mux.Vars(r)["webhookId"]
+	if !ok || v == "" {
+		moovhttp.Problem(w, errNoWebhookId)
+		return ""
+	}
+	return v
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func getWebhooks(logger log.Logger, webhooks *webhookDispatcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		writeJSON(w, http.StatusOK, webhooks.getSubscriptions(requestTenantID(r)))
+	}
+}
+
+func createWebhook(logger log.Logger, webhooks *webhookDispatcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		var req webhookSubscription
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			err = logger.LogErrorf("error reading request body: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		req.TenantID = requestTenantID(r)
+		sub, err := webhooks.subscribe(req)
+		if err != nil {
+			err = logger.LogErrorf("problem creating webhook: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+		logger.Set("webhookID", log.String(sub.ID)).Log("created webhook")
+
+		writeJSON(w, http.StatusCreated, sub)
+	}
+}
+
+func getWebhook(logger log.Logger, webhooks *webhookDispatcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		webhookId := getWebhookId(w, r)
+		if webhookId == "" {
+			return
+		}
+		sub := webhooks.getSubscription(requestTenantID(r), webhookId)
+		if sub == nil {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(w, http.StatusOK, sub)
+	}
+}
+
+func deleteWebhook(logger log.Logger, webhooks *webhookDispatcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		webhookId := getWebhookId(w, r)
+		if webhookId == "" {
+			return
+		}
+		webhooks.unsubscribe(requestTenantID(r), webhookId)
+		logger.Set("webhookID", log.String(webhookId)).Log("deleted webhook")
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func getWebhookDeliveries(logger log.Logger, webhooks *webhookDispatcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		webhookId := getWebhookId(w, r)
+		if webhookId == "" {
+			return
+		}
+		if webhooks.getSubscription(requestTenantID(r), webhookId) == nil {
+			http.NotFound(w, r)
+			return
+		}
+		writeJSON(w, http.StatusOK, webhooks.getDeliveries(webhookId))
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookReceiver records the events POSTed to it, failing the first failures requests
+type webhookReceiver struct {
+	mu       sync.Mutex
+	failures int
+	events   []webhookEvent
+	headers  []http.Header
+	bodies   [][]byte
+}
+
+func (rcv *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+
+	if rcv.failures > 0 {
+		rcv.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	var event webhookEvent
+	json.Unmarshal(body, &event)
+	rcv.events = append(rcv.events, event)
+	rcv.headers = append(rcv.headers, r.Header.Clone())
+	rcv.bodies = append(rcv.bodies, body)
+}
+
+func (rcv *webhookReceiver) types() []string {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+
+	var out []string
+	for _, e := range rcv.events {
+		out = append(out, e.Type)
+	}
+	return out
+}
+
+// allowTestReceivers lets webhooks deliver to httptest servers, which listen on 127.0.0.1 without TLS
+func allowTestReceivers(webhooks *webhookDispatcher) {
+	webhooks.allowHTTP = true
+	webhooks.allowedHosts = map[string]bool{"127.0.0.1": true}
+}
+
+func TestWebhooks_deliver(t *testing.T) {
+	rcv := &webhookReceiver{failures: 2}
+	server := httptest.NewServer(rcv)
+	defer server.Close()
+
+	webhooks := newWebhookDispatcher(log.NewNopLogger(), 3, time.Millisecond)
+	allowTestReceivers(webhooks)
+	sub, err := webhooks.subscribe(webhookSubscription{URL: server.URL, Events: []string{eventFileCreated}})
+	require.NoError(t, err)
+	require.NotEmpty(t, sub.Secret)
+
+	repo := newWebhookWireFileRepository(newMemoryWireFileRepository(), webhooks)
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.ID = "foo"
+	require.NoError(t, repo.SaveFile(f))
+	require.NoError(t, repo.SaveFile(f)) // updates aren't subscribed to
+	require.Eventually(t, func() bool { return len(webhooks.getDeliveries(sub.ID)) == 3 }, 5*time.Second, time.Millisecond)
+	require.NoError(t, webhooks.Close())
+
+	require.Equal(t, []string{eventFileCreated}, rcv.types())
+	require.Equal(t, "foo", rcv.events[0].FileID)
+	require.Equal(t, eventFileCreated, rcv.headers[0].Get("X-Wire-Event"))
+
+	// the signature is the HMAC of the timestamp and body
+	signature := rcv.headers[0].Get(webhookSignatureHeader)
+	ts, _, _ := strings.Cut(strings.TrimPrefix(signature, "t="), ",")
+	sent, err := strconv.ParseInt(ts, 10, 64)
+	require.NoError(t, err)
+	require.InDelta(t, time.Now().Unix(), sent, 5)
+	require.Equal(t, signature, signWebhook(sub.Secret, time.Unix(sent, 0), rcv.bodies[0]))
+
+	// both failed attempts and the delivery are logged, newest first
+	deliveries := webhooks.getDeliveries(sub.ID)
+	require.Len(t, deliveries, 3)
+	require.True(t, deliveries[0].Delivered)
+	require.Equal(t, 3, deliveries[0].Attempt)
+	require.False(t, deliveries[2].Delivered)
+	require.Equal(t, http.StatusServiceUnavailable, deliveries[2].StatusCode)
+	require.Equal(t, "unexpected status 503", deliveries[2].Error)
+}
+
+func TestWebhooks_events(t *testing.T) {
+	rcv := &webhookReceiver{}
+	server := httptest.NewServer(rcv)
+	defer server.Close()
+
+	webhooks := newWebhookDispatcher(log.NewNopLogger(), 1, 0)
+	allowTestReceivers(webhooks)
+	_, err := webhooks.subscribe(webhookSubscription{URL: server.URL})
+	require.NoError(t, err)
+
+	repo := newWebhookWireFileRepository(newMemoryWireFileRepository(), webhooks)
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.ID = "foo"
+	require.NoError(t, repo.SaveFile(f))
+	f.FEDWireMessage.Amount = nil
+	require.NoError(t, repo.SaveFile(f))
+	require.NoError(t, repo.DeleteFile("foo"))
+	require.NoError(t, repo.DeleteFile("foo")) // already deleted
+	require.NoError(t, webhooks.Close())
+
+	types := rcv.types()
+	require.ElementsMatch(t, []string{eventFileCreated, eventFileValidated, eventFileUpdated, eventFileInvalid, eventFileDeleted}, types)
+	for _, e := range rcv.events {
+		if e.Type == eventFileInvalid {
+			require.Equal(t, "Amount", e.Errors[0].Field)
+			require.Nil(t, e.Errors[0].Value)
+		}
+	}
+
+	// events don't carry the contents of files
+	for _, body := range rcv.bodies {
+		require.NotContains(t, string(body), f.FEDWireMessage.Beneficiary.Personal.Identifier)
+		require.NotContains(t, string(body), f.FEDWireMessage.Beneficiary.Personal.Name)
+	}
+}
+
+func TestWebhooks_routes(t *testing.T) {
+	webhooks := newWebhookDispatcher(log.NewNopLogger(), 1, 0)
+	defer webhooks.Close()
+	router := mux.NewRouter()
+	addWebhookRoutes(log.NewNopLogger(), router, webhooks)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(method, path, bytes.NewReader([]byte(body))))
+		w.Flush()
+		return w
+	}
+
+	w := serve("POST", "/webhooks", `{"url":"https://example.com/wire","secret":"s3cret","events":["file.deleted"]}`)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body)
+	var sub webhookSubscription
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&sub))
+	require.Equal(t, "s3cret", sub.Secret)
+
+	// secrets are only returned when created
+	w = serve("GET", "/webhooks", "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	var subs []webhookSubscription
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&subs))
+	require.Len(t, subs, 1)
+	require.Empty(t, subs[0].Secret)
+
+	w = serve("GET", "/webhooks/"+sub.ID, "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	w = serve("GET", "/webhooks/"+sub.ID+"/deliveries", "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, "[]\n", w.Body.String())
+
+	w = serve("DELETE", "/webhooks/"+sub.ID, "")
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body)
+	w = serve("GET", "/webhooks/"+sub.ID, "")
+	require.Equal(t, http.StatusNotFound, w.Code, w.Body)
+	w = serve("GET", "/webhooks/"+sub.ID+"/deliveries", "")
+	require.Equal(t, http.StatusNotFound, w.Code, w.Body)
+
+	for _, body := range []string{
+		`{"url":"ftp://example.com"}`,
+		`{"url":"http://example.com"}`,
+		`{"url":"https://127.0.0.1/wire"}`,
+		`{"url":"https://169.254.169.254/latest/meta-data"}`,
+		`{"url":"https://10.0.0.1/wire"}`,
+		`{"url":"https://[::1]/wire"}`,
+		`{"url":"https://example.com","events":["file.read"]}`,
+		`{`,
+	} {
+		w = serve("POST", "/webhooks", body)
+		require.Equal(t, http.StatusBadRequest, w.Code, body)
+	}
+}
+
+func TestWebhooks_env(t *testing.T) {
+	env := map[string]string{"WIRE_WEBHOOK_MAX_ATTEMPTS": "2", "WIRE_WEBHOOK_BACKOFF": "5s"}
+	webhooks, err := newWebhookDispatcherFromEnv(log.NewNopLogger(), func(k string) string { return env[k] })
+	require.NoError(t, err)
+	require.Equal(t, 2, webhooks.maxAttempts)
+	require.Equal(t, 5*time.Second, webhooks.backoff)
+
+	require.False(t, webhooks.allowHTTP)
+	require.Nil(t, webhooks.allowedHosts)
+
+	env["WIRE_WEBHOOK_ALLOWED_HOSTS"] = "hooks.internal, Payments.Internal"
+	env["WIRE_WEBHOOK_ALLOW_HTTP"] = "true"
+	webhooks, err = newWebhookDispatcherFromEnv(log.NewNopLogger(), func(k string) string { return env[k] })
+	require.NoError(t, err)
+	require.True(t, webhooks.allowHTTP)
+	_, err = webhooks.subscribe(webhookSubscription{URL: "http://payments.internal:8080/wire"})
+	require.NoError(t, err)
+	_, err = webhooks.subscribe(webhookSubscription{URL: "https://example.com/wire"})
+	require.ErrorContains(t, err, "not allowed")
+
+	env["WIRE_WEBHOOK_ALLOW_HTTP"] = "maybe"
+	_, err = newWebhookDispatcherFromEnv(log.NewNopLogger(), func(k string) string { return env[k] })
+	require.Error(t, err)
+
+	env["WIRE_WEBHOOK_MAX_ATTEMPTS"] = "0"
+	_, err = newWebhookDispatcherFromEnv(log.NewNopLogger(), func(k string) string { return env[k] })
+	require.Error(t, err)
+}
+
+func TestWebhooks_privateAddresses(t *testing.T) {
+	rcv := &webhookReceiver{}
+	server := httptest.NewServer(rcv)
+	defer server.Close()
+
+	// hosts are checked once resolved, so a name of a private address isn't delivered to
+	webhooks := newWebhookDispatcher(log.NewNopLogger(), 1, 0)
+	webhooks.allowHTTP = true
+	sub, err := webhooks.subscribe(webhookSubscription{URL: strings.Replace(server.URL, "127.0.0.1", "localhost", 1)})
+	require.NoError(t, err)
+
+	repo := newWebhookWireFileRepository(newMemoryWireFileRepository(), webhooks)
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.ID = "foo"
+	require.NoError(t, repo.SaveFile(f))
+	require.NoError(t, webhooks.Close())
+
+	require.Empty(t, rcv.types())
+	deliveries := webhooks.getDeliveries(sub.ID)
+	require.NotEmpty(t, deliveries)
+	require.False(t, deliveries[0].Delivered)
+	require.Contains(t, deliveries[0].Error, "is not public")
+}
+
+func TestWebhooks_redirects(t *testing.T) {
+	redirect := httptest.NewServer(http.RedirectHandler("http://169.254.169.254/latest/meta-data", http.StatusFound))
+	defer redirect.Close()
+
+	webhooks := newWebhookDispatcher(log.NewNopLogger(), 1, 0)
+	allowTestReceivers(webhooks)
+	sub, err := webhooks.subscribe(webhookSubscription{URL: redirect.URL, Events: []string{eventFileDeleted}})
+	require.NoError(t, err)
+
+	webhooks.publish(webhookEvent{Type: eventFileDeleted, FileID: "foo"})
+	require.NoError(t, webhooks.Close())
+
+	deliveries := webhooks.getDeliveries(sub.ID)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, http.StatusFound, deliveries[0].StatusCode)
+	require.False(t, deliveries[0].Delivered)
+}
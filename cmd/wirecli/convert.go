@@ -0,0 +1,76 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/moov-io/wire"
+)
+
+func runConvert(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	validateOpts := validateFlags(fs)
+	format := fs.String("format", "fixed", "Output format (Options: fixed, variable, json)")
+	newline := fs.Bool("newline", true, "Write each tag on its own line, for fixed and variable formats")
+	output := fs.String("o", "", "Write to this file instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprintln(stderr, "convert requires one file")
+		return exitError
+	}
+	switch *format {
+	case "fixed", "variable", "json":
+	default:
+		fmt.Fprintf(stderr, "unknown format %q\n", *format)
+		return exitError
+	}
+
+	path := fs.Arg(0)
+	file, invalid, err := readFile(path, stdin, validateOpts())
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", path, err)
+		return exitError
+	}
+	if invalid != nil {
+		fmt.Fprintf(stderr, "%s: invalid: %v\n", path, invalid)
+		return exitInvalid
+	}
+
+	w := stdout
+	if *output != "" {
+		fd, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitError
+		}
+		defer fd.Close()
+		w = fd
+	}
+
+	if *format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(file)
+	} else {
+		opts := []wire.OptionFunc{wire.VariableLengthFields(*format == "variable")}
+		if !*newline {
+			opts = append(opts, wire.NewlineCharacter(""))
+		}
+		err = wire.NewWriter(w, opts...).Write(file)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", path, err)
+		return exitError
+	}
+	return exitOK
+}
@@ -0,0 +1,71 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/moov-io/wire"
+)
+
+func runDiff(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	ignoreOutput := fs.Bool("ignore-output", false, "Ignore the tags appended to output messages ({1100}, {1110}, {1120} and {1130})")
+	asJSON := fs.Bool("json", false, "Print the differences as JSON")
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	if fs.NArg() != 2 {
+		fmt.Fprintln(stderr, "diff requires two files")
+		return exitError
+	}
+	if fs.Arg(0) == "-" && fs.Arg(1) == "-" {
+		// stdin is read once, so only one of the files can be read from it
+		fmt.Fprintln(stderr, "diff can read only one file from stdin")
+		return exitError
+	}
+
+	var files [2]*wire.File
+	for i := range files {
+		path := fs.Arg(i)
+		file, invalid, err := readFile(path, stdin, nil)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", path, err)
+			return exitError
+		}
+		if invalid != nil {
+			// invalid messages are still compared, such as a repaired message against the original
+			fmt.Fprintf(stderr, "warning: %s: %v\n", path, invalid)
+		}
+		files[i] = file
+	}
+
+	diffs := wire.DiffWithOpts(&files[0].FEDWireMessage, &files[1].FEDWireMessage, wire.DiffOpts{
+		IgnoreOutputTags: *ignoreOutput,
+	})
+	if *asJSON {
+		if diffs == nil {
+			diffs = []wire.Difference{}
+		}
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(diffs); err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitError
+		}
+	} else {
+		for _, d := range diffs {
+			fmt.Fprintln(stdout, d.String())
+		}
+	}
+	if len(diffs) > 0 {
+		return exitDifferent
+	}
+	return exitOK
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// wirecli works with FEDWireMessage files from the command line.
+//
+// Usage:
+//
+//	wirecli validate [-skipMandatoryIMAD] [-allowMissingSenderSupplied] <file>...
+//	wirecli convert [-format fixed|variable|json] [-newline=false] [-o output] <file>
+//	wirecli print <file>
+//	wirecli diff [-ignore-output] [-json] <a> <b>
+//
+// Files are fixed or variable length FED files or JSON, "-" reads stdin, which diff reads for one file only.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/moov-io/wire"
+)
+
+// Exit codes
+const (
+	// exitOK is returned when the command succeeded, for diff when the messages have no differences
+	exitOK = 0
+	// exitInvalid is returned when a file is invalid
+	exitInvalid = 1
+	// exitDifferent is returned by diff when the messages differ
+	exitDifferent = 1
+	// exitError is returned for invalid usage and files which cannot be read
+	exitError = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command in args and returns the exit code
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitError
+	}
+	switch args[0] {
+	case "validate":
+		return runValidate(args[1:], stdin, stdout, stderr)
+	case "convert":
+		return runConvert(args[1:], stdin, stdout, stderr)
+	case "print":
+		return runPrint(args[1:], stdin, stdout, stderr)
+	case "diff":
+		return runDiff(args[1:], stdin, stdout, stderr)
+	case "-h", "-help", "--help", "help":
+		usage(stdout)
+		return exitOK
+	}
+	fmt.Fprintf(stderr, "unknown command %q\n", args[0])
+	usage(stderr)
+	return exitError
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintf(w, `wirecli works with FEDWireMessage files
+
+Usage:
+  wirecli validate [flags] <file>...              Validate files
+  wirecli convert [flags] <file>                  Convert a file between fixed, variable length and JSON
+  wirecli print [flags] <file>                    Print the tags and fields of a file
+  wirecli diff [-ignore-output] [-json] <a> <b>   Print the differences between two files
+
+Files are fixed or variable length FED files or JSON, "-" reads stdin, which diff reads for one file only. Run
+"wirecli <command> -h" for the flags of a command.
+
+Exit codes:
+  0  success, or no differences
+  1  a file is invalid, or the files differ
+  2  invalid usage or a file cannot be read
+`)
+}
+
+// validateFlags adds the ValidateOpts flags to fs
+func validateFlags(fs *flag.FlagSet) func() *wire.ValidateOpts {
+	skipMandatoryIMAD := fs.Bool("skipMandatoryIMAD", false, "Skip checking that InputMessageAccountabilityData is present")
+	allowMissingSenderSupplied := fs.Bool("allowMissingSenderSupplied", false, "Allow SenderSupplied to be omitted")
+	return func() *wire.ValidateOpts {
+		if !*skipMandatoryIMAD && !*allowMissingSenderSupplied {
+			return nil
+		}
+		return &wire.ValidateOpts{
+			SkipMandatoryIMAD:          *skipMandatoryIMAD,
+			AllowMissingSenderSupplied: *allowMissingSenderSupplied,
+		}
+	}
+}
+
+// readFile reads a FED or JSON file from path, or stdin when path is "-", and validates it with opts.
+//
+// invalid describes why a file which was read is invalid, err is returned when the file cannot be read.
+func readFile(path string, stdin io.Reader, opts *wire.ValidateOpts) (file *wire.File, invalid error, err error) {
+	var bs []byte
+	if path == "-" {
+		bs, err = io.ReadAll(stdin)
+	} else {
+		bs, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if isJSON(bs) {
+		file, err := wire.FileFromJSON(bs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if opts != nil {
+			file.SetValidation(opts)
+		}
+		return file, file.Validate(), nil
+	}
+
+	f, invalid := wire.NewReader(bytes.NewReader(bs)).ReadWithOpts(opts)
+	if invalid == nil && len(bytes.TrimSpace(bs)) == 0 {
+		invalid = fmt.Errorf("no message found")
+	}
+	return &f, invalid, nil
+}
+
+// isJSON returns true if bs holds a JSON object rather than FED tags, which start with "{" and a digit
+func isJSON(bs []byte) bool {
+	bs = bytes.TrimSpace(bs)
+	return len(bs) > 1 && bs[0] == '{' && (bs[1] < '0' || bs[1] > '9')
+}
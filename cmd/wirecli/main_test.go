@@ -0,0 +1,166 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func testdata(name string) string {
+	return filepath.Join("..", "..", "test", "testdata", name)
+}
+
+func runCLI(t *testing.T, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_usage(t *testing.T) {
+	code, _, stderr := runCLI(t, "")
+	require.Equal(t, exitError, code)
+	require.Contains(t, stderr, "Usage")
+
+	code, _, _ = runCLI(t, "", "unknown")
+	require.Equal(t, exitError, code)
+
+	code, stdout, _ := runCLI(t, "", "help")
+	require.Equal(t, exitOK, code)
+	require.Contains(t, stdout, "wirecli diff")
+}
+
+func TestRun_diff(t *testing.T) {
+	txt, jsn := testdata("fedWireMessage-CustomerTransfer.txt"), testdata("fedWireMessage-CustomerTransfer.json")
+
+	code, stdout, stderr := runCLI(t, "", "diff", txt, txt)
+	require.Equal(t, exitOK, code, stderr)
+	require.Empty(t, stdout)
+
+	code, stdout, stderr = runCLI(t, "", "diff", txt, testdata("fedWireMessage-BankTransfer.txt"))
+	require.Equal(t, exitDifferent, code, stderr)
+	require.Contains(t, stdout, "{3600} BusinessFunctionCode changed")
+
+	// stdin and JSON
+	bs, err := os.ReadFile(jsn)
+	require.NoError(t, err)
+	code, stdout, stderr = runCLI(t, string(bs), "diff", "-json", "-", txt)
+	require.Equal(t, exitOK, code, stdout+stderr)
+	var diffs []wire.Difference
+	require.NoError(t, json.Unmarshal([]byte(stdout), &diffs))
+	require.Empty(t, diffs)
+
+	code, _, _ = runCLI(t, "", "diff", txt)
+	require.Equal(t, exitError, code)
+
+	code, _, stderr = runCLI(t, string(bs), "diff", "-", "-")
+	require.Equal(t, exitError, code)
+	require.Contains(t, stderr, "stdin")
+
+	code, _, stderr = runCLI(t, "", "diff", txt, "missing.txt")
+	require.Equal(t, exitError, code)
+	require.Contains(t, stderr, "missing.txt")
+}
+
+func TestRun_diffIgnoreOutput(t *testing.T) {
+	plain := testdata("fedWireMessage-CustomerTransfer.txt")
+	bs, err := os.ReadFile(plain)
+	require.NoError(t, err)
+
+	// append output tags to a copy of the message
+	out := filepath.Join(t.TempDir(), "output.txt")
+	require.NoError(t, os.WriteFile(out, append([]byte("{1100}30P 2\n"), bs...), 0600))
+
+	code, stdout, _ := runCLI(t, "", "diff", plain, out)
+	require.Equal(t, exitDifferent, code)
+	require.Contains(t, stdout, "{1100}")
+
+	code, stdout, _ = runCLI(t, "", "diff", "-ignore-output", plain, out)
+	require.Equal(t, exitOK, code, stdout)
+}
+
+func TestRun_validate(t *testing.T) {
+	code, stdout, _ := runCLI(t, "", "validate", testdata("fedWireMessage-BankTransfer.txt"), testdata("fedWireMessage-CustomerTransfer.json"))
+	require.Equal(t, exitOK, code)
+	require.Equal(t, 2, strings.Count(stdout, ": valid"))
+
+	code, stdout, _ = runCLI(t, "", "validate", "-q", testdata("fedWireMessage-BankTransfer.txt"), testdata("fedWireMessage-MissingRequiredTag.txt"))
+	require.Equal(t, exitInvalid, code)
+	require.NotContains(t, stdout, ": valid")
+	require.Contains(t, stdout, "fedWireMessage-MissingRequiredTag.txt: invalid")
+
+	code, _, _ = runCLI(t, "", "validate", "missing.txt")
+	require.Equal(t, exitError, code)
+
+	code, _, _ = runCLI(t, "", "validate")
+	require.Equal(t, exitError, code)
+}
+
+func TestRun_validateOpts(t *testing.T) {
+	bs, err := os.ReadFile(testdata("fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+	var lines []string
+	for _, line := range strings.Split(string(bs), "\n") {
+		if !strings.HasPrefix(line, "{1520}") {
+			lines = append(lines, line)
+		}
+	}
+	noIMAD := strings.Join(lines, "\n")
+
+	code, _, _ := runCLI(t, noIMAD, "validate", "-")
+	require.Equal(t, exitInvalid, code)
+
+	code, stdout, _ := runCLI(t, noIMAD, "validate", "-skipMandatoryIMAD", "-")
+	require.Equal(t, exitOK, code, stdout)
+}
+
+func TestRun_convert(t *testing.T) {
+	txt := testdata("fedWireMessage-CustomerTransfer.txt")
+
+	code, stdout, stderr := runCLI(t, "", "convert", "-format", "json", txt)
+	require.Equal(t, exitOK, code, stderr)
+	file, err := wire.FileFromJSON([]byte(stdout))
+	require.NoError(t, err)
+	require.Equal(t, "000001234567", file.FEDWireMessage.Amount.Amount)
+
+	// JSON back to a variable length file on one line
+	code, variable, stderr := runCLI(t, stdout, "convert", "-format", "variable", "-newline=false", "-")
+	require.Equal(t, exitOK, code, stderr)
+	require.NotContains(t, variable, "\n")
+
+	code, stdout, stderr = runCLI(t, variable, "convert", "-")
+	require.Equal(t, exitOK, code, stderr)
+	code, _, _ = runCLI(t, stdout, "diff", "-", txt)
+	require.Equal(t, exitOK, code)
+
+	out := filepath.Join(t.TempDir(), "out.txt")
+	code, _, _ = runCLI(t, "", "convert", "-o", out, txt)
+	require.Equal(t, exitOK, code)
+	require.FileExists(t, out)
+
+	code, _, _ = runCLI(t, "", "convert", "-format", "xml", txt)
+	require.Equal(t, exitError, code)
+
+	code, _, _ = runCLI(t, "", "convert", testdata("fedWireMessage-MissingRequiredTag.txt"))
+	require.Equal(t, exitInvalid, code)
+}
+
+func TestRun_print(t *testing.T) {
+	code, stdout, stderr := runCLI(t, "", "print", testdata("fedWireMessage-CustomerTransfer.txt"))
+	require.Equal(t, exitOK, code, stderr)
+	require.Contains(t, stdout, "{2000} Amount\n")
+	require.Regexp(t, `SenderABANumber\s+121042882`, stdout)
+
+	code, _, _ = runCLI(t, "", "print")
+	require.Equal(t, exitError, code)
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+func runPrint(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("print", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	validateOpts := validateFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprintln(stderr, "print requires one file")
+		return exitError
+	}
+
+	path := fs.Arg(0)
+	file, invalid, err := readFile(path, stdin, validateOpts())
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", path, err)
+		return exitError
+	}
+	if invalid != nil {
+		// print what was read, invalid files are often the ones which need to be looked at
+		fmt.Fprintf(stderr, "warning: %s: %v\n", path, invalid)
+	}
+
+	w := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	tag := ""
+	for _, f := range file.FEDWireMessage.Fields() {
+		if f.Tag != tag {
+			if tag != "" {
+				fmt.Fprintln(w)
+			}
+			tag = f.Tag
+			fmt.Fprintf(w, "%s %s\n", f.Tag, f.Name)
+		}
+		if f.Field != "" {
+			fmt.Fprintf(w, "  %s\t%s\n", f.Field, f.Value)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	if invalid != nil {
+		return exitInvalid
+	}
+	return exitOK
+}
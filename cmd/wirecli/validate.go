@@ -0,0 +1,41 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+)
+
+func runValidate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	validateOpts := validateFlags(fs)
+	quiet := fs.Bool("q", false, "Only print invalid files")
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	if fs.NArg() == 0 {
+		fmt.Fprintln(stderr, "validate requires at least one file")
+		return exitError
+	}
+
+	code := exitOK
+	for _, path := range fs.Args() {
+		_, invalid, err := readFile(path, stdin, validateOpts())
+		switch {
+		case err != nil:
+			fmt.Fprintf(stderr, "%s: %v\n", path, err)
+			return exitError
+		case invalid != nil:
+			fmt.Fprintf(stdout, "%s: invalid: %v\n", path, invalid)
+			code = exitInvalid
+		case !*quiet:
+			fmt.Fprintf(stdout, "%s: valid\n", path)
+		}
+	}
+	return code
+}
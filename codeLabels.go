@@ -0,0 +1,172 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// businessFunctionCodeLabels are readable names of BusinessFunctionCode values
+var businessFunctionCodeLabels = map[string]string{
+	BankTransfer:                     "Bank Transfer",
+	CheckSameDaySettlement:           "Check Same Day Settlement",
+	CustomerTransferPlus:             "Customer Transfer Plus",
+	CustomerTransfer:                 "Customer Transfer",
+	DepositSendersAccount:            "Deposit to Sender's Account",
+	BankDrawDownRequest:              "Bank-to-Bank Drawdown Request",
+	CustomerCorporateDrawdownRequest: "Customer or Corporate Drawdown Request",
+	DrawdownResponse:                 "Drawdown Payment",
+	FEDFundsReturned:                 "Fed Funds Returned",
+	FEDFundsSold:                     "Fed Funds Sold",
+	BFCServiceMessage:                "Service Message",
+}
+
+// typeCodeLabels are readable names of TypeSubType TypeCode values
+var typeCodeLabels = map[string]string{
+	FundsTransfer:      "Funds Transfer",
+	ForeignTransfer:    "Foreign Transfer",
+	SettlementTransfer: "Settlement Transfer",
+}
+
+// subTypeCodeLabels are readable names of TypeSubType SubTypeCode values
+var subTypeCodeLabels = map[string]string{
+	BasicFundsTransfer:              "Basic Funds Transfer",
+	RequestReversal:                 "Request for Reversal",
+	ReversalTransfer:                "Reversal of Transfer",
+	RequestReversalPriorDayTransfer: "Request for Reversal of a Prior Day Transfer",
+	ReversalPriorDayTransfer:        "Reversal of a Prior Day Transfer",
+	RequestCredit:                   "Request for Credit (Drawdown)",
+	FundsTransferRequestCredit:      "Funds Transfer Honoring a Request for Credit",
+	RefusalRequestCredit:            "Refusal to Honor a Request for Credit",
+	SSIServiceMessage:               "Service Message",
+}
+
+// chargeDetailsLabels are readable names of Charges ChargeDetails values
+var chargeDetailsLabels = map[string]string{
+	CDBeneficiary: "Beneficiary",
+	CDShared:      "Shared",
+}
+
+// localInstrumentLabels are readable names of LocalInstrument LocalInstrumentCode values
+var localInstrumentLabels = map[string]string{
+	ANSIX12format:                   "ANSI X12 format",
+	SequenceBCoverPaymentStructured: "Sequence B Cover Payment Structured",
+	GeneralXMLformat:                "General XML format",
+	ISO20022XMLformat:               "ISO 20022 XML format",
+	NarrativeText:                   "Narrative Text",
+	ProprietaryLocalInstrumentCode:  "Proprietary Local Instrument Code",
+	RemittanceInformationStructured: "Remittance Information Structured",
+	RelatedRemittanceInformation:    "Related Remittance Information",
+	STP820format:                    "STP 820 format",
+	SWIFTfield70:                    "SWIFT field 70",
+	UNEDIFACTformat:                 "UN/EDIFACT format",
+}
+
+// identificationCodeLabels are readable names of FinancialInstitution and Personal IdentificationCode values
+var identificationCodeLabels = map[string]string{
+	SWIFTBankIdentifierCode:       "SWIFT BIC",
+	CHIPSParticipant:              "CHIPS Participant",
+	DemandDepositAccountNumber:    "Account Number",
+	FEDRoutingNumber:              "Fed Routing Number",
+	SWIFTBICORBEIANDAccountNumber: "SWIFT BIC or BEI and Account Number",
+	CHIPSIdentifier:               "CHIPS Identifier",
+	PassportNumber:                "Passport Number",
+	TaxIdentificationNumber:       "Tax Identification Number",
+	DriversLicenseNumber:          "Driver's License Number",
+	AlienRegistrationNumber:       "Alien Registration Number",
+	CorporateIdentification:       "Corporate Identification",
+	OtherIdentification:           "Other Identification",
+}
+
+// adviceCodeLabels are readable names of Advice AdviceCode values
+var adviceCodeLabels = map[string]string{
+	AdviceCodeHold:   "Hold",
+	AdviceCodeLetter: "Letter",
+	AdviceCodePhone:  "Phone",
+	AdviceCodeTelex:  "Telex",
+	AdviceCodeWire:   "Wire",
+}
+
+// documentTypeCodeLabels are readable names of remittance document DocumentTypeCode values
+var documentTypeCodeLabels = map[string]string{
+	AccountsReceivableOpenItem:           "Accounts Receivable Open Item",
+	BillLadingShippingNotice:             "Bill of Lading Shipping Notice",
+	CommercialInvoice:                    "Commercial Invoice",
+	CommercialContract:                   "Commercial Contract",
+	CreditNoteRelatedFinancialAdjustment: "Credit Note Related to Financial Adjustment",
+	CreditNote:                           "Credit Note",
+	DebitNote:                            "Debit Note",
+	DispatchAdvice:                       "Dispatch Advice",
+	DebitNoteRelatedFinancialAdjustment:  "Debit Note Related to Financial Adjustment",
+	HireInvoice:                          "Hire Invoice",
+	MeteredServiceInvoice:                "Metered Service Invoice",
+	ProprietaryDocumentType:              "Proprietary Document Type",
+	PurchaseOrder:                        "Purchase Order",
+	SelfBilledInvoice:                    "Self Billed Invoice",
+	StatementAccount:                     "Statement of Account",
+	TradeServicesUtilityTransaction:      "Trade Services Utility Transaction",
+	Voucher:                              "Voucher",
+}
+
+// adjustmentReasonCodeLabels are readable names of Adjustment AdjustmentReasonCode values
+var adjustmentReasonCodeLabels = map[string]string{
+	PricingError:           "Pricing Error",
+	ExtensionError:         "Extension Error",
+	ItemNotAcceptedDamaged: "Item Not Accepted (Damaged)",
+	ItemNotAcceptedQuality: "Item Not Accepted (Quality)",
+	QuantityContested:      "Quantity Contested",
+	IncorrectProduct:       "Incorrect Product",
+	ReturnsDamaged:         "Returns (Damaged)",
+	ReturnsQuality:         "Returns (Quality)",
+	ItemNotReceived:        "Item Not Received",
+	TotalOrderNotReceived:  "Total Order Not Received",
+	CreditAgreed:           "Credit as Agreed",
+	CoveredCreditMemo:      "Covered by Credit Memo",
+}
+
+// label returns the label of code in labels, or code when it has no label
+func label(labels map[string]string, code string) string {
+	if l, ok := labels[code]; ok {
+		return l
+	}
+	return code
+}
+
+// BusinessFunctionCodeLabel returns the readable name of a BusinessFunctionCode, such as "Customer Transfer"
+func BusinessFunctionCodeLabel(code string) string {
+	return label(businessFunctionCodeLabels, code)
+}
+
+// TypeSubTypeLabel returns the readable name of a TypeCode and SubTypeCode, such as "Funds Transfer, Basic Funds
+// Transfer"
+func TypeSubTypeLabel(typeCode, subTypeCode string) string {
+	return label(typeCodeLabels, typeCode) + ", " + label(subTypeCodeLabels, subTypeCode)
+}
+
+// ChargeDetailsLabel returns the readable name of a ChargeDetails code, such as "Shared"
+func ChargeDetailsLabel(code string) string {
+	return label(chargeDetailsLabels, code)
+}
+
+// LocalInstrumentLabel returns the readable name of a LocalInstrumentCode, such as "Narrative Text"
+func LocalInstrumentLabel(code string) string {
+	return label(localInstrumentLabels, code)
+}
+
+// IdentificationCodeLabel returns the readable name of an IdentificationCode, such as "Fed Routing Number"
+func IdentificationCodeLabel(code string) string {
+	return label(identificationCodeLabels, code)
+}
+
+// AdviceCodeLabel returns the readable name of an AdviceCode, such as "Letter"
+func AdviceCodeLabel(code string) string {
+	return label(adviceCodeLabels, code)
+}
+
+// DocumentTypeCodeLabel returns the readable name of a remittance DocumentTypeCode, such as "Commercial Invoice"
+func DocumentTypeCodeLabel(code string) string {
+	return label(documentTypeCodeLabels, code)
+}
+
+// AdjustmentReasonCodeLabel returns the readable name of an AdjustmentReasonCode, such as "Pricing Error"
+func AdjustmentReasonCodeLabel(code string) string {
+	return label(adjustmentReasonCodeLabels, code)
+}
@@ -0,0 +1,524 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+)
+
+// ConfirmationFormat is the output format of a Renderer
+type ConfirmationFormat string
+
+const (
+	// ConfirmationText renders plain text
+	ConfirmationText ConfirmationFormat = "text"
+	// ConfirmationMarkdown renders Markdown
+	ConfirmationMarkdown ConfirmationFormat = "markdown"
+	// ConfirmationHTML renders an HTML fragment, with values escaped
+	ConfirmationHTML ConfirmationFormat = "html"
+)
+
+// ConfirmationParty is a party or financial institution of a Confirmation
+type ConfirmationParty struct {
+	Name string
+	// Identification is the label of the IdentificationCode, such as "Fed Routing Number"
+	Identification     string
+	IdentificationCode string
+	Identifier         string
+	Address            []string
+}
+
+// ConfirmationAdvice is an advice tag of a Confirmation
+type ConfirmationAdvice struct {
+	// Name is the tag, such as "Beneficiary Advice"
+	Name string
+	// Advice is the label of the AdviceCode, such as "Letter"
+	Advice string
+	Lines  []string
+}
+
+// ConfirmationContact is the contact of the beneficiary from PaymentNotification
+type ConfirmationContact struct {
+	Name   string
+	Phone  string
+	Mobile string
+	// ElectronicAddress is where the beneficiary is notified, such as an email address
+	ElectronicAddress string
+}
+
+// Confirmation is the readable summary of a FEDWireMessage passed to Renderer templates. Codes are resolved to labels.
+type Confirmation struct {
+	BusinessFunction     string
+	BusinessFunctionCode string
+	Type                 string
+	// Amount is formatted with a thousands separator and decimal point, such as 12,345.67
+	Amount                    string
+	Currency                  string
+	IMAD                      string
+	OMAD                      string
+	SenderReference           string
+	PreviousMessageIdentifier string
+	EndToEndIdentification    string
+
+	Sender   ConfirmationParty
+	Receiver ConfirmationParty
+
+	Originator    *ConfirmationParty
+	OriginatorFI  *ConfirmationParty
+	Beneficiary   *ConfirmationParty
+	BeneficiaryFI *ConfirmationParty
+
+	LocalInstrument string
+	Charges         string
+	// Notification is the contact of the beneficiary from PaymentNotification
+	Notification *PaymentNotification
+	// Contact is the contact of Notification, or nil when it has none
+	Contact *ConfirmationContact
+	// Information is the OriginatorToBeneficiary text
+	Information []string
+	Advices     []ConfirmationAdvice
+}
+
+// NewConfirmation returns the Confirmation of fwm
+func NewConfirmation(fwm *FEDWireMessage) *Confirmation {
+	c := &Confirmation{Currency: "USD"}
+	if fwm.BusinessFunctionCode != nil {
+		c.BusinessFunctionCode = fwm.BusinessFunctionCode.BusinessFunctionCode
+		c.BusinessFunction = BusinessFunctionCodeLabel(c.BusinessFunctionCode)
+	}
+	if fwm.TypeSubType != nil {
+		c.Type = TypeSubTypeLabel(fwm.TypeSubType.TypeCode, fwm.TypeSubType.SubTypeCode)
+	}
+	if fwm.Amount != nil {
+		c.Amount = formatAmount(fwm.Amount.Amount)
+	}
+	if fwm.InputMessageAccountabilityData != nil {
+		c.IMAD = fwm.InputMessageAccountabilityData.Identifier()
+	}
+	if fwm.OutputMessageAccountabilityData != nil {
+		c.OMAD = fwm.OutputMessageAccountabilityData.Identifier()
+	}
+	if fwm.SenderReference != nil {
+		c.SenderReference = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.PreviousMessageIdentifier != nil {
+		c.PreviousMessageIdentifier = strings.TrimSpace(fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	}
+	if fwm.SenderDepositoryInstitution != nil {
+		c.Sender = ConfirmationParty{
+			Name:               fwm.SenderDepositoryInstitution.SenderShortName,
+			Identification:     IdentificationCodeLabel(FEDRoutingNumber),
+			IdentificationCode: FEDRoutingNumber,
+			Identifier:         fwm.SenderDepositoryInstitution.SenderABANumber,
+		}
+	}
+	if fwm.ReceiverDepositoryInstitution != nil {
+		c.Receiver = ConfirmationParty{
+			Name:               fwm.ReceiverDepositoryInstitution.ReceiverShortName,
+			Identification:     IdentificationCodeLabel(FEDRoutingNumber),
+			IdentificationCode: FEDRoutingNumber,
+			Identifier:         fwm.ReceiverDepositoryInstitution.ReceiverABANumber,
+		}
+	}
+	if fwm.Originator != nil {
+		c.Originator = personalParty(fwm.Originator.Personal)
+	} else if fwm.OriginatorOptionF != nil {
+		c.Originator = optionFParty(fwm.OriginatorOptionF)
+	}
+	if fwm.OriginatorFI != nil {
+		c.OriginatorFI = institutionParty(fwm.OriginatorFI.FinancialInstitution)
+	}
+	if fwm.Beneficiary != nil {
+		c.Beneficiary = personalParty(fwm.Beneficiary.Personal)
+	}
+	if fwm.BeneficiaryFI != nil {
+		c.BeneficiaryFI = institutionParty(fwm.BeneficiaryFI.FinancialInstitution)
+	}
+	if fwm.LocalInstrument != nil {
+		c.LocalInstrument = LocalInstrumentLabel(fwm.LocalInstrument.LocalInstrumentCode)
+	}
+	if fwm.Charges != nil {
+		c.Charges = ChargeDetailsLabel(fwm.Charges.ChargeDetails)
+	}
+	if fwm.PaymentNotification != nil {
+		c.Notification = fwm.PaymentNotification
+		c.EndToEndIdentification = strings.TrimSpace(fwm.PaymentNotification.EndToEndIdentification)
+		c.Contact = notificationContact(fwm.PaymentNotification)
+	}
+	if otb := fwm.OriginatorToBeneficiary; otb != nil {
+		c.Information = nonEmpty(otb.LineOne, otb.LineTwo, otb.LineThree, otb.LineFour)
+	}
+	if fwm.FIDrawdownDebitAccountAdvice != nil {
+		c.Advices = append(c.Advices, confirmationAdvice("Drawdown Debit Account Advice", fwm.FIDrawdownDebitAccountAdvice.Advice))
+	}
+	if fwm.FIBeneficiaryFIAdvice != nil {
+		c.Advices = append(c.Advices, confirmationAdvice("Beneficiary FI Advice", fwm.FIBeneficiaryFIAdvice.Advice))
+	}
+	if fwm.FIIntermediaryFIAdvice != nil {
+		c.Advices = append(c.Advices, confirmationAdvice("Intermediary FI Advice", fwm.FIIntermediaryFIAdvice.Advice))
+	}
+	if fwm.FIBeneficiaryAdvice != nil {
+		c.Advices = append(c.Advices, confirmationAdvice("Beneficiary Advice", fwm.FIBeneficiaryAdvice.Advice))
+	}
+	return c
+}
+
+func personalParty(p Personal) *ConfirmationParty {
+	return &ConfirmationParty{
+		Name:               strings.TrimSpace(p.Name),
+		Identification:     IdentificationCodeLabel(p.IdentificationCode),
+		IdentificationCode: p.IdentificationCode,
+		Identifier:         strings.TrimSpace(p.Identifier),
+		Address:            nonEmpty(p.Address.AddressLineOne, p.Address.AddressLineTwo, p.Address.AddressLineThree),
+	}
+}
+
+func institutionParty(fi FinancialInstitution) *ConfirmationParty {
+	return &ConfirmationParty{
+		Name:               strings.TrimSpace(fi.Name),
+		Identification:     IdentificationCodeLabel(fi.IdentificationCode),
+		IdentificationCode: fi.IdentificationCode,
+		Identifier:         strings.TrimSpace(fi.Identifier),
+		Address:            nonEmpty(fi.Address.AddressLineOne, fi.Address.AddressLineTwo, fi.Address.AddressLineThree),
+	}
+}
+
+func optionFParty(oof *OriginatorOptionF) *ConfirmationParty {
+	party := &ConfirmationParty{Identifier: strings.TrimSpace(oof.PartyIdentifier)}
+	details, err := oof.Details()
+	if err != nil {
+		party.Name = strings.TrimSpace(oof.Name)
+		return party
+	}
+	party.Name = strings.Join(details.Name, " ")
+	party.Address = append(party.Address, details.Address...)
+	if !details.CountryTown.IsZero() {
+		party.Address = append(party.Address, details.CountryTown.String())
+	}
+	return party
+}
+
+func notificationContact(pn *PaymentNotification) *ConfirmationContact {
+	contact := &ConfirmationContact{
+		Name:              strings.TrimSpace(pn.ContactName),
+		Phone:             strings.TrimSpace(pn.ContactPhoneNumber),
+		Mobile:            strings.TrimSpace(pn.ContactMobileNumber),
+		ElectronicAddress: strings.TrimSpace(pn.ContactNotificationElectronicAddress),
+	}
+	if *contact == (ConfirmationContact{}) {
+		return nil
+	}
+	return contact
+}
+
+func confirmationAdvice(name string, advice Advice) ConfirmationAdvice {
+	return ConfirmationAdvice{
+		Name:   name,
+		Advice: AdviceCodeLabel(advice.AdviceCode),
+		Lines:  nonEmpty(advice.LineOne, advice.LineTwo, advice.LineThree, advice.LineFour, advice.LineFive, advice.LineSix),
+	}
+}
+
+// nonEmpty returns the lines which are not blank, without surrounding spaces
+func nonEmpty(lines ...string) []string {
+	var out []string
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			out = append(out, line)
+		}
+	}
+	return out
+}
+
+// formatAmount formats an Amount in cents, such as 000001234567, as 12,345.67
+func formatAmount(amount string) string {
+	amount = strings.TrimLeft(strings.TrimSpace(amount), "0")
+	for len(amount) < 3 {
+		amount = "0" + amount
+	}
+	dollars, cents := amount[:len(amount)-2], amount[len(amount)-2:]
+	var buf strings.Builder
+	for i, r := range dollars {
+		if i > 0 && (len(dollars)-i)%3 == 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String() + "." + cents
+}
+
+// Renderer renders the Confirmation of a FEDWireMessage as plain text, Markdown or HTML.
+//
+// Each format has a default template which SetTemplate replaces. Templates are executed with a *Confirmation. The
+// zero value renders every format with the default templates.
+type Renderer struct {
+	text map[ConfirmationFormat]*texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// templateFuncs are the functions available to Renderer templates
+var templateFuncs = map[string]interface{}{
+	"join": strings.Join,
+	"md":   escapeMarkdown,
+}
+
+// defaultRenderer renders the formats without a template of their own
+var defaultRenderer = NewRenderer()
+
+// NewRenderer returns a Renderer with the default templates
+func NewRenderer() *Renderer {
+	r := &Renderer{
+		text: make(map[ConfirmationFormat]*texttemplate.Template),
+	}
+	for format, tmpl := range map[ConfirmationFormat]string{
+		ConfirmationText:     defaultTextConfirmation,
+		ConfirmationMarkdown: defaultMarkdownConfirmation,
+		ConfirmationHTML:     defaultHTMLConfirmation,
+	} {
+		if err := r.SetTemplate(format, tmpl); err != nil {
+			panic(fmt.Sprintf("default %s confirmation template: %v", format, err))
+		}
+	}
+	return r
+}
+
+// SetTemplate replaces the template of format. HTML templates are parsed with html/template, which escapes values.
+func (r *Renderer) SetTemplate(format ConfirmationFormat, tmpl string) error {
+	switch format {
+	case ConfirmationText, ConfirmationMarkdown:
+		t, err := texttemplate.New(string(format)).Funcs(templateFuncs).Parse(tmpl)
+		if err != nil {
+			return err
+		}
+		if r.text == nil {
+			r.text = make(map[ConfirmationFormat]*texttemplate.Template)
+		}
+		r.text[format] = t
+	case ConfirmationHTML:
+		t, err := htmltemplate.New(string(format)).Funcs(templateFuncs).Parse(tmpl)
+		if err != nil {
+			return err
+		}
+		r.html = t
+	default:
+		return fmt.Errorf("unknown confirmation format %q", format)
+	}
+	return nil
+}
+
+// Render writes the Confirmation of fwm to w in format
+func (r *Renderer) Render(w io.Writer, format ConfirmationFormat, fwm *FEDWireMessage) error {
+	c := NewConfirmation(fwm)
+	switch format {
+	case ConfirmationText, ConfirmationMarkdown:
+		t, ok := r.text[format]
+		if !ok {
+			t = defaultRenderer.text[format]
+		}
+		return t.Execute(w, c)
+	case ConfirmationHTML:
+		t := r.html
+		if t == nil {
+			t = defaultRenderer.html
+		}
+		return t.Execute(w, c)
+	}
+	return fmt.Errorf("unknown confirmation format %q", format)
+}
+
+// markdownEscaper escapes the characters with a meaning in Markdown
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `<`, `\<`, `>`, `\>`, `#`, `\#`, `|`, `\|`,
+)
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+const defaultTextConfirmation = `WIRE TRANSFER CONFIRMATION
+{{.BusinessFunction}} ({{.BusinessFunctionCode}}){{if .Type}} - {{.Type}}{{end}}
+
+Amount: {{.Amount}} {{.Currency}}
+{{- if .IMAD}}
+IMAD: {{.IMAD}}{{end}}
+{{- if .OMAD}}
+OMAD: {{.OMAD}}{{end}}
+{{- if .SenderReference}}
+Sender Reference: {{.SenderReference}}{{end}}
+{{- if .EndToEndIdentification}}
+End-to-End Identification: {{.EndToEndIdentification}}{{end}}
+{{- if .PreviousMessageIdentifier}}
+Previous Message: {{.PreviousMessageIdentifier}}{{end}}
+
+Sender: {{.Sender.Name}} ({{.Sender.Identification}} {{.Sender.Identifier}})
+Receiver: {{.Receiver.Name}} ({{.Receiver.Identification}} {{.Receiver.Identifier}})
+{{- define "party"}}{{.Name}}{{if .Identifier}} ({{if .Identification}}{{.Identification}} {{end}}{{.Identifier}}){{end}}{{range .Address}}
+  {{.}}{{end}}{{end}}
+{{- with .Originator}}
+
+Originator: {{template "party" .}}{{end}}
+{{- with .OriginatorFI}}
+
+Originator FI: {{template "party" .}}{{end}}
+{{- with .BeneficiaryFI}}
+
+Beneficiary FI: {{template "party" .}}{{end}}
+{{- with .Beneficiary}}
+
+Beneficiary: {{template "party" .}}{{end}}
+{{- if or .LocalInstrument .Charges}}
+{{end}}
+{{- if .LocalInstrument}}
+Local Instrument: {{.LocalInstrument}}{{end}}
+{{- if .Charges}}
+Charges: {{.Charges}}{{end}}
+{{- with .Contact}}
+
+Beneficiary Contact:{{if .Name}}
+  Name: {{.Name}}{{end}}{{if .Phone}}
+  Phone: {{.Phone}}{{end}}{{if .Mobile}}
+  Mobile: {{.Mobile}}{{end}}{{if .ElectronicAddress}}
+  Electronic Address: {{.ElectronicAddress}}{{end}}{{end}}
+{{- if .Information}}
+
+Information for Beneficiary:{{range .Information}}
+  {{.}}{{end}}{{end}}
+{{- range .Advices}}
+
+{{.Name}}{{if .Advice}} ({{.Advice}}){{end}}:{{range .Lines}}
+  {{.}}{{end}}{{end}}
+`
+
+const defaultMarkdownConfirmation = `# Wire Transfer Confirmation
+
+**{{md .BusinessFunction}}** ({{.BusinessFunctionCode}}){{if .Type}} - {{md .Type}}{{end}}
+
+| | |
+|---|---|
+| Amount | {{.Amount}} {{.Currency}} |
+{{- if .IMAD}}
+| IMAD | {{md .IMAD}} |{{end}}
+{{- if .OMAD}}
+| OMAD | {{md .OMAD}} |{{end}}
+{{- if .SenderReference}}
+| Sender Reference | {{md .SenderReference}} |{{end}}
+{{- if .EndToEndIdentification}}
+| End-to-End Identification | {{md .EndToEndIdentification}} |{{end}}
+{{- if .PreviousMessageIdentifier}}
+| Previous Message | {{md .PreviousMessageIdentifier}} |{{end}}
+| Sender | {{md .Sender.Name}} ({{.Sender.Identification}} {{md .Sender.Identifier}}) |
+| Receiver | {{md .Receiver.Name}} ({{.Receiver.Identification}} {{md .Receiver.Identifier}}) |
+{{- if .LocalInstrument}}
+| Local Instrument | {{md .LocalInstrument}} |{{end}}
+{{- if .Charges}}
+| Charges | {{md .Charges}} |{{end}}
+{{- define "party"}}{{md .Name}}{{if .Identifier}}
+{{if .Identification}}{{.Identification}} {{end}}{{md .Identifier}}{{end}}{{range .Address}}
+{{md .}}{{end}}{{end}}
+{{- with .Originator}}
+
+## Originator
+
+{{template "party" .}}{{end}}
+{{- with .OriginatorFI}}
+
+## Originator FI
+
+{{template "party" .}}{{end}}
+{{- with .BeneficiaryFI}}
+
+## Beneficiary FI
+
+{{template "party" .}}{{end}}
+{{- with .Beneficiary}}
+
+## Beneficiary
+
+{{template "party" .}}{{end}}
+{{- with .Contact}}
+
+## Beneficiary Contact
+
+| | |
+|---|---|
+{{- if .Name}}
+| Name | {{md .Name}} |{{end}}
+{{- if .Phone}}
+| Phone | {{md .Phone}} |{{end}}
+{{- if .Mobile}}
+| Mobile | {{md .Mobile}} |{{end}}
+{{- if .ElectronicAddress}}
+| Electronic Address | {{md .ElectronicAddress}} |{{end}}{{end}}
+{{- if .Information}}
+
+## Information for Beneficiary
+{{range .Information}}
+{{md .}}  {{end}}{{end}}
+{{- range .Advices}}
+
+## {{.Name}}{{if .Advice}} ({{.Advice}}){{end}}
+{{range .Lines}}
+{{md .}}  {{end}}{{end}}
+`
+
+const defaultHTMLConfirmation = `<div class="wire-confirmation">
+<h1>Wire Transfer Confirmation</h1>
+<p><strong>{{.BusinessFunction}}</strong> ({{.BusinessFunctionCode}}){{if .Type}} - {{.Type}}{{end}}</p>
+<table>
+<tr><th>Amount</th><td>{{.Amount}} {{.Currency}}</td></tr>
+{{- if .IMAD}}
+<tr><th>IMAD</th><td>{{.IMAD}}</td></tr>{{end}}
+{{- if .OMAD}}
+<tr><th>OMAD</th><td>{{.OMAD}}</td></tr>{{end}}
+{{- if .SenderReference}}
+<tr><th>Sender Reference</th><td>{{.SenderReference}}</td></tr>{{end}}
+{{- if .EndToEndIdentification}}
+<tr><th>End-to-End Identification</th><td>{{.EndToEndIdentification}}</td></tr>{{end}}
+{{- if .PreviousMessageIdentifier}}
+<tr><th>Previous Message</th><td>{{.PreviousMessageIdentifier}}</td></tr>{{end}}
+<tr><th>Sender</th><td>{{.Sender.Name}} ({{.Sender.Identification}} {{.Sender.Identifier}})</td></tr>
+<tr><th>Receiver</th><td>{{.Receiver.Name}} ({{.Receiver.Identification}} {{.Receiver.Identifier}})</td></tr>
+{{- if .LocalInstrument}}
+<tr><th>Local Instrument</th><td>{{.LocalInstrument}}</td></tr>{{end}}
+{{- if .Charges}}
+<tr><th>Charges</th><td>{{.Charges}}</td></tr>{{end}}
+</table>
+{{- define "party"}}<p>{{.Name}}{{if .Identifier}}<br>{{if .Identification}}{{.Identification}} {{end}}{{.Identifier}}{{end}}{{range .Address}}<br>{{.}}{{end}}</p>{{end}}
+{{- with .Originator}}
+<h2>Originator</h2>
+{{template "party" .}}{{end}}
+{{- with .OriginatorFI}}
+<h2>Originator FI</h2>
+{{template "party" .}}{{end}}
+{{- with .BeneficiaryFI}}
+<h2>Beneficiary FI</h2>
+{{template "party" .}}{{end}}
+{{- with .Beneficiary}}
+<h2>Beneficiary</h2>
+{{template "party" .}}{{end}}
+{{- with .Contact}}
+<h2>Beneficiary Contact</h2>
+<table>
+{{- if .Name}}
+<tr><th>Name</th><td>{{.Name}}</td></tr>{{end}}
+{{- if .Phone}}
+<tr><th>Phone</th><td>{{.Phone}}</td></tr>{{end}}
+{{- if .Mobile}}
+<tr><th>Mobile</th><td>{{.Mobile}}</td></tr>{{end}}
+{{- if .ElectronicAddress}}
+<tr><th>Electronic Address</th><td>{{.ElectronicAddress}}</td></tr>{{end}}
+</table>{{end}}
+{{- if .Information}}
+<h2>Information for Beneficiary</h2>
+<p>{{range $i, $line := .Information}}{{if $i}}<br>{{end}}{{$line}}{{end}}</p>{{end}}
+{{- range .Advices}}
+<h2>{{.Name}}{{if .Advice}} ({{.Advice}}){{end}}</h2>
+<p>{{range $i, $line := .Lines}}{{if $i}}<br>{{end}}{{$line}}{{end}}</p>{{end}}
+</div>
+`
@@ -0,0 +1,140 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mockConfirmationMessage() *FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.SenderReference = mockSenderReference()
+	fwm.Originator = mockOriginator()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.BeneficiaryFI = mockBeneficiaryFI()
+	fwm.Charges = mockCharges()
+	fwm.LocalInstrument = mockLocalInstrument()
+	fwm.PaymentNotification = mockPaymentNotification()
+	fwm.OriginatorToBeneficiary = mockOriginatorToBeneficiary()
+	fwm.FIBeneficiaryAdvice = mockFIBeneficiaryAdvice()
+	return &fwm
+}
+
+func TestNewConfirmation(t *testing.T) {
+	fwm := mockConfirmationMessage()
+	c := NewConfirmation(fwm)
+
+	require.Equal(t, "Customer Transfer", c.BusinessFunction)
+	require.Equal(t, "12,345.67", c.Amount)
+	require.Equal(t, fwm.InputMessageAccountabilityData.Identifier(), c.IMAD)
+	require.Equal(t, "Fed Routing Number", c.Sender.Identification)
+	require.Equal(t, "Driver's License Number", c.Beneficiary.Identification)
+	require.Equal(t, []string{"Address One", "Address Two", "Address Three"}, c.Beneficiary.Address)
+	require.Equal(t, ChargeDetailsLabel(fwm.Charges.ChargeDetails), c.Charges)
+	require.Equal(t, LocalInstrumentLabel(fwm.LocalInstrument.LocalInstrumentCode), c.LocalInstrument)
+	require.Equal(t, "End To End Identification", c.EndToEndIdentification)
+	require.Equal(t, "Contact Name", c.Notification.ContactName)
+	require.Equal(t, &ConfirmationContact{Name: "Contact Name", Phone: "5555551212", Mobile: "5551231212", ElectronicAddress: "http://moov.io"}, c.Contact)
+	require.Len(t, c.Advices, 1)
+	require.Equal(t, "Letter", c.Advices[0].Advice)
+	require.Len(t, c.Advices[0].Lines, 6)
+	require.Nil(t, c.OriginatorFI)
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := map[string]string{
+		"000001234567": "12,345.67",
+		"000000000005": "0.05",
+		"000000000000": "0.00",
+		"000000100000": "1,000.00",
+		"999999999999": "9,999,999,999.99",
+	}
+	for amount, want := range tests {
+		require.Equal(t, want, formatAmount(amount), amount)
+	}
+}
+
+func TestRenderer_Render(t *testing.T) {
+	fwm := mockConfirmationMessage()
+	r := NewRenderer()
+
+	var buf strings.Builder
+	require.NoError(t, r.Render(&buf, ConfirmationText, fwm))
+	text := buf.String()
+	require.Contains(t, text, "Customer Transfer (CTR) - Funds Transfer, Basic Funds Transfer")
+	require.Contains(t, text, "Amount: 12,345.67 USD")
+	require.Contains(t, text, "Beneficiary: Name (Driver's License Number 1234)")
+	require.Contains(t, text, "Beneficiary Advice (Letter):\n  Line One")
+	require.Contains(t, text, "End-to-End Identification: End To End Identification")
+	require.Contains(t, text, "Beneficiary Contact:\n  Name: Contact Name\n  Phone: 5555551212\n  Mobile: 5551231212\n  Electronic Address: http://moov.io")
+
+	buf.Reset()
+	require.NoError(t, r.Render(&buf, ConfirmationMarkdown, fwm))
+	require.Contains(t, buf.String(), "| Amount | 12,345.67 USD |")
+	require.Contains(t, buf.String(), "## Beneficiary Advice (Letter)")
+	require.Contains(t, buf.String(), "| Name | Contact Name |\n| Phone | 5555551212 |")
+
+	fwm.Beneficiary.Personal.Name = "<script>"
+	fwm.OriginatorToBeneficiary = &OriginatorToBeneficiary{LineOne: "INV 1", LineTwo: "INV 2 & 3"}
+	buf.Reset()
+	require.NoError(t, r.Render(&buf, ConfirmationHTML, fwm))
+	require.Contains(t, buf.String(), "<th>Amount</th><td>12,345.67 USD</td>")
+	require.Contains(t, buf.String(), "&lt;script&gt;")
+	require.NotContains(t, buf.String(), "<script>")
+	require.Contains(t, buf.String(), "<p>INV 1<br>INV 2 &amp; 3</p>")
+	require.Contains(t, buf.String(), "<tr><th>Electronic Address</th><td>http://moov.io</td></tr>")
+
+	require.Error(t, r.Render(&buf, ConfirmationFormat("pdf"), fwm))
+}
+
+func TestRenderer_zeroValue(t *testing.T) {
+	fwm := mockConfirmationMessage()
+	fwm.PaymentNotification = &PaymentNotification{EndToEndIdentification: "E2E"}
+	require.Nil(t, NewConfirmation(fwm).Contact)
+
+	var r Renderer
+	for _, format := range []ConfirmationFormat{ConfirmationText, ConfirmationMarkdown, ConfirmationHTML} {
+		var want, got strings.Builder
+		require.NoError(t, NewRenderer().Render(&want, format, fwm))
+		require.NoError(t, r.Render(&got, format, fwm), format)
+		require.Equal(t, want.String(), got.String(), format)
+		require.NotContains(t, got.String(), "Contact", format)
+	}
+
+	// formats without a template of their own keep the default
+	require.NoError(t, r.SetTemplate(ConfirmationText, "{{.Amount}}"))
+	var buf strings.Builder
+	require.NoError(t, r.Render(&buf, ConfirmationText, fwm))
+	require.Equal(t, "12,345.67", buf.String())
+	buf.Reset()
+	require.NoError(t, r.Render(&buf, ConfirmationHTML, fwm))
+	require.Contains(t, buf.String(), "<th>Amount</th><td>12,345.67 USD</td>")
+}
+
+func TestRenderer_SetTemplate(t *testing.T) {
+	r := NewRenderer()
+	require.NoError(t, r.SetTemplate(ConfirmationText, "{{.IMAD}} {{.Amount}}"))
+
+	var buf strings.Builder
+	fwm := mockConfirmationMessage()
+	require.NoError(t, r.Render(&buf, ConfirmationText, fwm))
+	require.Equal(t, fwm.InputMessageAccountabilityData.Identifier()+" 12,345.67", buf.String())
+
+	require.Error(t, r.SetTemplate(ConfirmationHTML, "{{.IMAD"))
+	require.Error(t, r.SetTemplate(ConfirmationFormat("pdf"), ""))
+}
+
+func TestCodeLabels(t *testing.T) {
+	require.Equal(t, "Bank Transfer", BusinessFunctionCodeLabel(BankTransfer))
+	require.Equal(t, "XYZ", BusinessFunctionCodeLabel("XYZ"))
+	require.Equal(t, "Funds Transfer, Basic Funds Transfer", TypeSubTypeLabel(FundsTransfer, BasicFundsTransfer))
+	require.Equal(t, "Shared", ChargeDetailsLabel(CDShared))
+	require.Equal(t, "Narrative Text", LocalInstrumentLabel(NarrativeText))
+	require.Equal(t, "SWIFT BIC", IdentificationCodeLabel(SWIFTBankIdentifierCode))
+	require.Equal(t, "Phone", AdviceCodeLabel(AdviceCodePhone))
+}
@@ -150,6 +150,32 @@ const (
 	// MessageDuplicationResend designates a resend of a message
 	MessageDuplicationResend = "P"
 
+	// MessageDisposition MessageStatusIndicator
+
+	// MessageStatusIndicatorInProcess is a message in process or intercepted
+	MessageStatusIndicatorInProcess = "0"
+	// MessageStatusIndicatorSuccessful is a message processed successfully with accountability
+	MessageStatusIndicatorSuccessful = "2"
+	// MessageStatusIndicatorRejected is a message rejected due to an error condition
+	MessageStatusIndicatorRejected = "3"
+	// MessageStatusIndicatorSuccessfulWithoutAccountability is a message processed successfully without accountability
+	MessageStatusIndicatorSuccessfulWithoutAccountability = "7"
+
+	// ErrorWire ErrorCategory
+
+	// ErrorCategoryDataError is a Data Error
+	ErrorCategoryDataError = "E"
+	// ErrorCategoryInsufficientBalance is an Insufficient Balance
+	ErrorCategoryInsufficientBalance = "F"
+	// ErrorCategoryAccountabilityError is an Accountability Error
+	ErrorCategoryAccountabilityError = "H"
+	// ErrorCategoryInProcess is In Process or Intercepted
+	ErrorCategoryInProcess = "I"
+	// ErrorCategoryCutoffHourError is a Cutoff Hour Error
+	ErrorCategoryCutoffHourError = "W"
+	// ErrorCategoryDuplicateIMAD is a Duplicate IMAD
+	ErrorCategoryDuplicateIMAD = "X"
+
 	// TypeCode
 
 	// FundsTransfer is SenderSuppliedInformation {1510} TypeCode which designates a funds transfer in which the
@@ -0,0 +1,262 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DuplicateKind describes how a message duplicates one seen before
+type DuplicateKind string
+
+const (
+	// DuplicateIMAD is a message reusing the IMAD of a different message, which the Fedwire Funds Service rejects
+	// with ErrorWire category X (Duplicate IMAD)
+	DuplicateIMAD DuplicateKind = "imad"
+	// DuplicateMessage is a message with the same fingerprint as one seen before, which is not marked as a resend
+	DuplicateMessage DuplicateKind = "message"
+	// NearDuplicateMessage is a message with the same amount and parties as one seen before, but a different
+	// SenderReference. It may be a legitimate repeat payment, so it's a warning and the message is remembered.
+	NearDuplicateMessage DuplicateKind = "nearDuplicate"
+)
+
+// Fingerprint returns a hex encoded SHA-256 digest of the content identifying a payment: the Amount, depository
+// institutions, BusinessFunctionCode, parties and SenderReference. The IMAD is not included.
+func (fwm *FEDWireMessage) Fingerprint() string {
+	return fingerprint(fwm.partyContent(), fwm.senderReference())
+}
+
+// partyContent returns the fields of the amount and parties of a payment
+func (fwm *FEDWireMessage) partyContent() []string {
+	var content []string
+	if fwm.Amount != nil {
+		content = append(content, fwm.Amount.Amount)
+	}
+	if fwm.SenderDepositoryInstitution != nil {
+		content = append(content, fwm.SenderDepositoryInstitution.SenderABANumber)
+	}
+	if fwm.ReceiverDepositoryInstitution != nil {
+		content = append(content, fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	}
+	if fwm.BusinessFunctionCode != nil {
+		content = append(content, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	if fwm.Beneficiary != nil {
+		content = append(content, fwm.Beneficiary.Personal.IdentificationCode, fwm.Beneficiary.Personal.Identifier,
+			fwm.Beneficiary.Personal.Name)
+	}
+	if fwm.BeneficiaryFI != nil {
+		content = append(content, fwm.BeneficiaryFI.FinancialInstitution.IdentificationCode,
+			fwm.BeneficiaryFI.FinancialInstitution.Identifier)
+	}
+	if fwm.Originator != nil {
+		content = append(content, fwm.Originator.Personal.IdentificationCode, fwm.Originator.Personal.Identifier,
+			fwm.Originator.Personal.Name)
+	}
+	if fwm.OriginatorOptionF != nil {
+		content = append(content, fwm.OriginatorOptionF.PartyIdentifier, fwm.OriginatorOptionF.Name)
+	}
+	if fwm.OriginatorFI != nil {
+		content = append(content, fwm.OriginatorFI.FinancialInstitution.IdentificationCode,
+			fwm.OriginatorFI.FinancialInstitution.Identifier)
+	}
+	return content
+}
+
+// senderReference returns the SenderReference of the message, if any
+func (fwm *FEDWireMessage) senderReference() string {
+	if fwm.SenderReference == nil {
+		return ""
+	}
+	return fwm.SenderReference.SenderReference
+}
+
+// isResend returns true if the message is marked as a resend with MessageDuplicationCode P
+func (fwm *FEDWireMessage) isResend() bool {
+	return fwm.SenderSupplied != nil && fwm.SenderSupplied.MessageDuplicationCode == MessageDuplicationResend
+}
+
+// fingerprint returns the hex encoded SHA-256 digest of content
+func fingerprint(content []string, extra ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(append(content, extra...), "|")))
+	return hex.EncodeToString(sum[:])
+}
+
+// DuplicateErr is the error given when a message duplicates one seen by a DuplicateDetector
+type DuplicateErr struct {
+	Message string
+	Kind    DuplicateKind
+	// PreviousIMAD is the IMAD of the message which was duplicated, if it had one
+	PreviousIMAD string
+}
+
+// NewDuplicateErr creates a new error of the DuplicateErr type
+func NewDuplicateErr(kind DuplicateKind, previousIMAD string) DuplicateErr {
+	var msg string
+	switch kind {
+	case DuplicateIMAD:
+		msg = fmt.Sprintf("IMAD %s was already used by a different message", previousIMAD)
+	case NearDuplicateMessage:
+		msg = "message has the same amount and parties as a previous message"
+	default:
+		msg = "message is a duplicate of a previous message"
+	}
+	if previousIMAD != "" && kind != DuplicateIMAD {
+		msg = fmt.Sprintf("%s with IMAD %s", msg, previousIMAD)
+	}
+	return DuplicateErr{
+		Message:      msg,
+		Kind:         kind,
+		PreviousIMAD: previousIMAD,
+	}
+}
+
+func (e DuplicateErr) Error() string {
+	return e.Message
+}
+
+// ErrorCategory returns the ErrorWire ErrorCategory the Fedwire Funds Service would report, which is only known
+// for a DuplicateIMAD
+func (e DuplicateErr) ErrorCategory() string {
+	if e.Kind == DuplicateIMAD {
+		return ErrorCategoryDuplicateIMAD
+	}
+	return ""
+}
+
+// duplicateEntry is a message seen by a DuplicateDetector
+type duplicateEntry struct {
+	imad   string
+	exact  string
+	near   string
+	seenAt time.Time
+}
+
+// DuplicateDetector flags messages which duplicate a message seen within Window.
+//
+// A message is a duplicate when it reuses the IMAD of a message with a different fingerprint, or it has the
+// fingerprint of a previous message and is not a resend (MessageDuplicationCode P) with the IMAD of that message.
+// It is a near-duplicate when only its SenderReference differs from a previous message.
+//
+// DuplicateDetector is safe for concurrent use.
+type DuplicateDetector struct {
+	// Window is how long a message is remembered
+	Window time.Duration
+
+	mu      sync.Mutex
+	entries []*duplicateEntry
+	imads   map[string]*duplicateEntry
+	exact   map[string]*duplicateEntry
+	near    map[string]*duplicateEntry
+
+	now func() time.Time
+}
+
+// NewDuplicateDetector returns a DuplicateDetector which remembers messages for window
+func NewDuplicateDetector(window time.Duration) *DuplicateDetector {
+	return &DuplicateDetector{
+		Window: window,
+		imads:  make(map[string]*duplicateEntry),
+		exact:  make(map[string]*duplicateEntry),
+		near:   make(map[string]*duplicateEntry),
+		now:    time.Now,
+	}
+}
+
+// Check returns a DuplicateErr if fwm duplicates a message seen within Window, otherwise fwm is remembered.
+// Near-duplicates are remembered too, as they are only flagged with a NearDuplicateMessage DuplicateErr.
+func (d *DuplicateDetector) Check(fwm *FEDWireMessage) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	d.expire(now)
+
+	entry := &duplicateEntry{
+		exact:  fwm.Fingerprint(),
+		near:   fingerprint(fwm.partyContent()),
+		seenAt: now,
+	}
+	if fwm.InputMessageAccountabilityData != nil {
+		entry.imad = fwm.InputMessageAccountabilityData.Identifier()
+	}
+
+	if prev, ok := d.imads[entry.imad]; ok && entry.imad != "" {
+		if prev.exact != entry.exact {
+			return NewDuplicateErr(DuplicateIMAD, prev.imad)
+		}
+		if !fwm.isResend() {
+			return NewDuplicateErr(DuplicateMessage, prev.imad)
+		}
+		// a resend of the same message with its original IMAD
+		return nil
+	}
+	if prev, ok := d.exact[entry.exact]; ok {
+		return NewDuplicateErr(DuplicateMessage, prev.imad)
+	}
+	var err error
+	if prev, ok := d.near[entry.near]; ok {
+		err = NewDuplicateErr(NearDuplicateMessage, prev.imad)
+	}
+
+	d.entries = append(d.entries, entry)
+	if entry.imad != "" {
+		d.imads[entry.imad] = entry
+	}
+	d.exact[entry.exact] = entry
+	d.near[entry.near] = entry
+	return err
+}
+
+// Forget removes fwm from the remembered messages, such as when a message which passed Check is not sent
+func (d *DuplicateDetector) Forget(fwm *FEDWireMessage) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	exact := fwm.Fingerprint()
+	for i, entry := range d.entries {
+		if entry.exact != exact {
+			continue
+		}
+		if fwm.InputMessageAccountabilityData != nil && entry.imad != fwm.InputMessageAccountabilityData.Identifier() {
+			continue
+		}
+		d.remove(entry)
+		d.entries = append(d.entries[:i], d.entries[i+1:]...)
+		return
+	}
+}
+
+// expire forgets messages seen before the Window
+func (d *DuplicateDetector) expire(now time.Time) {
+	cutoff := now.Add(-d.Window)
+	n := 0
+	for _, entry := range d.entries {
+		if entry.seenAt.After(cutoff) {
+			break
+		}
+		d.remove(entry)
+		n++
+	}
+	d.entries = d.entries[n:]
+}
+
+// remove deletes entry from the indexes
+func (d *DuplicateDetector) remove(entry *duplicateEntry) {
+	if d.imads[entry.imad] == entry {
+		delete(d.imads, entry.imad)
+	}
+	if d.exact[entry.exact] == entry {
+		delete(d.exact, entry.exact)
+	}
+	if d.near[entry.near] == entry {
+		delete(d.near, entry.near)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockDuplicateTransfer creates a CustomerTransfer with parties, a SenderReference and the IMAD sequence number
+func mockDuplicateTransfer(sequenceNumber string) *FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.InputMessageAccountabilityData.InputSequenceNumber = sequenceNumber
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.SenderReference = mockSenderReference()
+	return &fwm
+}
+
+// mockDuplicateDetector returns a DuplicateDetector with a clock controlled by the test
+func mockDuplicateDetector(window time.Duration) (*DuplicateDetector, *time.Time) {
+	now := time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC)
+	d := NewDuplicateDetector(window)
+	d.now = func() time.Time { return now }
+	return d, &now
+}
+
+func TestFEDWireMessage_Fingerprint(t *testing.T) {
+	a, b := mockDuplicateTransfer("000001"), mockDuplicateTransfer("000002")
+	require.Len(t, a.Fingerprint(), 64)
+	require.Equal(t, a.Fingerprint(), b.Fingerprint())
+
+	b.Amount.Amount = "000000000001"
+	require.NotEqual(t, a.Fingerprint(), b.Fingerprint())
+}
+
+func TestDuplicateDetector(t *testing.T) {
+	d, _ := mockDuplicateDetector(time.Hour)
+	require.NoError(t, d.Check(mockDuplicateTransfer("000001")))
+
+	var dupErr DuplicateErr
+
+	// same content with a new IMAD
+	err := d.Check(mockDuplicateTransfer("000002"))
+	require.True(t, errors.As(err, &dupErr))
+	require.Equal(t, DuplicateMessage, dupErr.Kind)
+	require.Equal(t, mockDuplicateTransfer("000001").InputMessageAccountabilityData.Identifier(), dupErr.PreviousIMAD)
+
+	// same IMAD with different content
+	other := mockDuplicateTransfer("000001")
+	other.Amount.Amount = "000000000001"
+	err = d.Check(other)
+	require.True(t, errors.As(err, &dupErr))
+	require.Equal(t, DuplicateIMAD, dupErr.Kind)
+	require.Equal(t, ErrorCategoryDuplicateIMAD, dupErr.ErrorCategory())
+
+	// only the SenderReference differs
+	near := mockDuplicateTransfer("000003")
+	near.SenderReference.SenderReference = "Other Reference"
+	err = d.Check(near)
+	require.True(t, errors.As(err, &dupErr))
+	require.Equal(t, NearDuplicateMessage, dupErr.Kind)
+	require.Empty(t, dupErr.ErrorCategory())
+
+	// near-duplicates are remembered, so repeating one is a duplicate
+	err = d.Check(near)
+	require.True(t, errors.As(err, &dupErr))
+	require.Equal(t, DuplicateMessage, dupErr.Kind)
+
+	// a different payment
+	other = mockDuplicateTransfer("000004")
+	other.Amount.Amount = "000000000001"
+	require.NoError(t, d.Check(other))
+}
+
+func TestDuplicateDetector_Resend(t *testing.T) {
+	d, _ := mockDuplicateDetector(time.Hour)
+	require.NoError(t, d.Check(mockDuplicateTransfer("000001")))
+
+	// an accidental double submit
+	var dupErr DuplicateErr
+	err := d.Check(mockDuplicateTransfer("000001"))
+	require.True(t, errors.As(err, &dupErr))
+	require.Equal(t, DuplicateMessage, dupErr.Kind)
+
+	// a resend keeps the IMAD and is marked with MessageDuplicationCode P
+	resend := mockDuplicateTransfer("000001")
+	resend.SenderSupplied.MessageDuplicationCode = MessageDuplicationResend
+	require.NoError(t, d.Check(resend))
+}
+
+func TestDuplicateDetector_Window(t *testing.T) {
+	d, now := mockDuplicateDetector(time.Hour)
+	require.NoError(t, d.Check(mockDuplicateTransfer("000001")))
+
+	*now = now.Add(30 * time.Minute)
+	require.Error(t, d.Check(mockDuplicateTransfer("000002")))
+
+	*now = now.Add(31 * time.Minute)
+	require.NoError(t, d.Check(mockDuplicateTransfer("000002")))
+	require.Len(t, d.entries, 1)
+}
+
+func TestDuplicateDetector_Forget(t *testing.T) {
+	d, _ := mockDuplicateDetector(time.Hour)
+	fwm := mockDuplicateTransfer("000001")
+	require.NoError(t, d.Check(fwm))
+
+	d.Forget(fwm)
+	require.Empty(t, d.entries)
+	require.NoError(t, d.Check(mockDuplicateTransfer("000002")))
+}
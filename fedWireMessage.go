@@ -4,7 +4,12 @@
 
 package wire
 
-import "strings"
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
 
 // FEDWireMessage is a FedWire Message
 type FEDWireMessage struct {
@@ -146,6 +151,13 @@ func (fwm *FEDWireMessage) requireSenderSupplied() bool {
 // verify checks basic WIRE rules. Assumes properly parsed records. Each validation func should
 // check for the expected relationships between fields within a FedWireMessage.
 func (fwm *FEDWireMessage) verify() error {
+	return fwm.verifyContext(context.Background())
+}
+
+// verifyContext verifies fwm as verify does, with ctx as the parent of its trace span.
+func (fwm *FEDWireMessage) verifyContext(ctx context.Context) (err error) {
+	_, span := startSpan(ctx, "wire.FEDWireMessage.verify", trace.WithAttributes(messageAttributes(fwm)...))
+	defer func() { endSpan(span, err) }()
 
 	if err := fwm.mandatoryFields(); err != nil {
 		return err
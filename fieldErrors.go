@@ -123,6 +123,27 @@ var (
 	// ErrOptionFName is returned for an invalid name for OriginatorOptionF
 	ErrOptionFName = errors.New("is an invalid name for originator optionF")
 
+	// ErrOptionFTooManyLines is returned when OriginatorOptionF details need more than three lines
+	ErrOptionFTooManyLines = errors.New("exceeds the three lines available for originator optionF")
+
+	// ErrNotDrawdownRequest is returned when a drawdown response is generated from a message which is not a drawdown request
+	ErrNotDrawdownRequest = errors.New("is not a drawdown request")
+
+	// ErrNotReversalRequest is returned when a reversal is generated from a message which is not a request for reversal
+	ErrNotReversalRequest = errors.New("is not a request for reversal")
+
+	// ErrNotReturnable is returned when funds cannot be returned with the requested ReturnType
+	ErrNotReturnable = errors.New("cannot be returned with this return type")
+
+	// ErrSequenceExhausted is returned when all sequence numbers of a cycle date have been allocated
+	ErrSequenceExhausted = errors.New("has no sequence numbers remaining for the cycle date")
+
+	// ErrCycleDateRollback is returned when a sequence number is allocated for a cycle date before the current one
+	ErrCycleDateRollback = errors.New("is before the current cycle date")
+
+	// ErrNoMatchingMessage is returned when an acknowledgment does not match a submitted message
+	ErrNoMatchingMessage = errors.New("no submitted message matches the acknowledgment IMAD")
+
 	// ErrValidLength is returned for an field with invalid length
 	ErrValidLength = errors.New("is an invalid length")
 
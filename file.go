@@ -6,6 +6,7 @@ package wire
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -59,7 +60,12 @@ func (f *File) Create() error {
 
 // Validate will never modify the file.
 func (f *File) Validate() error {
-	if err := f.FEDWireMessage.verify(); err != nil {
+	return f.ValidateContext(context.Background())
+}
+
+// ValidateContext validates the file as Validate does, with ctx as the parent of its trace span.
+func (f *File) ValidateContext(ctx context.Context) error {
+	if err := f.FEDWireMessage.verifyContext(ctx); err != nil {
 		return err
 	}
 	return nil
@@ -75,6 +75,12 @@ func (imad *InputMessageAccountabilityData) String() string {
 	return buf.String()
 }
 
+// Identifier returns the 22 character IMAD (InputCycleDate, InputSource and InputSequenceNumber) as
+// it is written in PreviousMessageIdentifier
+func (imad *InputMessageAccountabilityData) Identifier() string {
+	return imad.InputCycleDateField() + imad.InputSourceField() + imad.InputSequenceNumberField()
+}
+
 // Validate performs WIRE format rule checks on InputMessageAccountabilityData and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (imad *InputMessageAccountabilityData) Validate() error {
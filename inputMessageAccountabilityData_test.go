@@ -108,3 +108,13 @@ func TestInputMessageAccountabilityDataInputCycleDateError(t *testing.T) {
 
 	require.EqualError(t, imad.Validate(), fieldError("InputCycleDate", ErrValidDate, imad.InputCycleDate).Error())
 }
+
+// TestInputMessageAccountabilityDataIdentifier validates the IMAD identifier is padded to 22 characters
+func TestInputMessageAccountabilityDataIdentifier(t *testing.T) {
+	imad := NewInputMessageAccountabilityData()
+	imad.InputCycleDate = "20250101"
+	imad.InputSource = "Src"
+	imad.InputSequenceNumber = "000001"
+
+	require.Equal(t, "20250101Src     000001", imad.Identifier())
+}
@@ -0,0 +1,265 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package lifecycle tracks outbound wires through their lifecycle, keyed by IMAD.
+//
+// A wire is Created, Validated and Sent by the application, then Acknowledged or Rejected by the output message
+// the Fedwire Funds Service returns, and finally Settled. Hooks registered with OnTransition are called after
+// each transition is stored.
+package lifecycle
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/moov-io/wire"
+)
+
+// State is the lifecycle state of an outbound wire
+type State string
+
+const (
+	// StateCreated is a wire which has been recorded but not validated
+	StateCreated State = "created"
+	// StateValidated is a wire which passed validation and may be sent
+	StateValidated State = "validated"
+	// StateSent is a wire sent to the Fedwire Funds Service, awaiting an output message
+	StateSent State = "sent"
+	// StateAcknowledged is a wire the Fedwire Funds Service accepted
+	StateAcknowledged State = "acknowledged"
+	// StateRejected is a wire the Fedwire Funds Service rejected, a resend requires a new IMAD
+	StateRejected State = "rejected"
+	// StateSettled is an acknowledged wire which has been reconciled as settled
+	StateSettled State = "settled"
+)
+
+// transitions are the states each state may move to
+var transitions = map[State][]State{
+	StateCreated:      {StateValidated},
+	StateValidated:    {StateSent},
+	StateSent:         {StateAcknowledged, StateRejected},
+	StateAcknowledged: {StateSettled},
+}
+
+// CanTransition returns true if a wire in state from may move to state to
+func CanTransition(from, to State) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ErrNotFound is returned when no wire is tracked for an IMAD
+	ErrNotFound = errors.New("no wire found for IMAD")
+	// ErrAlreadyExists is returned when a wire is already tracked for an IMAD
+	ErrAlreadyExists = errors.New("wire already exists for IMAD")
+)
+
+// InvalidTransitionErr is the error given when a wire cannot move between two states
+type InvalidTransitionErr struct {
+	Message string
+	From    State
+	To      State
+}
+
+// NewInvalidTransitionErr creates a new error of the InvalidTransitionErr type
+func NewInvalidTransitionErr(from, to State) InvalidTransitionErr {
+	return InvalidTransitionErr{
+		Message: fmt.Sprintf("cannot move from %s to %s", from, to),
+		From:    from,
+		To:      to,
+	}
+}
+
+func (e InvalidTransitionErr) Error() string {
+	return e.Message
+}
+
+// Transition is a change of State of a wire
+type Transition struct {
+	From   State     `json:"from"`
+	To     State     `json:"to"`
+	At     time.Time `json:"at"`
+	Reason string    `json:"reason,omitempty"`
+}
+
+// Record is an outbound wire and its lifecycle
+type Record struct {
+	// IMAD is the 22 character InputMessageAccountabilityData identifier of the wire
+	IMAD    string               `json:"imad"`
+	State   State                `json:"state"`
+	Message *wire.FEDWireMessage `json:"message"`
+	// OMAD is the OutputMessageAccountabilityData identifier of the latest output message
+	OMAD string `json:"omad,omitempty"`
+	// Error is the ErrorWire of the latest output message, if any
+	Error     *wire.ErrorWire `json:"error,omitempty"`
+	History   []Transition    `json:"history"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+// Hook is called after a transition of a wire has been stored
+type Hook func(rec *Record, t Transition)
+
+// Machine moves wires through their lifecycle and stores them in a Store.
+//
+// Machine is safe for concurrent use.
+type Machine struct {
+	store Store
+
+	mu    sync.Mutex
+	hooks []Hook
+
+	now func() time.Time
+}
+
+// NewMachine returns a Machine storing wires in store
+func NewMachine(store Store) *Machine {
+	return &Machine{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// OnTransition registers a Hook called after every transition
+func (m *Machine) OnTransition(hook Hook) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, hook)
+}
+
+// Create starts tracking fwm, which must have an InputMessageAccountabilityData, in StateCreated
+func (m *Machine) Create(fwm *wire.FEDWireMessage) (*Record, error) {
+	if fwm == nil || fwm.InputMessageAccountabilityData == nil {
+		return nil, errors.New("InputMessageAccountabilityData is required to track a wire")
+	}
+	imad := fwm.InputMessageAccountabilityData.Identifier()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, err := m.store.Get(imad); err == nil {
+		return nil, fmt.Errorf("%w: %s", ErrAlreadyExists, imad)
+	} else if !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	rec := &Record{
+		IMAD:      imad,
+		State:     StateCreated,
+		Message:   fwm,
+		UpdatedAt: m.now(),
+	}
+	if err := m.store.Save(rec); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// Get returns the tracked wire for imad
+func (m *Machine) Get(imad string) (*Record, error) {
+	return m.store.Get(imad)
+}
+
+// Validate validates the wire and moves it to StateValidated
+func (m *Machine) Validate(imad string) (*Record, error) {
+	return m.update(imad, func(rec *Record) (State, string, error) {
+		file := wire.NewFile()
+		file.AddFEDWireMessage(*rec.Message)
+		if err := file.Validate(); err != nil {
+			return "", "", err
+		}
+		return StateValidated, "", nil
+	})
+}
+
+// Sent moves the wire to StateSent once it has been sent to the Fedwire Funds Service
+func (m *Machine) Sent(imad string) (*Record, error) {
+	return m.update(imad, func(rec *Record) (State, string, error) {
+		return StateSent, "", nil
+	})
+}
+
+// Settle moves an acknowledged wire to StateSettled
+func (m *Machine) Settle(imad, reason string) (*Record, error) {
+	return m.update(imad, func(rec *Record) (State, string, error) {
+		return StateSettled, reason, nil
+	})
+}
+
+// HandleOutput applies an output message of the Fedwire Funds Service to the wire with its IMAD.
+//
+// An accepted MessageDisposition moves the wire to StateAcknowledged and a rejected one, or an ErrorWire, to
+// StateRejected. Messages in process or intercepted record the OMAD and ErrorWire without a transition.
+func (m *Machine) HandleOutput(out *wire.FEDWireMessage) (*Record, error) {
+	if out == nil || out.InputMessageAccountabilityData == nil {
+		return nil, errors.New("InputMessageAccountabilityData is required to match an output message")
+	}
+	return m.update(out.InputMessageAccountabilityData.Identifier(), func(rec *Record) (State, string, error) {
+		if out.OutputMessageAccountabilityData != nil {
+			rec.OMAD = out.OutputMessageAccountabilityData.Identifier()
+		}
+		if out.ErrorWire != nil {
+			rec.Error = out.ErrorWire
+		}
+		status := out.Status()
+		switch {
+		case status.IsAccepted():
+			return StateAcknowledged, string(status), nil
+		case status == wire.MessageStatusRejected:
+			reason := string(status)
+			if out.ErrorWire != nil {
+				reason = out.ErrorWire.Describe()
+			}
+			return StateRejected, reason, nil
+		}
+		return rec.State, string(status), nil
+	})
+}
+
+// update applies fn to the wire for imad and stores the transition to the State fn returns
+func (m *Machine) update(imad string, fn func(rec *Record) (State, string, error)) (*Record, error) {
+	m.mu.Lock()
+	rec, t, err := m.apply(imad, fn)
+	hooks := m.hooks
+	m.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if t != nil {
+		for _, hook := range hooks {
+			hook(rec, *t)
+		}
+	}
+	return rec, nil
+}
+
+func (m *Machine) apply(imad string, fn func(rec *Record) (State, string, error)) (*Record, *Transition, error) {
+	rec, err := m.store.Get(imad)
+	if err != nil {
+		return nil, nil, err
+	}
+	to, reason, err := fn(rec)
+	if err != nil {
+		return nil, nil, err
+	}
+	rec.UpdatedAt = m.now()
+
+	var t *Transition
+	if to != rec.State {
+		if !CanTransition(rec.State, to) {
+			return nil, nil, NewInvalidTransitionErr(rec.State, to)
+		}
+		t = &Transition{From: rec.State, To: to, At: rec.UpdatedAt, Reason: reason}
+		rec.History = append(rec.History, *t)
+		rec.State = to
+	}
+	if err := m.store.Save(rec); err != nil {
+		return nil, nil, err
+	}
+	return rec, t, nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lifecycle
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+const testIMAD = "20190410Source08000001"
+
+func readMessage(t *testing.T) *wire.FEDWireMessage {
+	t.Helper()
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	defer fd.Close()
+
+	file, err := wire.NewReader(fd).Read()
+	require.NoError(t, err)
+	return &file.FEDWireMessage
+}
+
+// outputMessage returns the output message acknowledging fwm with the MessageStatusIndicator
+func outputMessage(fwm *wire.FEDWireMessage, indicator string) *wire.FEDWireMessage {
+	out := *fwm
+	out.MessageDisposition = wire.NewMessageDisposition()
+	out.MessageDisposition.MessageStatusIndicator = indicator
+	out.OutputMessageAccountabilityData = wire.NewOutputMessageAccountabilityData()
+	out.OutputMessageAccountabilityData.OutputCycleDate = "20190410"
+	out.OutputMessageAccountabilityData.OutputDestinationID = "Dest0001"
+	out.OutputMessageAccountabilityData.OutputSequenceNumber = "000001"
+	return &out
+}
+
+func TestMachine(t *testing.T) {
+	m := NewMachine(NewMemoryStore())
+
+	var seen []Transition
+	m.OnTransition(func(rec *Record, tr Transition) {
+		require.Equal(t, tr.To, rec.State)
+		seen = append(seen, tr)
+	})
+
+	fwm := readMessage(t)
+	rec, err := m.Create(fwm)
+	require.NoError(t, err)
+	require.Equal(t, testIMAD, rec.IMAD)
+	require.Equal(t, StateCreated, rec.State)
+
+	_, err = m.Create(fwm)
+	require.True(t, errors.Is(err, ErrAlreadyExists))
+
+	// a wire must be validated before it is sent
+	_, err = m.Sent(testIMAD)
+	var transitionErr InvalidTransitionErr
+	require.True(t, errors.As(err, &transitionErr))
+	require.Equal(t, StateCreated, transitionErr.From)
+
+	_, err = m.Validate(testIMAD)
+	require.NoError(t, err)
+	_, err = m.Sent(testIMAD)
+	require.NoError(t, err)
+
+	// in process output messages do not move the wire
+	rec, err = m.HandleOutput(outputMessage(fwm, wire.MessageStatusIndicatorInProcess))
+	require.NoError(t, err)
+	require.Equal(t, StateSent, rec.State)
+	require.Equal(t, "20190410Dest0001000001", rec.OMAD)
+
+	rec, err = m.HandleOutput(outputMessage(fwm, wire.MessageStatusIndicatorSuccessful))
+	require.NoError(t, err)
+	require.Equal(t, StateAcknowledged, rec.State)
+
+	rec, err = m.Settle(testIMAD, "reconciled")
+	require.NoError(t, err)
+	require.Equal(t, StateSettled, rec.State)
+
+	require.Len(t, seen, 4)
+	require.Equal(t, []State{StateValidated, StateSent, StateAcknowledged, StateSettled},
+		[]State{seen[0].To, seen[1].To, seen[2].To, seen[3].To})
+
+	rec, err = m.Get(testIMAD)
+	require.NoError(t, err)
+	require.Len(t, rec.History, 4)
+	require.Equal(t, "reconciled", rec.History[3].Reason)
+}
+
+func TestMachine_Rejected(t *testing.T) {
+	m := NewMachine(NewMemoryStore())
+	fwm := readMessage(t)
+	_, err := m.Create(fwm)
+	require.NoError(t, err)
+	_, err = m.Validate(testIMAD)
+	require.NoError(t, err)
+	_, err = m.Sent(testIMAD)
+	require.NoError(t, err)
+
+	out := outputMessage(fwm, wire.MessageStatusIndicatorRejected)
+	out.ErrorWire = wire.NewErrorWire()
+	out.ErrorWire.ErrorCategory = wire.ErrorCategoryCutoffHourError
+	rec, err := m.HandleOutput(out)
+	require.NoError(t, err)
+	require.Equal(t, StateRejected, rec.State)
+	require.Equal(t, "Cutoff Hour Error", rec.History[len(rec.History)-1].Reason)
+	require.Equal(t, wire.ErrorCategoryCutoffHourError, rec.Error.ErrorCategory)
+
+	_, err = m.Settle(testIMAD, "")
+	require.Error(t, err)
+}
+
+func TestMachine_ValidateError(t *testing.T) {
+	m := NewMachine(NewMemoryStore())
+	fwm := readMessage(t)
+	fwm.Amount.Amount = "00000000000A"
+	_, err := m.Create(fwm)
+	require.NoError(t, err)
+
+	_, err = m.Validate(testIMAD)
+	require.Error(t, err)
+
+	rec, err := m.Get(testIMAD)
+	require.NoError(t, err)
+	require.Equal(t, StateCreated, rec.State)
+
+	_, err = m.Get("20190410Source08000002")
+	require.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = m.HandleOutput(&wire.FEDWireMessage{})
+	require.Error(t, err)
+}
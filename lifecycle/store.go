@@ -0,0 +1,58 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package lifecycle
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Store persists the Record of each wire, keyed by IMAD
+type Store interface {
+	// Get returns the Record for imad, or an error wrapping ErrNotFound
+	Get(imad string) (*Record, error)
+	// Save creates or replaces the Record for rec.IMAD
+	Save(rec *Record) error
+}
+
+// MemoryStore is a Store kept in memory. Records are copied on Get and Save, so callers may modify them freely.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	records map[string]*Record
+}
+
+// NewMemoryStore returns an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		records: make(map[string]*Record),
+	}
+}
+
+// Get returns a copy of the Record for imad
+func (s *MemoryStore) Get(imad string) (*Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rec, ok := s.records[imad]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, imad)
+	}
+	return copyRecord(rec), nil
+}
+
+// Save stores a copy of rec
+func (s *MemoryStore) Save(rec *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[rec.IMAD] = copyRecord(rec)
+	return nil
+}
+
+func copyRecord(rec *Record) *Record {
+	cp := *rec
+	cp.History = append([]Transition(nil), rec.History...)
+	return &cp
+}
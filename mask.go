@@ -0,0 +1,149 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// MaskFunc returns the masked form of a field value
+type MaskFunc func(value string) string
+
+// MaskAll replaces every character of value but spaces with *
+func MaskAll(value string) string {
+	return MaskAllButLast(0)(value)
+}
+
+// MaskLast4 replaces all but the last four characters of value, not counting spaces, with *
+func MaskLast4(value string) string {
+	return MaskAllButLast(4)(value)
+}
+
+// MaskAllButLast returns a MaskFunc which keeps the last n characters of values, not counting spaces, and replaces
+// the others with *. Values of n characters or fewer are masked entirely, as keeping them would reveal the value.
+func MaskAllButLast(n int) MaskFunc {
+	return func(value string) string {
+		runes := []rune(value)
+		visible := 0
+		for _, r := range runes {
+			if !unicode.IsSpace(r) {
+				visible++
+			}
+		}
+		keep := n
+		if visible <= n {
+			keep = 0
+		}
+		for i := len(runes) - 1; i >= 0; i-- {
+			if unicode.IsSpace(runes[i]) {
+				continue
+			}
+			if keep > 0 {
+				keep--
+				continue
+			}
+			runes[i] = '*'
+		}
+		return string(runes)
+	}
+}
+
+// MaskOpts maps the paths of FEDWireMessage fields to how they are masked. A path is the field of the tag followed
+// by the field within it, such as Beneficiary.Personal.Identifier.
+type MaskOpts map[string]MaskFunc
+
+// DefaultMaskOpts returns the MaskOpts keeping the last four characters of account numbers and party identifiers
+// and masking names entirely. Callers can add or remove fields from the returned map.
+func DefaultMaskOpts() MaskOpts {
+	return MaskOpts{
+		"Beneficiary.Personal.Identifier":                     MaskLast4,
+		"Beneficiary.Personal.Name":                           MaskAll,
+		"Originator.Personal.Identifier":                      MaskLast4,
+		"Originator.Personal.Name":                            MaskAll,
+		"AccountDebitedDrawdown.Identifier":                   MaskLast4,
+		"AccountDebitedDrawdown.Name":                         MaskAll,
+		"AccountCreditedDrawdown.DrawdownCreditAccountNumber": MaskLast4,
+		"OriginatorOptionF.PartyIdentifier":                   MaskLast4,
+		"OriginatorOptionF.Name":                              MaskAll,
+		"RemittanceOriginator.IdentificationNumber":           MaskLast4,
+		"RemittanceBeneficiary.IdentificationNumber":          MaskLast4,
+	}
+}
+
+// Validate returns an error naming the paths which aren't a text field of a FEDWireMessage tag
+func (opts MaskOpts) Validate() error {
+	var invalid []string
+	for path := range opts {
+		if _, ok := maskField(reflect.TypeOf(FEDWireMessage{}), path); !ok {
+			invalid = append(invalid, path)
+		}
+	}
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return fmt.Errorf("unknown mask fields: %s", strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+// maskField returns the index of the field at path within typ, when it is a string field of a tag
+func maskField(typ reflect.Type, path string) ([]int, bool) {
+	var index []int
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			return nil, false
+		}
+		field, ok := typ.FieldByName(name)
+		if !ok || !field.IsExported() || len(field.Index) != 1 {
+			return nil, false
+		}
+		// the first field must be a tag
+		if i == 0 && (field.Type.Kind() != reflect.Ptr || field.Type.Elem().Kind() != reflect.Struct) {
+			return nil, false
+		}
+		index = append(index, field.Index[0])
+		typ = field.Type
+	}
+	return index, len(index) > 1 && typ.Kind() == reflect.String
+}
+
+// Mask returns a copy of the message with the fields of opts masked, for logging or display. The message itself is
+// left unchanged. Paths which aren't a text field of a tag are skipped; check them with MaskOpts.Validate.
+func (fwm *FEDWireMessage) Mask(opts MaskOpts) *FEDWireMessage {
+	out := *fwm
+	v := reflect.ValueOf(&out).Elem()
+	copied := make(map[int]bool)
+
+	for path, mask := range opts {
+		index, ok := maskField(v.Type(), path)
+		if !ok || mask == nil {
+			continue
+		}
+		tag := v.Field(index[0])
+		if tag.IsNil() {
+			continue
+		}
+		// tags hold only values, so a shallow copy of the tag leaves the message's tag untouched
+		if !copied[index[0]] {
+			c := reflect.New(tag.Type().Elem())
+			c.Elem().Set(tag.Elem())
+			tag.Set(c)
+			copied[index[0]] = true
+		}
+		field := tag.Elem()
+		for _, i := range index[1:] {
+			field = field.Field(i)
+		}
+		field.SetString(mask(field.String()))
+	}
+	return &out
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskFuncs(t *testing.T) {
+	require.Equal(t, "*****6789", MaskLast4("123456789"))
+	require.Equal(t, "**** 6789  ", MaskLast4("1234 6789  "))
+	require.Equal(t, "****", MaskLast4("1234"))
+	require.Equal(t, "**** ***", MaskAll("Jane Doe"))
+	require.Equal(t, "**3", MaskAllButLast(1)("123"))
+	require.Equal(t, "", MaskLast4(""))
+}
+
+func TestFEDWireMessage_Mask(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Identifier = "987654321"
+	fwm.Originator = mockOriginator()
+	fwm.AccountDebitedDrawdown = mockAccountDebitedDrawdown()
+
+	masked := fwm.Mask(DefaultMaskOpts())
+	require.Equal(t, "*****4321", masked.Beneficiary.Personal.Identifier)
+	require.Equal(t, "****", masked.Beneficiary.Personal.Name)
+	require.Equal(t, fwm.Beneficiary.Personal.Address, masked.Beneficiary.Personal.Address)
+	require.NotEqual(t, fwm.Originator.Personal.Name, masked.Originator.Personal.Name)
+	require.NotEqual(t, fwm.AccountDebitedDrawdown.Identifier, masked.AccountDebitedDrawdown.Identifier)
+	require.Nil(t, masked.OriginatorOptionF)
+	require.Equal(t, fwm.Amount, masked.Amount)
+
+	// the message is unchanged
+	require.Equal(t, "987654321", fwm.Beneficiary.Personal.Identifier)
+	require.Equal(t, "Name", fwm.Beneficiary.Personal.Name)
+
+	// fields are masked as configured
+	masked = fwm.Mask(MaskOpts{"Beneficiary.Personal.Name": MaskAllButLast(2)})
+	require.Equal(t, "**me", masked.Beneficiary.Personal.Name)
+	require.Equal(t, "987654321", masked.Beneficiary.Personal.Identifier)
+	require.Equal(t, fwm.Originator, masked.Originator)
+}
+
+func TestMaskOpts_Validate(t *testing.T) {
+	require.NoError(t, DefaultMaskOpts().Validate())
+
+	err := MaskOpts{
+		"Beneficiary.Personal":          MaskAll,
+		"Beneficiary.Personal.Missing":  MaskAll,
+		"ID":                            MaskAll,
+		"Amount":                        MaskAll,
+		"Beneficiary.Personal.Name.Foo": MaskAll,
+		"Amount.Amount":                 MaskAll,
+	}.Validate()
+	require.EqualError(t, err, "unknown mask fields: Amount, Beneficiary.Personal, Beneficiary.Personal.Missing, Beneficiary.Personal.Name.Foo, ID")
+}
@@ -0,0 +1,722 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import "sort"
+
+// messageTemplate describes the defaults and tag rules of a business function code
+type messageTemplate struct {
+	// typeCode and subTypeCode are the default TypeSubType for the business function code
+	typeCode    string
+	subTypeCode string
+	// required are the tags which must be present in addition to the mandatory tags
+	required []string
+	// prohibited are the tags which are not permitted, as checked by the checkProhibited* rules
+	prohibited []string
+}
+
+// coverPaymentTags are the {7xxx} cover payment information tags
+var coverPaymentTags = []string{
+	TagCurrencyInstructedAmount, TagOrderingCustomer, TagOrderingInstitution, TagIntermediaryInstitution,
+	TagInstitutionAccount, TagBeneficiaryCustomer, TagRemittance, TagSenderToReceiver,
+}
+
+// remittanceTags are the {8xxx} unstructured addenda and remittance tags
+var remittanceTags = []string{
+	TagUnstructuredAddenda, TagRelatedRemittance, TagRemittanceOriginator, TagRemittanceBeneficiary,
+	TagPrimaryRemittanceDocument, TagActualAmountPaid, TagGrossAmountRemittanceDocument,
+	TagAmountNegotiatedDiscount, TagAdjustment, TagDateRemittanceDocument, TagSecondaryRemittanceDocument,
+	TagRemittanceFreeText,
+}
+
+// drawdownTags are the tags only permitted on drawdown requests and responses
+var drawdownTags = []string{
+	TagAccountDebitedDrawdown, TagAccountCreditedDrawdown, TagFIDrawdownDebitAccountAdvice,
+}
+
+// sharedProhibitedTags are the tags checked by checkSharedProhibitedTags
+var sharedProhibitedTags = joinTags([]string{
+	TagLocalInstrument, TagPaymentNotification, TagCharges, TagInstructedAmount, TagExchangeRate,
+	TagOriginatorOptionF, TagServiceMessage,
+}, coverPaymentTags, remittanceTags)
+
+// messageTemplates holds a messageTemplate for each business function code
+var messageTemplates = map[string]messageTemplate{
+	BankTransfer: {
+		typeCode:    FundsTransfer,
+		subTypeCode: BasicFundsTransfer,
+		prohibited: joinTags([]string{
+			TagLocalInstrument, TagCharges, TagInstructedAmount, TagExchangeRate, TagOriginatorOptionF,
+			TagServiceMessage,
+		}, drawdownTags, coverPaymentTags, remittanceTags),
+	},
+	CustomerTransfer: {
+		typeCode:    FundsTransfer,
+		subTypeCode: BasicFundsTransfer,
+		required:    []string{TagBeneficiary, TagOriginator},
+		prohibited: joinTags([]string{
+			TagLocalInstrument, TagPaymentNotification, TagOriginatorOptionF, TagServiceMessage,
+		}, drawdownTags, coverPaymentTags, remittanceTags),
+	},
+	CustomerTransferPlus: {
+		typeCode:    FundsTransfer,
+		subTypeCode: BasicFundsTransfer,
+		required:    []string{TagBeneficiary},
+		prohibited:  joinTags([]string{TagServiceMessage}, drawdownTags),
+	},
+	CheckSameDaySettlement: {
+		typeCode:    SettlementTransfer,
+		subTypeCode: BasicFundsTransfer,
+		prohibited:  joinTags(sharedProhibitedTags, drawdownTags),
+	},
+	DepositSendersAccount: {
+		typeCode:    SettlementTransfer,
+		subTypeCode: BasicFundsTransfer,
+		prohibited:  joinTags(sharedProhibitedTags, drawdownTags),
+	},
+	FEDFundsReturned: {
+		typeCode:    SettlementTransfer,
+		subTypeCode: BasicFundsTransfer,
+		prohibited:  joinTags(sharedProhibitedTags, drawdownTags),
+	},
+	FEDFundsSold: {
+		typeCode:    SettlementTransfer,
+		subTypeCode: BasicFundsTransfer,
+		prohibited:  joinTags(sharedProhibitedTags, drawdownTags),
+	},
+	DrawdownResponse: {
+		typeCode:    FundsTransfer,
+		subTypeCode: FundsTransferRequestCredit,
+		required:    []string{TagBeneficiary, TagOriginator},
+		prohibited:  sharedProhibitedTags,
+	},
+	BankDrawDownRequest: {
+		typeCode:    SettlementTransfer,
+		subTypeCode: RequestCredit,
+		required:    []string{TagAccountDebitedDrawdown, TagAccountCreditedDrawdown},
+		prohibited:  sharedProhibitedTags,
+	},
+	CustomerCorporateDrawdownRequest: {
+		typeCode:    FundsTransfer,
+		subTypeCode: RequestCredit,
+		required:    []string{TagBeneficiary, TagAccountDebitedDrawdown, TagAccountCreditedDrawdown},
+		prohibited:  sharedProhibitedTags,
+	},
+	BFCServiceMessage: {
+		typeCode:    FundsTransfer,
+		subTypeCode: SSIServiceMessage,
+		prohibited: joinTags([]string{
+			TagLocalInstrument, TagPaymentNotification, TagCharges, TagInstructedAmount, TagExchangeRate,
+			TagOriginatorOptionF,
+		}, coverPaymentTags, remittanceTags),
+	},
+}
+
+func joinTags(groups ...[]string) []string {
+	var out []string
+	for _, group := range groups {
+		out = append(out, group...)
+	}
+	return out
+}
+
+// builderTags are the tags a MessageBuilder can set, in the order they are written
+var builderTags = []string{
+	TagSenderSupplied, TagTypeSubType, TagInputMessageAccountabilityData, TagAmount,
+	TagSenderDepositoryInstitution, TagReceiverDepositoryInstitution, TagBusinessFunctionCode,
+	TagSenderReference, TagPreviousMessageIdentifier, TagLocalInstrument, TagPaymentNotification,
+	TagCharges, TagInstructedAmount, TagExchangeRate,
+	TagBeneficiaryIntermediaryFI, TagBeneficiaryFI, TagBeneficiary, TagBeneficiaryReference,
+	TagAccountDebitedDrawdown, TagOriginator, TagOriginatorOptionF, TagOriginatorFI, TagInstructingFI,
+	TagAccountCreditedDrawdown, TagOriginatorToBeneficiary, TagFIReceiverFI, TagFIDrawdownDebitAccountAdvice,
+	TagFIIntermediaryFI, TagFIIntermediaryFIAdvice, TagFIBeneficiaryFI, TagFIBeneficiaryFIAdvice,
+	TagFIBeneficiary, TagFIBeneficiaryAdvice, TagFIPaymentMethodToBeneficiary, TagFIAdditionalFIToFI,
+	TagCurrencyInstructedAmount, TagOrderingCustomer, TagOrderingInstitution, TagIntermediaryInstitution,
+	TagInstitutionAccount, TagBeneficiaryCustomer, TagRemittance, TagSenderToReceiver,
+	TagUnstructuredAddenda, TagRelatedRemittance, TagRemittanceOriginator, TagRemittanceBeneficiary,
+	TagPrimaryRemittanceDocument, TagActualAmountPaid, TagGrossAmountRemittanceDocument,
+	TagAmountNegotiatedDiscount, TagAdjustment, TagDateRemittanceDocument, TagSecondaryRemittanceDocument,
+	TagRemittanceFreeText, TagServiceMessage,
+}
+
+// PermittedTags returns the tags which may be included in a message with the business function code,
+// sorted by tag number. Tags which are only invalid for certain values (e.g. Beneficiary with
+// IdentificationCode SWIFTBICORBEIANDAccountNumber) are included and checked when the message is built.
+func PermittedTags(businessFunctionCode string) []string {
+	tmpl, ok := messageTemplates[businessFunctionCode]
+	if !ok {
+		return nil
+	}
+	var tags []string
+	for _, tag := range builderTags {
+		if !tmpl.prohibits(tag) {
+			tags = append(tags, tag)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+func (tmpl messageTemplate) prohibits(tag string) bool {
+	for _, t := range tmpl.prohibited {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// MessageBuilder builds a FEDWireMessage for a business function code
+//
+// NewMessageBuilder pre-populates SenderSupplied, TypeSubType and BusinessFunctionCode. Setting a tag
+// which is not permitted for the business function code records an error which is returned by Build,
+// along with any missing required tag or validation error.
+//
+//	fwm, err := wire.NewMessageBuilder(wire.CustomerTransfer).
+//		IMAD("20250101", "Source08", "000001").
+//		Amount("000001234567").
+//		SenderDI("121042882", "Wells Fargo NA").
+//		ReceiverDI("231380104", "Citadel").
+//		Beneficiary(ben).
+//		Originator(orig).
+//		Build()
+type MessageBuilder struct {
+	fwm  FEDWireMessage
+	tmpl messageTemplate
+	err  error
+}
+
+// NewMessageBuilder returns a MessageBuilder for the business function code
+func NewMessageBuilder(businessFunctionCode string) *MessageBuilder {
+	b := &MessageBuilder{}
+	tmpl, ok := messageTemplates[businessFunctionCode]
+	if !ok {
+		b.err = fieldError("BusinessFunctionCode", ErrBusinessFunctionCode, businessFunctionCode)
+		return b
+	}
+	b.tmpl = tmpl
+
+	b.fwm.SenderSupplied = NewSenderSupplied()
+	b.fwm.TypeSubType = NewTypeSubType()
+	b.fwm.TypeSubType.TypeCode = tmpl.typeCode
+	b.fwm.TypeSubType.SubTypeCode = tmpl.subTypeCode
+	b.fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	b.fwm.BusinessFunctionCode.BusinessFunctionCode = businessFunctionCode
+	return b
+}
+
+// permit returns true if the tag is permitted for the business function code, otherwise an error is recorded
+func (b *MessageBuilder) permit(tag, name string) bool {
+	if b.err != nil {
+		return false
+	}
+	if b.tmpl.prohibits(tag) {
+		b.err = fieldError(name, ErrNotPermitted, b.fwm.BusinessFunctionCode.BusinessFunctionCode)
+		return false
+	}
+	return true
+}
+
+// Build returns the FEDWireMessage after checking the required tags for the business function code and
+// validating the message.
+func (b *MessageBuilder) Build() (*FEDWireMessage, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	for _, tag := range b.tmpl.required {
+		if !b.fwm.hasTag(tag) {
+			return nil, fieldError(tagName(tag), ErrFieldRequired)
+		}
+	}
+	if b.fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus {
+		if b.fwm.Originator == nil && b.fwm.OriginatorOptionF == nil {
+			return nil, fieldError("Originator", ErrFieldRequired)
+		}
+	}
+	fwm := b.fwm
+	if err := fwm.verify(); err != nil {
+		return nil, err
+	}
+	return &fwm, nil
+}
+
+// hasTag returns true when the tag required by a messageTemplate is present
+func (fwm *FEDWireMessage) hasTag(tag string) bool {
+	switch tag {
+	case TagBeneficiary:
+		return fwm.Beneficiary != nil
+	case TagOriginator:
+		return fwm.Originator != nil
+	case TagAccountDebitedDrawdown:
+		return fwm.AccountDebitedDrawdown != nil
+	case TagAccountCreditedDrawdown:
+		return fwm.AccountCreditedDrawdown != nil
+	}
+	return false
+}
+
+// tagName returns the FEDWireMessage field name for a tag required by a messageTemplate
+func tagName(tag string) string {
+	switch tag {
+	case TagBeneficiary:
+		return "Beneficiary"
+	case TagOriginator:
+		return "Originator"
+	case TagAccountDebitedDrawdown:
+		return "AccountDebitedDrawdown"
+	case TagAccountCreditedDrawdown:
+		return "AccountCreditedDrawdown"
+	}
+	return tag
+}
+
+// ValidateOptions sets the ValidateOpts used when building the message
+func (b *MessageBuilder) ValidateOptions(opts *ValidateOpts) *MessageBuilder {
+	b.fwm.ValidateOptions = opts
+	return b
+}
+
+// SenderSupplied replaces the pre-populated SenderSupplied tag
+func (b *MessageBuilder) SenderSupplied(senderSupplied *SenderSupplied) *MessageBuilder {
+	b.fwm.SenderSupplied = senderSupplied
+	return b
+}
+
+// TypeSubType overrides the default TypeCode and SubTypeCode of the business function code
+func (b *MessageBuilder) TypeSubType(typeCode, subTypeCode string) *MessageBuilder {
+	if b.fwm.TypeSubType != nil {
+		b.fwm.TypeSubType.TypeCode = typeCode
+		b.fwm.TypeSubType.SubTypeCode = subTypeCode
+	}
+	return b
+}
+
+// IMAD sets the InputMessageAccountabilityData tag
+func (b *MessageBuilder) IMAD(cycleDate, source, sequenceNumber string) *MessageBuilder {
+	imad := NewInputMessageAccountabilityData()
+	imad.InputCycleDate = cycleDate
+	imad.InputSource = source
+	imad.InputSequenceNumber = sequenceNumber
+	b.fwm.InputMessageAccountabilityData = imad
+	return b
+}
+
+// Amount sets the Amount tag
+func (b *MessageBuilder) Amount(amount string) *MessageBuilder {
+	amt := NewAmount()
+	amt.Amount = amount
+	b.fwm.Amount = amt
+	return b
+}
+
+// SenderDI sets the SenderDepositoryInstitution tag
+func (b *MessageBuilder) SenderDI(abaNumber, shortName string) *MessageBuilder {
+	sdi := NewSenderDepositoryInstitution()
+	sdi.SenderABANumber = abaNumber
+	sdi.SenderShortName = shortName
+	b.fwm.SenderDepositoryInstitution = sdi
+	return b
+}
+
+// ReceiverDI sets the ReceiverDepositoryInstitution tag
+func (b *MessageBuilder) ReceiverDI(abaNumber, shortName string) *MessageBuilder {
+	rdi := NewReceiverDepositoryInstitution()
+	rdi.ReceiverABANumber = abaNumber
+	rdi.ReceiverShortName = shortName
+	b.fwm.ReceiverDepositoryInstitution = rdi
+	return b
+}
+
+// SenderReference sets the SenderReference tag
+func (b *MessageBuilder) SenderReference(senderReference *SenderReference) *MessageBuilder {
+	if b.permit(TagSenderReference, "SenderReference") {
+		b.fwm.SenderReference = senderReference
+	}
+	return b
+}
+
+// PreviousMessageIdentifier sets the PreviousMessageIdentifier tag
+func (b *MessageBuilder) PreviousMessageIdentifier(previousMessageIdentifier *PreviousMessageIdentifier) *MessageBuilder {
+	if b.permit(TagPreviousMessageIdentifier, "PreviousMessageIdentifier") {
+		b.fwm.PreviousMessageIdentifier = previousMessageIdentifier
+	}
+	return b
+}
+
+// LocalInstrument sets the LocalInstrument tag
+func (b *MessageBuilder) LocalInstrument(localInstrument *LocalInstrument) *MessageBuilder {
+	if b.permit(TagLocalInstrument, "LocalInstrument") {
+		b.fwm.LocalInstrument = localInstrument
+	}
+	return b
+}
+
+// PaymentNotification sets the PaymentNotification tag
+func (b *MessageBuilder) PaymentNotification(paymentNotification *PaymentNotification) *MessageBuilder {
+	if b.permit(TagPaymentNotification, "PaymentNotification") {
+		b.fwm.PaymentNotification = paymentNotification
+	}
+	return b
+}
+
+// Charges sets the Charges tag
+func (b *MessageBuilder) Charges(charges *Charges) *MessageBuilder {
+	if b.permit(TagCharges, "Charges") {
+		b.fwm.Charges = charges
+	}
+	return b
+}
+
+// InstructedAmount sets the InstructedAmount tag
+func (b *MessageBuilder) InstructedAmount(instructedAmount *InstructedAmount) *MessageBuilder {
+	if b.permit(TagInstructedAmount, "InstructedAmount") {
+		b.fwm.InstructedAmount = instructedAmount
+	}
+	return b
+}
+
+// ExchangeRate sets the ExchangeRate tag
+func (b *MessageBuilder) ExchangeRate(exchangeRate *ExchangeRate) *MessageBuilder {
+	if b.permit(TagExchangeRate, "ExchangeRate") {
+		b.fwm.ExchangeRate = exchangeRate
+	}
+	return b
+}
+
+// BeneficiaryIntermediaryFI sets the BeneficiaryIntermediaryFI tag
+func (b *MessageBuilder) BeneficiaryIntermediaryFI(beneficiaryIntermediaryFI *BeneficiaryIntermediaryFI) *MessageBuilder {
+	if b.permit(TagBeneficiaryIntermediaryFI, "BeneficiaryIntermediaryFI") {
+		b.fwm.BeneficiaryIntermediaryFI = beneficiaryIntermediaryFI
+	}
+	return b
+}
+
+// BeneficiaryFI sets the BeneficiaryFI tag
+func (b *MessageBuilder) BeneficiaryFI(beneficiaryFI *BeneficiaryFI) *MessageBuilder {
+	if b.permit(TagBeneficiaryFI, "BeneficiaryFI") {
+		b.fwm.BeneficiaryFI = beneficiaryFI
+	}
+	return b
+}
+
+// Beneficiary sets the Beneficiary tag
+func (b *MessageBuilder) Beneficiary(beneficiary *Beneficiary) *MessageBuilder {
+	if b.permit(TagBeneficiary, "Beneficiary") {
+		b.fwm.Beneficiary = beneficiary
+	}
+	return b
+}
+
+// BeneficiaryReference sets the BeneficiaryReference tag
+func (b *MessageBuilder) BeneficiaryReference(beneficiaryReference *BeneficiaryReference) *MessageBuilder {
+	if b.permit(TagBeneficiaryReference, "BeneficiaryReference") {
+		b.fwm.BeneficiaryReference = beneficiaryReference
+	}
+	return b
+}
+
+// AccountDebitedDrawdown sets the AccountDebitedDrawdown tag
+func (b *MessageBuilder) AccountDebitedDrawdown(accountDebitedDrawdown *AccountDebitedDrawdown) *MessageBuilder {
+	if b.permit(TagAccountDebitedDrawdown, "AccountDebitedDrawdown") {
+		b.fwm.AccountDebitedDrawdown = accountDebitedDrawdown
+	}
+	return b
+}
+
+// Originator sets the Originator tag
+func (b *MessageBuilder) Originator(originator *Originator) *MessageBuilder {
+	if b.permit(TagOriginator, "Originator") {
+		b.fwm.Originator = originator
+	}
+	return b
+}
+
+// OriginatorOptionF sets the OriginatorOptionF tag
+func (b *MessageBuilder) OriginatorOptionF(originatorOptionF *OriginatorOptionF) *MessageBuilder {
+	if b.permit(TagOriginatorOptionF, "OriginatorOptionF") {
+		b.fwm.OriginatorOptionF = originatorOptionF
+	}
+	return b
+}
+
+// OriginatorFI sets the OriginatorFI tag
+func (b *MessageBuilder) OriginatorFI(originatorFI *OriginatorFI) *MessageBuilder {
+	if b.permit(TagOriginatorFI, "OriginatorFI") {
+		b.fwm.OriginatorFI = originatorFI
+	}
+	return b
+}
+
+// InstructingFI sets the InstructingFI tag
+func (b *MessageBuilder) InstructingFI(instructingFI *InstructingFI) *MessageBuilder {
+	if b.permit(TagInstructingFI, "InstructingFI") {
+		b.fwm.InstructingFI = instructingFI
+	}
+	return b
+}
+
+// AccountCreditedDrawdown sets the AccountCreditedDrawdown tag
+func (b *MessageBuilder) AccountCreditedDrawdown(accountCreditedDrawdown *AccountCreditedDrawdown) *MessageBuilder {
+	if b.permit(TagAccountCreditedDrawdown, "AccountCreditedDrawdown") {
+		b.fwm.AccountCreditedDrawdown = accountCreditedDrawdown
+	}
+	return b
+}
+
+// OriginatorToBeneficiary sets the OriginatorToBeneficiary tag
+func (b *MessageBuilder) OriginatorToBeneficiary(originatorToBeneficiary *OriginatorToBeneficiary) *MessageBuilder {
+	if b.permit(TagOriginatorToBeneficiary, "OriginatorToBeneficiary") {
+		b.fwm.OriginatorToBeneficiary = originatorToBeneficiary
+	}
+	return b
+}
+
+// FIReceiverFI sets the FIReceiverFI tag
+func (b *MessageBuilder) FIReceiverFI(fIReceiverFI *FIReceiverFI) *MessageBuilder {
+	if b.permit(TagFIReceiverFI, "FIReceiverFI") {
+		b.fwm.FIReceiverFI = fIReceiverFI
+	}
+	return b
+}
+
+// FIDrawdownDebitAccountAdvice sets the FIDrawdownDebitAccountAdvice tag
+func (b *MessageBuilder) FIDrawdownDebitAccountAdvice(fIDrawdownDebitAccountAdvice *FIDrawdownDebitAccountAdvice) *MessageBuilder {
+	if b.permit(TagFIDrawdownDebitAccountAdvice, "FIDrawdownDebitAccountAdvice") {
+		b.fwm.FIDrawdownDebitAccountAdvice = fIDrawdownDebitAccountAdvice
+	}
+	return b
+}
+
+// FIIntermediaryFI sets the FIIntermediaryFI tag
+func (b *MessageBuilder) FIIntermediaryFI(fIIntermediaryFI *FIIntermediaryFI) *MessageBuilder {
+	if b.permit(TagFIIntermediaryFI, "FIIntermediaryFI") {
+		b.fwm.FIIntermediaryFI = fIIntermediaryFI
+	}
+	return b
+}
+
+// FIIntermediaryFIAdvice sets the FIIntermediaryFIAdvice tag
+func (b *MessageBuilder) FIIntermediaryFIAdvice(fIIntermediaryFIAdvice *FIIntermediaryFIAdvice) *MessageBuilder {
+	if b.permit(TagFIIntermediaryFIAdvice, "FIIntermediaryFIAdvice") {
+		b.fwm.FIIntermediaryFIAdvice = fIIntermediaryFIAdvice
+	}
+	return b
+}
+
+// FIBeneficiaryFI sets the FIBeneficiaryFI tag
+func (b *MessageBuilder) FIBeneficiaryFI(fIBeneficiaryFI *FIBeneficiaryFI) *MessageBuilder {
+	if b.permit(TagFIBeneficiaryFI, "FIBeneficiaryFI") {
+		b.fwm.FIBeneficiaryFI = fIBeneficiaryFI
+	}
+	return b
+}
+
+// FIBeneficiaryFIAdvice sets the FIBeneficiaryFIAdvice tag
+func (b *MessageBuilder) FIBeneficiaryFIAdvice(fIBeneficiaryFIAdvice *FIBeneficiaryFIAdvice) *MessageBuilder {
+	if b.permit(TagFIBeneficiaryFIAdvice, "FIBeneficiaryFIAdvice") {
+		b.fwm.FIBeneficiaryFIAdvice = fIBeneficiaryFIAdvice
+	}
+	return b
+}
+
+// FIBeneficiary sets the FIBeneficiary tag
+func (b *MessageBuilder) FIBeneficiary(fIBeneficiary *FIBeneficiary) *MessageBuilder {
+	if b.permit(TagFIBeneficiary, "FIBeneficiary") {
+		b.fwm.FIBeneficiary = fIBeneficiary
+	}
+	return b
+}
+
+// FIBeneficiaryAdvice sets the FIBeneficiaryAdvice tag
+func (b *MessageBuilder) FIBeneficiaryAdvice(fIBeneficiaryAdvice *FIBeneficiaryAdvice) *MessageBuilder {
+	if b.permit(TagFIBeneficiaryAdvice, "FIBeneficiaryAdvice") {
+		b.fwm.FIBeneficiaryAdvice = fIBeneficiaryAdvice
+	}
+	return b
+}
+
+// FIPaymentMethodToBeneficiary sets the FIPaymentMethodToBeneficiary tag
+func (b *MessageBuilder) FIPaymentMethodToBeneficiary(fIPaymentMethodToBeneficiary *FIPaymentMethodToBeneficiary) *MessageBuilder {
+	if b.permit(TagFIPaymentMethodToBeneficiary, "FIPaymentMethodToBeneficiary") {
+		b.fwm.FIPaymentMethodToBeneficiary = fIPaymentMethodToBeneficiary
+	}
+	return b
+}
+
+// FIAdditionalFIToFI sets the FIAdditionalFIToFI tag
+func (b *MessageBuilder) FIAdditionalFIToFI(fIAdditionalFIToFI *FIAdditionalFIToFI) *MessageBuilder {
+	if b.permit(TagFIAdditionalFIToFI, "FIAdditionalFIToFI") {
+		b.fwm.FIAdditionalFIToFI = fIAdditionalFIToFI
+	}
+	return b
+}
+
+// CurrencyInstructedAmount sets the CurrencyInstructedAmount tag
+func (b *MessageBuilder) CurrencyInstructedAmount(currencyInstructedAmount *CurrencyInstructedAmount) *MessageBuilder {
+	if b.permit(TagCurrencyInstructedAmount, "CurrencyInstructedAmount") {
+		b.fwm.CurrencyInstructedAmount = currencyInstructedAmount
+	}
+	return b
+}
+
+// OrderingCustomer sets the OrderingCustomer tag
+func (b *MessageBuilder) OrderingCustomer(orderingCustomer *OrderingCustomer) *MessageBuilder {
+	if b.permit(TagOrderingCustomer, "OrderingCustomer") {
+		b.fwm.OrderingCustomer = orderingCustomer
+	}
+	return b
+}
+
+// OrderingInstitution sets the OrderingInstitution tag
+func (b *MessageBuilder) OrderingInstitution(orderingInstitution *OrderingInstitution) *MessageBuilder {
+	if b.permit(TagOrderingInstitution, "OrderingInstitution") {
+		b.fwm.OrderingInstitution = orderingInstitution
+	}
+	return b
+}
+
+// IntermediaryInstitution sets the IntermediaryInstitution tag
+func (b *MessageBuilder) IntermediaryInstitution(intermediaryInstitution *IntermediaryInstitution) *MessageBuilder {
+	if b.permit(TagIntermediaryInstitution, "IntermediaryInstitution") {
+		b.fwm.IntermediaryInstitution = intermediaryInstitution
+	}
+	return b
+}
+
+// InstitutionAccount sets the InstitutionAccount tag
+func (b *MessageBuilder) InstitutionAccount(institutionAccount *InstitutionAccount) *MessageBuilder {
+	if b.permit(TagInstitutionAccount, "InstitutionAccount") {
+		b.fwm.InstitutionAccount = institutionAccount
+	}
+	return b
+}
+
+// BeneficiaryCustomer sets the BeneficiaryCustomer tag
+func (b *MessageBuilder) BeneficiaryCustomer(beneficiaryCustomer *BeneficiaryCustomer) *MessageBuilder {
+	if b.permit(TagBeneficiaryCustomer, "BeneficiaryCustomer") {
+		b.fwm.BeneficiaryCustomer = beneficiaryCustomer
+	}
+	return b
+}
+
+// Remittance sets the Remittance tag
+func (b *MessageBuilder) Remittance(remittance *Remittance) *MessageBuilder {
+	if b.permit(TagRemittance, "Remittance") {
+		b.fwm.Remittance = remittance
+	}
+	return b
+}
+
+// SenderToReceiver sets the SenderToReceiver tag
+func (b *MessageBuilder) SenderToReceiver(senderToReceiver *SenderToReceiver) *MessageBuilder {
+	if b.permit(TagSenderToReceiver, "SenderToReceiver") {
+		b.fwm.SenderToReceiver = senderToReceiver
+	}
+	return b
+}
+
+// UnstructuredAddenda sets the UnstructuredAddenda tag
+func (b *MessageBuilder) UnstructuredAddenda(unstructuredAddenda *UnstructuredAddenda) *MessageBuilder {
+	if b.permit(TagUnstructuredAddenda, "UnstructuredAddenda") {
+		b.fwm.UnstructuredAddenda = unstructuredAddenda
+	}
+	return b
+}
+
+// RelatedRemittance sets the RelatedRemittance tag
+func (b *MessageBuilder) RelatedRemittance(relatedRemittance *RelatedRemittance) *MessageBuilder {
+	if b.permit(TagRelatedRemittance, "RelatedRemittance") {
+		b.fwm.RelatedRemittance = relatedRemittance
+	}
+	return b
+}
+
+// RemittanceOriginator sets the RemittanceOriginator tag
+func (b *MessageBuilder) RemittanceOriginator(remittanceOriginator *RemittanceOriginator) *MessageBuilder {
+	if b.permit(TagRemittanceOriginator, "RemittanceOriginator") {
+		b.fwm.RemittanceOriginator = remittanceOriginator
+	}
+	return b
+}
+
+// RemittanceBeneficiary sets the RemittanceBeneficiary tag
+func (b *MessageBuilder) RemittanceBeneficiary(remittanceBeneficiary *RemittanceBeneficiary) *MessageBuilder {
+	if b.permit(TagRemittanceBeneficiary, "RemittanceBeneficiary") {
+		b.fwm.RemittanceBeneficiary = remittanceBeneficiary
+	}
+	return b
+}
+
+// PrimaryRemittanceDocument sets the PrimaryRemittanceDocument tag
+func (b *MessageBuilder) PrimaryRemittanceDocument(primaryRemittanceDocument *PrimaryRemittanceDocument) *MessageBuilder {
+	if b.permit(TagPrimaryRemittanceDocument, "PrimaryRemittanceDocument") {
+		b.fwm.PrimaryRemittanceDocument = primaryRemittanceDocument
+	}
+	return b
+}
+
+// ActualAmountPaid sets the ActualAmountPaid tag
+func (b *MessageBuilder) ActualAmountPaid(actualAmountPaid *ActualAmountPaid) *MessageBuilder {
+	if b.permit(TagActualAmountPaid, "ActualAmountPaid") {
+		b.fwm.ActualAmountPaid = actualAmountPaid
+	}
+	return b
+}
+
+// GrossAmountRemittanceDocument sets the GrossAmountRemittanceDocument tag
+func (b *MessageBuilder) GrossAmountRemittanceDocument(grossAmountRemittanceDocument *GrossAmountRemittanceDocument) *MessageBuilder {
+	if b.permit(TagGrossAmountRemittanceDocument, "GrossAmountRemittanceDocument") {
+		b.fwm.GrossAmountRemittanceDocument = grossAmountRemittanceDocument
+	}
+	return b
+}
+
+// AmountNegotiatedDiscount sets the AmountNegotiatedDiscount tag
+func (b *MessageBuilder) AmountNegotiatedDiscount(amountNegotiatedDiscount *AmountNegotiatedDiscount) *MessageBuilder {
+	if b.permit(TagAmountNegotiatedDiscount, "AmountNegotiatedDiscount") {
+		b.fwm.AmountNegotiatedDiscount = amountNegotiatedDiscount
+	}
+	return b
+}
+
+// Adjustment sets the Adjustment tag
+func (b *MessageBuilder) Adjustment(adjustment *Adjustment) *MessageBuilder {
+	if b.permit(TagAdjustment, "Adjustment") {
+		b.fwm.Adjustment = adjustment
+	}
+	return b
+}
+
+// DateRemittanceDocument sets the DateRemittanceDocument tag
+func (b *MessageBuilder) DateRemittanceDocument(dateRemittanceDocument *DateRemittanceDocument) *MessageBuilder {
+	if b.permit(TagDateRemittanceDocument, "DateRemittanceDocument") {
+		b.fwm.DateRemittanceDocument = dateRemittanceDocument
+	}
+	return b
+}
+
+// SecondaryRemittanceDocument sets the SecondaryRemittanceDocument tag
+func (b *MessageBuilder) SecondaryRemittanceDocument(secondaryRemittanceDocument *SecondaryRemittanceDocument) *MessageBuilder {
+	if b.permit(TagSecondaryRemittanceDocument, "SecondaryRemittanceDocument") {
+		b.fwm.SecondaryRemittanceDocument = secondaryRemittanceDocument
+	}
+	return b
+}
+
+// RemittanceFreeText sets the RemittanceFreeText tag
+func (b *MessageBuilder) RemittanceFreeText(remittanceFreeText *RemittanceFreeText) *MessageBuilder {
+	if b.permit(TagRemittanceFreeText, "RemittanceFreeText") {
+		b.fwm.RemittanceFreeText = remittanceFreeText
+	}
+	return b
+}
+
+// ServiceMessage sets the ServiceMessage tag
+func (b *MessageBuilder) ServiceMessage(serviceMessage *ServiceMessage) *MessageBuilder {
+	if b.permit(TagServiceMessage, "ServiceMessage") {
+		b.fwm.ServiceMessage = serviceMessage
+	}
+	return b
+}
@@ -0,0 +1,119 @@
+package wire
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockMessageBuilder returns a MessageBuilder with the mandatory tags populated
+func mockMessageBuilder(businessFunctionCode string) *MessageBuilder {
+	return NewMessageBuilder(businessFunctionCode).
+		IMAD(time.Now().Format("20060102"), "Source08", "000001").
+		Amount("000001234567").
+		SenderDI("121042882", "Wells Fargo NA").
+		ReceiverDI("231380104", "Citadel")
+}
+
+func TestMessageBuilder_Templates(t *testing.T) {
+	tests := []struct {
+		bfc   string
+		build func(b *MessageBuilder) *MessageBuilder
+	}{
+		{BankTransfer, func(b *MessageBuilder) *MessageBuilder { return b }},
+		{CustomerTransfer, func(b *MessageBuilder) *MessageBuilder {
+			return b.Beneficiary(mockBeneficiary()).Originator(mockOriginator())
+		}},
+		{CustomerTransferPlus, func(b *MessageBuilder) *MessageBuilder {
+			return b.Beneficiary(mockBeneficiary()).OriginatorOptionF(mockOriginatorOptionF())
+		}},
+		{CheckSameDaySettlement, func(b *MessageBuilder) *MessageBuilder { return b }},
+		{DepositSendersAccount, func(b *MessageBuilder) *MessageBuilder { return b }},
+		{FEDFundsReturned, func(b *MessageBuilder) *MessageBuilder { return b }},
+		{FEDFundsSold, func(b *MessageBuilder) *MessageBuilder { return b }},
+		{DrawdownResponse, func(b *MessageBuilder) *MessageBuilder {
+			return b.Beneficiary(mockBeneficiary()).Originator(mockOriginator())
+		}},
+		{BankDrawDownRequest, func(b *MessageBuilder) *MessageBuilder {
+			return b.AccountDebitedDrawdown(mockAccountDebitedDrawdown()).AccountCreditedDrawdown(mockAccountCreditedDrawdown())
+		}},
+		{CustomerCorporateDrawdownRequest, func(b *MessageBuilder) *MessageBuilder {
+			return b.Beneficiary(mockBeneficiary()).
+				AccountDebitedDrawdown(mockAccountDebitedDrawdown()).
+				AccountCreditedDrawdown(mockAccountCreditedDrawdown())
+		}},
+		{BFCServiceMessage, func(b *MessageBuilder) *MessageBuilder { return b.ServiceMessage(mockServiceMessage()) }},
+	}
+	for _, test := range tests {
+		t.Run(test.bfc, func(t *testing.T) {
+			fwm, err := test.build(mockMessageBuilder(test.bfc)).Build()
+			require.NoError(t, err)
+			require.Equal(t, test.bfc, fwm.BusinessFunctionCode.BusinessFunctionCode)
+			require.NotNil(t, fwm.SenderSupplied)
+
+			file := NewFile()
+			file.AddFEDWireMessage(*fwm)
+			require.NoError(t, file.Validate())
+		})
+	}
+}
+
+func TestMessageBuilder_InvalidBusinessFunctionCode(t *testing.T) {
+	_, err := mockMessageBuilder("ZZZ").Build()
+	require.True(t, errors.Is(err, ErrBusinessFunctionCode))
+	require.Nil(t, PermittedTags("ZZZ"))
+}
+
+func TestMessageBuilder_NotPermitted(t *testing.T) {
+	_, err := mockMessageBuilder(BankTransfer).
+		LocalInstrument(mockLocalInstrument()).
+		Build()
+	require.EqualError(t, err, fieldError("LocalInstrument", ErrNotPermitted, BankTransfer).Error())
+
+	_, err = mockMessageBuilder(FEDFundsSold).
+		AccountDebitedDrawdown(mockAccountDebitedDrawdown()).
+		Build()
+	require.EqualError(t, err, fieldError("AccountDebitedDrawdown", ErrNotPermitted, FEDFundsSold).Error())
+}
+
+func TestMessageBuilder_MissingRequired(t *testing.T) {
+	_, err := mockMessageBuilder(CustomerTransfer).
+		Beneficiary(mockBeneficiary()).
+		Build()
+	require.EqualError(t, err, fieldError("Originator", ErrFieldRequired).Error())
+
+	_, err = mockMessageBuilder(CustomerTransferPlus).
+		Beneficiary(mockBeneficiary()).
+		Build()
+	require.EqualError(t, err, fieldError("Originator", ErrFieldRequired).Error())
+
+	_, err = NewMessageBuilder(BankTransfer).Build()
+	require.EqualError(t, err, fieldError("InputMessageAccountabilityData", ErrFieldRequired).Error())
+}
+
+func TestMessageBuilder_TypeSubType(t *testing.T) {
+	_, err := mockMessageBuilder(BankTransfer).
+		TypeSubType(FundsTransfer, ReversalTransfer).
+		Build()
+	require.EqualError(t, err, fieldError("PreviousMessageIdentifier", ErrFieldRequired).Error())
+
+	fwm, err := mockMessageBuilder(BankTransfer).
+		TypeSubType(FundsTransfer, ReversalTransfer).
+		PreviousMessageIdentifier(mockPreviousMessageIdentifier()).
+		Build()
+	require.NoError(t, err)
+	require.Equal(t, ReversalTransfer, fwm.TypeSubType.SubTypeCode)
+}
+
+func TestPermittedTags(t *testing.T) {
+	tags := PermittedTags(CustomerTransferPlus)
+	require.Contains(t, tags, TagLocalInstrument)
+	require.Contains(t, tags, TagRemittanceOriginator)
+	require.NotContains(t, tags, TagAccountDebitedDrawdown)
+
+	tags = PermittedTags(BankDrawDownRequest)
+	require.Contains(t, tags, TagAccountDebitedDrawdown)
+	require.NotContains(t, tags, TagCharges)
+}
@@ -0,0 +1,247 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// DiffKind is how a field differs between two messages
+type DiffKind string
+
+const (
+	// DiffAdded is a field only present in the second message
+	DiffAdded DiffKind = "added"
+	// DiffRemoved is a field only present in the first message
+	DiffRemoved DiffKind = "removed"
+	// DiffChanged is a field with a different value in each message
+	DiffChanged DiffKind = "changed"
+)
+
+// Difference is a difference in one field of a tag between two messages
+type Difference struct {
+	// Tag is the tag number, such as {2000}
+	Tag string `json:"tag"`
+	// Field is the path of the field within the tag, such as Personal.Address.AddressLineOne. Field is empty for a
+	// tag added or removed without any values.
+	Field string   `json:"field,omitempty"`
+	Kind  DiffKind `json:"kind"`
+	From  string   `json:"from,omitempty"`
+	To    string   `json:"to,omitempty"`
+}
+
+// String returns the difference as a line such as `{2000} Amount changed: "000000001000" -> "000000002000"`
+func (d Difference) String() string {
+	name := d.Tag
+	if d.Field != "" {
+		name += " " + d.Field
+	}
+	switch {
+	case d.Field == "" && d.From == "" && d.To == "":
+		return fmt.Sprintf("%s %s", name, d.Kind)
+	case d.Kind == DiffAdded:
+		return fmt.Sprintf("%s added: %q", name, d.To)
+	case d.Kind == DiffRemoved:
+		return fmt.Sprintf("%s removed: %q", name, d.From)
+	}
+	return fmt.Sprintf("%s changed: %q -> %q", name, d.From, d.To)
+}
+
+// DiffOpts configures how messages are compared
+type DiffOpts struct {
+	// IgnoreOutputTags skips the tags the Fedwire Funds Service appends to output messages: MessageDisposition,
+	// ReceiptTimeStamp, OutputMessageAccountabilityData and ErrorWire
+	IgnoreOutputTags bool
+}
+
+// outputTags are the tags only present in output messages
+var outputTags = map[string]bool{
+	TagMessageDisposition:              true,
+	TagReceiptTimeStamp:                true,
+	TagOutputMessageAccountabilityData: true,
+	TagErrorWire:                       true,
+}
+
+// fieldTags maps FEDWireMessage fields to their tag
+var fieldTags = map[string]string{
+	"MessageDisposition":              TagMessageDisposition,
+	"ReceiptTimeStamp":                TagReceiptTimeStamp,
+	"OutputMessageAccountabilityData": TagOutputMessageAccountabilityData,
+	"ErrorWire":                       TagErrorWire,
+	"SenderSupplied":                  TagSenderSupplied,
+	"TypeSubType":                     TagTypeSubType,
+	"InputMessageAccountabilityData":  TagInputMessageAccountabilityData,
+	"Amount":                          TagAmount,
+	"SenderDepositoryInstitution":     TagSenderDepositoryInstitution,
+	"ReceiverDepositoryInstitution":   TagReceiverDepositoryInstitution,
+	"BusinessFunctionCode":            TagBusinessFunctionCode,
+	"SenderReference":                 TagSenderReference,
+	"PreviousMessageIdentifier":       TagPreviousMessageIdentifier,
+	"LocalInstrument":                 TagLocalInstrument,
+	"PaymentNotification":             TagPaymentNotification,
+	"Charges":                         TagCharges,
+	"InstructedAmount":                TagInstructedAmount,
+	"ExchangeRate":                    TagExchangeRate,
+	"BeneficiaryIntermediaryFI":       TagBeneficiaryIntermediaryFI,
+	"BeneficiaryFI":                   TagBeneficiaryFI,
+	"Beneficiary":                     TagBeneficiary,
+	"BeneficiaryReference":            TagBeneficiaryReference,
+	"AccountDebitedDrawdown":          TagAccountDebitedDrawdown,
+	"Originator":                      TagOriginator,
+	"OriginatorOptionF":               TagOriginatorOptionF,
+	"OriginatorFI":                    TagOriginatorFI,
+	"InstructingFI":                   TagInstructingFI,
+	"AccountCreditedDrawdown":         TagAccountCreditedDrawdown,
+	"OriginatorToBeneficiary":         TagOriginatorToBeneficiary,
+	"FIReceiverFI":                    TagFIReceiverFI,
+	"FIDrawdownDebitAccountAdvice":    TagFIDrawdownDebitAccountAdvice,
+	"FIIntermediaryFI":                TagFIIntermediaryFI,
+	"FIIntermediaryFIAdvice":          TagFIIntermediaryFIAdvice,
+	"FIBeneficiaryFI":                 TagFIBeneficiaryFI,
+	"FIBeneficiaryFIAdvice":           TagFIBeneficiaryFIAdvice,
+	"FIBeneficiary":                   TagFIBeneficiary,
+	"FIBeneficiaryAdvice":             TagFIBeneficiaryAdvice,
+	"FIPaymentMethodToBeneficiary":    TagFIPaymentMethodToBeneficiary,
+	"FIAdditionalFIToFI":              TagFIAdditionalFIToFI,
+	"CurrencyInstructedAmount":        TagCurrencyInstructedAmount,
+	"OrderingCustomer":                TagOrderingCustomer,
+	"OrderingInstitution":             TagOrderingInstitution,
+	"IntermediaryInstitution":         TagIntermediaryInstitution,
+	"InstitutionAccount":              TagInstitutionAccount,
+	"BeneficiaryCustomer":             TagBeneficiaryCustomer,
+	"Remittance":                      TagRemittance,
+	"SenderToReceiver":                TagSenderToReceiver,
+	"UnstructuredAddenda":             TagUnstructuredAddenda,
+	"RelatedRemittance":               TagRelatedRemittance,
+	"RemittanceOriginator":            TagRemittanceOriginator,
+	"RemittanceBeneficiary":           TagRemittanceBeneficiary,
+	"PrimaryRemittanceDocument":       TagPrimaryRemittanceDocument,
+	"ActualAmountPaid":                TagActualAmountPaid,
+	"GrossAmountRemittanceDocument":   TagGrossAmountRemittanceDocument,
+	"AmountNegotiatedDiscount":        TagAmountNegotiatedDiscount,
+	"Adjustment":                      TagAdjustment,
+	"DateRemittanceDocument":          TagDateRemittanceDocument,
+	"SecondaryRemittanceDocument":     TagSecondaryRemittanceDocument,
+	"RemittanceFreeText":              TagRemittanceFreeText,
+	"ServiceMessage":                  TagServiceMessage,
+}
+
+// Diff returns the differences between the tags of messages a and b, ordered by tag and field.
+//
+// Values are compared without surrounding spaces, so a message read from fixed and variable length files has no
+// differences.
+func Diff(a, b *FEDWireMessage) []Difference {
+	return DiffWithOpts(a, b, DiffOpts{})
+}
+
+// DiffWithOpts returns the differences between the tags of messages a and b according to the DiffOpts
+func DiffWithOpts(a, b *FEDWireMessage, opts DiffOpts) []Difference {
+	if a == nil {
+		a = &FEDWireMessage{}
+	}
+	if b == nil {
+		b = &FEDWireMessage{}
+	}
+	av, bv := reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem()
+
+	var diffs []Difference
+	for i := 0; i < av.NumField(); i++ {
+		tag, ok := fieldTags[av.Type().Field(i).Name]
+		if !ok || (opts.IgnoreOutputTags && outputTags[tag]) {
+			continue
+		}
+		from, to := tagValues(av.Field(i)), tagValues(bv.Field(i))
+		diffs = append(diffs, diffTag(tag, from, to)...)
+	}
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return diffs[i].Tag < diffs[j].Tag
+	})
+	return diffs
+}
+
+// diffTag returns the differences between the field values of one tag, which are nil when the tag is absent
+func diffTag(tag string, from, to map[string]string) []Difference {
+	switch {
+	case from == nil && to == nil:
+		return nil
+	case from == nil && len(to) == 0:
+		return []Difference{{Tag: tag, Kind: DiffAdded}}
+	case to == nil && len(from) == 0:
+		return []Difference{{Tag: tag, Kind: DiffRemoved}}
+	}
+
+	fields := make(map[string]bool)
+	for field := range from {
+		fields[field] = true
+	}
+	for field := range to {
+		fields[field] = true
+	}
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	var diffs []Difference
+	for _, field := range names {
+		f, inFrom := from[field]
+		t, inTo := to[field]
+		switch {
+		case !inFrom:
+			diffs = append(diffs, Difference{Tag: tag, Field: field, Kind: DiffAdded, To: t})
+		case !inTo:
+			diffs = append(diffs, Difference{Tag: tag, Field: field, Kind: DiffRemoved, From: f})
+		case f != t:
+			diffs = append(diffs, Difference{Tag: tag, Field: field, Kind: DiffChanged, From: f, To: t})
+		}
+	}
+	return diffs
+}
+
+// tagValues returns the non-blank values of the exported fields of a tag keyed by field path, or nil for a nil tag
+func tagValues(v reflect.Value) map[string]string {
+	if v.IsNil() {
+		return nil
+	}
+	values := make(map[string]string)
+	collectValues(v.Elem(), "", func(field, value string) {
+		values[field] = value
+	})
+	return values
+}
+
+// collectValues calls add with the path and value of each non-blank exported field of v, in declaration order
+func collectValues(v reflect.Value, prefix string, add func(field, value string)) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			collectValues(v.Elem(), prefix, add)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			name := field.Name
+			if prefix != "" {
+				name = prefix + "." + name
+			}
+			collectValues(v.Field(i), name, add)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			collectValues(v.Index(i), fmt.Sprintf("%s[%d]", prefix, i), add)
+		}
+	default:
+		if s := strings.TrimSpace(fmt.Sprint(v.Interface())); s != "" {
+			add(prefix, s)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	a, b := mockCustomerTransferData(), mockCustomerTransferData()
+	a.Beneficiary = mockBeneficiary()
+	b.Beneficiary = mockBeneficiary()
+	require.Empty(t, Diff(&a, &b))
+
+	b.Amount.Amount = "000000002000"
+	b.Beneficiary.Personal.Address.AddressLineTwo = ""
+	b.Beneficiary.Personal.Address.AddressLineThree = "New Address"
+	b.SenderReference = mockSenderReference()
+	a.OriginatorToBeneficiary = mockOriginatorToBeneficiary()
+
+	diffs := Diff(&a, &b)
+	require.Equal(t, []Difference{
+		{Tag: TagAmount, Field: "Amount", Kind: DiffChanged, From: a.Amount.Amount, To: "000000002000"},
+		{Tag: TagSenderReference, Field: "SenderReference", Kind: DiffAdded, To: b.SenderReference.SenderReference},
+		{Tag: TagBeneficiary, Field: "Personal.Address.AddressLineThree", Kind: DiffChanged,
+			From: a.Beneficiary.Personal.Address.AddressLineThree, To: "New Address"},
+		{Tag: TagBeneficiary, Field: "Personal.Address.AddressLineTwo", Kind: DiffRemoved,
+			From: a.Beneficiary.Personal.Address.AddressLineTwo},
+		{Tag: TagOriginatorToBeneficiary, Field: "LineFour", Kind: DiffRemoved, From: a.OriginatorToBeneficiary.LineFour},
+		{Tag: TagOriginatorToBeneficiary, Field: "LineOne", Kind: DiffRemoved, From: a.OriginatorToBeneficiary.LineOne},
+		{Tag: TagOriginatorToBeneficiary, Field: "LineThree", Kind: DiffRemoved, From: a.OriginatorToBeneficiary.LineThree},
+		{Tag: TagOriginatorToBeneficiary, Field: "LineTwo", Kind: DiffRemoved, From: a.OriginatorToBeneficiary.LineTwo},
+	}, diffs)
+	require.Equal(t, `{2000} Amount changed: "`+a.Amount.Amount+`" -> "000000002000"`, diffs[0].String())
+}
+
+func TestDiff_OutputTags(t *testing.T) {
+	a, b := mockCustomerTransferData(), mockCustomerTransferData()
+	b.MessageDisposition = NewMessageDisposition()
+	b.OutputMessageAccountabilityData = NewOutputMessageAccountabilityData()
+
+	diffs := Diff(&a, &b)
+	require.Len(t, diffs, 3)
+	require.Equal(t, TagMessageDisposition, diffs[0].Tag)
+	require.Equal(t, DiffAdded, diffs[0].Kind)
+	require.Equal(t, Difference{Tag: TagOutputMessageAccountabilityData, Kind: DiffAdded}, diffs[2])
+
+	require.Empty(t, DiffWithOpts(&a, &b, DiffOpts{IgnoreOutputTags: true}))
+	require.NotEmpty(t, Diff(&a, nil))
+}
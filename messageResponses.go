@@ -0,0 +1,267 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import "strings"
+
+// DrawdownResponse returns a DrawdownResponse (DRW) message honoring an inbound BankDrawDownRequest (DRB) or
+// CustomerCorporateDrawdownRequest (DRC).
+//
+// The sender and receiver depository institutions are swapped, the request IMAD is copied into
+// PreviousMessageIdentifier, the AccountDebitedDrawdown becomes the Originator and the requesting party becomes
+// the Beneficiary. The returned message has no InputMessageAccountabilityData, the caller assigns one before sending.
+func (fwm *FEDWireMessage) DrawdownResponse() (*FEDWireMessage, error) {
+	if err := fwm.checkDrawdownRequest(); err != nil {
+		return nil, err
+	}
+	resp := fwm.newResponse(DrawdownResponse, FundsTransferRequestCredit)
+
+	ben := NewBeneficiary()
+	if fwm.Beneficiary != nil {
+		ben.Personal = fwm.Beneficiary.Personal
+	} else {
+		ben.Personal.IdentificationCode = FEDRoutingNumber
+		ben.Personal.Identifier = fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber
+		ben.Personal.Name = fwm.SenderDepositoryInstitution.SenderShortName
+	}
+	resp.Beneficiary = ben
+	resp.BeneficiaryFI = fwm.BeneficiaryFI
+
+	o := NewOriginator()
+	o.Personal.IdentificationCode = fwm.AccountDebitedDrawdown.IdentificationCode
+	o.Personal.Identifier = fwm.AccountDebitedDrawdown.Identifier
+	o.Personal.Name = fwm.AccountDebitedDrawdown.Name
+	o.Personal.Address = fwm.AccountDebitedDrawdown.Address
+	resp.Originator = o
+
+	if err := resp.verifyResponse(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DrawdownRefusal returns a refusal (SubTypeCode 33) of an inbound BankDrawDownRequest (DRB) or
+// CustomerCorporateDrawdownRequest (DRC).
+//
+// The sender and receiver depository institutions are swapped, the request IMAD is copied into
+// PreviousMessageIdentifier and the drawdown accounts and Beneficiary of the request are kept. The returned
+// message has no InputMessageAccountabilityData, the caller assigns one before sending.
+func (fwm *FEDWireMessage) DrawdownRefusal() (*FEDWireMessage, error) {
+	if err := fwm.checkDrawdownRequest(); err != nil {
+		return nil, err
+	}
+	resp := fwm.newResponse(fwm.BusinessFunctionCode.BusinessFunctionCode, RefusalRequestCredit)
+	resp.Beneficiary = fwm.Beneficiary
+	resp.BeneficiaryFI = fwm.BeneficiaryFI
+	resp.AccountDebitedDrawdown = fwm.AccountDebitedDrawdown
+	resp.AccountCreditedDrawdown = fwm.AccountCreditedDrawdown
+
+	if err := resp.verifyResponse(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ReversalTransfer returns the reversal transfer for an inbound request for reversal.
+//
+// A RequestReversal (01) is answered with a ReversalTransfer (02) and a RequestReversalPriorDayTransfer (07) with a
+// ReversalPriorDayTransfer (08). The sender and receiver depository institutions, Beneficiary and Originator, and
+// BeneficiaryFI and OriginatorFI are swapped. PreviousMessageIdentifier refers to the original transfer when the
+// request carries one, otherwise to the IMAD of the request.
+//
+// Requests sent as a ServiceMessage (SVC) are reversed as a CustomerTransfer when they identify a Beneficiary and
+// Originator, otherwise as a BankTransfer. The returned message has no InputMessageAccountabilityData, the caller
+// assigns one before sending.
+func (fwm *FEDWireMessage) ReversalTransfer() (*FEDWireMessage, error) {
+	if fwm.TypeSubType == nil || fwm.BusinessFunctionCode == nil {
+		return nil, fieldError("TypeSubType", ErrNotReversalRequest)
+	}
+	var subTypeCode string
+	switch fwm.TypeSubType.SubTypeCode {
+	case RequestReversal:
+		subTypeCode = ReversalTransfer
+	case RequestReversalPriorDayTransfer:
+		subTypeCode = ReversalPriorDayTransfer
+	default:
+		return nil, fieldError("TypeSubType", ErrNotReversalRequest, fwm.TypeSubType.SubTypeCode)
+	}
+	if err := fwm.checkResponseFields(); err != nil {
+		return nil, err
+	}
+
+	bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
+	if bfc == BFCServiceMessage {
+		bfc = BankTransfer
+		if fwm.Beneficiary != nil && fwm.Originator != nil {
+			bfc = CustomerTransfer
+		}
+	}
+	resp := fwm.newResponse(bfc, subTypeCode)
+	if fwm.PreviousMessageIdentifier != nil {
+		pmi := NewPreviousMessageIdentifier()
+		pmi.PreviousMessageIdentifier = fwm.PreviousMessageIdentifier.PreviousMessageIdentifier
+		resp.PreviousMessageIdentifier = pmi
+	}
+
+	if err := fwm.reverseParties(resp); err != nil {
+		return nil, err
+	}
+
+	if err := resp.verifyResponse(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// reverseParties copies the parties of fwm into resp with Beneficiary and Originator, and BeneficiaryFI and
+// OriginatorFI swapped
+func (fwm *FEDWireMessage) reverseParties(resp *FEDWireMessage) error {
+	if fwm.Beneficiary != nil {
+		o := NewOriginator()
+		o.Personal = fwm.Beneficiary.Personal
+		resp.Originator = o
+	}
+	if fwm.Originator != nil {
+		ben := NewBeneficiary()
+		ben.Personal = fwm.Originator.Personal
+		resp.Beneficiary = ben
+	} else if fwm.OriginatorOptionF != nil {
+		ben, err := beneficiaryFromOptionF(fwm.OriginatorOptionF)
+		if err != nil {
+			return err
+		}
+		resp.Beneficiary = ben
+	}
+	if fwm.BeneficiaryFI != nil {
+		ofi := NewOriginatorFI()
+		ofi.FinancialInstitution = fwm.BeneficiaryFI.FinancialInstitution
+		resp.OriginatorFI = ofi
+	}
+	if fwm.OriginatorFI != nil {
+		bfi := NewBeneficiaryFI()
+		bfi.FinancialInstitution = fwm.OriginatorFI.FinancialInstitution
+		resp.BeneficiaryFI = bfi
+	}
+	return nil
+}
+
+// checkDrawdownRequest returns an error if the message is not a drawdown request which can be responded to
+func (fwm *FEDWireMessage) checkDrawdownRequest() error {
+	if fwm.BusinessFunctionCode == nil {
+		return fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+	switch fwm.BusinessFunctionCode.BusinessFunctionCode {
+	case BankDrawDownRequest, CustomerCorporateDrawdownRequest:
+	default:
+		return fieldError("BusinessFunctionCode", ErrNotDrawdownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	if fwm.TypeSubType == nil || fwm.TypeSubType.SubTypeCode != RequestCredit {
+		return fieldError("TypeSubType", ErrNotDrawdownRequest)
+	}
+	if fwm.AccountDebitedDrawdown == nil {
+		return fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+	}
+	if fwm.AccountCreditedDrawdown == nil {
+		return fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+	}
+	return fwm.checkResponseFields()
+}
+
+// checkResponseFields returns an error if the tags copied into a response are missing
+func (fwm *FEDWireMessage) checkResponseFields() error {
+	if fwm.InputMessageAccountabilityData == nil {
+		return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	if fwm.Amount == nil {
+		return fieldError("Amount", ErrFieldRequired)
+	}
+	if fwm.SenderDepositoryInstitution == nil {
+		return fieldError("SenderDepositoryInstitution", ErrFieldRequired)
+	}
+	if fwm.ReceiverDepositoryInstitution == nil {
+		return fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)
+	}
+	return nil
+}
+
+// newResponse returns a message with the mandatory tags of a response to fwm. The depository institutions are
+// swapped and PreviousMessageIdentifier is set to the IMAD of fwm.
+func (fwm *FEDWireMessage) newResponse(businessFunctionCode, subTypeCode string) *FEDWireMessage {
+	resp := &FEDWireMessage{}
+
+	resp.SenderSupplied = NewSenderSupplied()
+	if fwm.SenderSupplied != nil {
+		resp.SenderSupplied.TestProductionCode = fwm.SenderSupplied.TestProductionCode
+	}
+
+	resp.TypeSubType = NewTypeSubType()
+	resp.TypeSubType.TypeCode = fwm.TypeSubType.TypeCode
+	resp.TypeSubType.SubTypeCode = subTypeCode
+
+	resp.Amount = NewAmount()
+	resp.Amount.Amount = fwm.Amount.Amount
+
+	resp.SenderDepositoryInstitution = NewSenderDepositoryInstitution()
+	resp.SenderDepositoryInstitution.SenderABANumber = fwm.ReceiverDepositoryInstitution.ReceiverABANumber
+	resp.SenderDepositoryInstitution.SenderShortName = fwm.ReceiverDepositoryInstitution.ReceiverShortName
+
+	resp.ReceiverDepositoryInstitution = NewReceiverDepositoryInstitution()
+	resp.ReceiverDepositoryInstitution.ReceiverABANumber = fwm.SenderDepositoryInstitution.SenderABANumber
+	resp.ReceiverDepositoryInstitution.ReceiverShortName = fwm.SenderDepositoryInstitution.SenderShortName
+
+	resp.BusinessFunctionCode = NewBusinessFunctionCode()
+	resp.BusinessFunctionCode.BusinessFunctionCode = businessFunctionCode
+
+	resp.PreviousMessageIdentifier = NewPreviousMessageIdentifier()
+	resp.PreviousMessageIdentifier.PreviousMessageIdentifier = fwm.InputMessageAccountabilityData.Identifier()
+	return resp
+}
+
+// verifyResponse validates a generated response, which does not have an IMAD assigned yet
+func (fwm *FEDWireMessage) verifyResponse() error {
+	check := *fwm
+	check.ValidateOptions = &ValidateOpts{SkipMandatoryIMAD: true}
+	return check.verify()
+}
+
+// beneficiaryFromOptionF returns a Beneficiary identifying the party of an OriginatorOptionF
+func beneficiaryFromOptionF(oof *OriginatorOptionF) (*Beneficiary, error) {
+	details, err := oof.Details()
+	if err != nil {
+		return nil, err
+	}
+	ben := NewBeneficiary()
+	pi := details.PartyIdentifier
+	switch {
+	case pi.IsAccount():
+		ben.Personal.IdentificationCode = DemandDepositAccountNumber
+		ben.Personal.Identifier = pi.Account
+	default:
+		ben.Personal.IdentificationCode = optionFIdentificationCodes[pi.Code]
+		if ben.Personal.IdentificationCode == "" {
+			ben.Personal.IdentificationCode = OtherIdentification
+		}
+		ben.Personal.Identifier = pi.Identifier
+	}
+	ben.Personal.Name = strings.Join(details.Name, " ")
+	if len(details.Address) > 0 {
+		ben.Personal.Address.AddressLineOne = details.Address[0]
+	}
+	if len(details.Address) > 1 {
+		ben.Personal.Address.AddressLineTwo = details.Address[1]
+	}
+	if !details.CountryTown.IsZero() {
+		ben.Personal.Address.AddressLineThree = details.CountryTown.String()
+	}
+	return ben, nil
+}
+
+// optionFIdentificationCodes maps OriginatorOptionF PartyIdentifier codes to Personal IdentificationCode values
+var optionFIdentificationCodes = map[string]string{
+	PartyIdentifierPassportNumber:          PassportNumber,
+	PartyIdentifierTaxIdentificationNumber: TaxIdentificationNumber,
+	PartyIdentifierDriversLicenseNumber:    DriversLicenseNumber,
+	PartyIdentifierAlienRegistrationNumber: AlienRegistrationNumber,
+}
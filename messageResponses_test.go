@@ -0,0 +1,148 @@
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockDrawdownRequest creates a CustomerCorporateDrawdownRequest FEDWireMessage
+func mockDrawdownRequest() *FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.TypeSubType.TypeCode = FundsTransfer
+	fwm.TypeSubType.SubTypeCode = RequestCredit
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerCorporateDrawdownRequest
+	fwm.BusinessFunctionCode.TransactionTypeCode = ""
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.AccountDebitedDrawdown = mockAccountDebitedDrawdown()
+	fwm.AccountCreditedDrawdown = mockAccountCreditedDrawdown()
+	return &fwm
+}
+
+// mockReversalRequest creates a CustomerTransferPlus request for reversal FEDWireMessage
+func mockReversalRequest() *FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.TypeSubType.SubTypeCode = RequestReversal
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.BusinessFunctionCode.TransactionTypeCode = ""
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.BeneficiaryFI = mockBeneficiaryFI()
+	fwm.Originator = mockOriginator()
+	fwm.OriginatorFI = mockOriginatorFI()
+	return &fwm
+}
+
+func TestMockDrawdownRequest(t *testing.T) {
+	require.NoError(t, mockDrawdownRequest().verify())
+	require.NoError(t, mockReversalRequest().verify())
+}
+
+func TestFEDWireMessage_DrawdownResponse(t *testing.T) {
+	req := mockDrawdownRequest()
+
+	resp, err := req.DrawdownResponse()
+	require.NoError(t, err)
+	require.Equal(t, DrawdownResponse, resp.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, FundsTransfer+FundsTransferRequestCredit, resp.TypeSubType.TypeCode+resp.TypeSubType.SubTypeCode)
+	require.Equal(t, req.InputMessageAccountabilityData.Identifier(), resp.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, req.ReceiverDepositoryInstitution.ReceiverABANumber, resp.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, req.SenderDepositoryInstitution.SenderABANumber, resp.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, req.Amount.Amount, resp.Amount.Amount)
+	require.Equal(t, req.Beneficiary.Personal, resp.Beneficiary.Personal)
+	require.Equal(t, req.AccountDebitedDrawdown.Identifier, resp.Originator.Personal.Identifier)
+	require.Nil(t, resp.InputMessageAccountabilityData)
+
+	resp.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	require.NoError(t, resp.verify())
+}
+
+func TestFEDWireMessage_DrawdownResponseBank(t *testing.T) {
+	req := mockDrawdownRequest()
+	req.TypeSubType.TypeCode = SettlementTransfer
+	req.BusinessFunctionCode.BusinessFunctionCode = BankDrawDownRequest
+	req.Beneficiary = nil
+	require.NoError(t, req.verify())
+
+	resp, err := req.DrawdownResponse()
+	require.NoError(t, err)
+	require.Equal(t, SettlementTransfer+FundsTransferRequestCredit, resp.TypeSubType.TypeCode+resp.TypeSubType.SubTypeCode)
+	require.Equal(t, FEDRoutingNumber, resp.Beneficiary.Personal.IdentificationCode)
+	require.Equal(t, req.AccountCreditedDrawdown.DrawdownCreditAccountNumber, resp.Beneficiary.Personal.Identifier)
+}
+
+func TestFEDWireMessage_DrawdownRefusal(t *testing.T) {
+	req := mockDrawdownRequest()
+
+	resp, err := req.DrawdownRefusal()
+	require.NoError(t, err)
+	require.Equal(t, CustomerCorporateDrawdownRequest, resp.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, RefusalRequestCredit, resp.TypeSubType.SubTypeCode)
+	require.Equal(t, req.InputMessageAccountabilityData.Identifier(), resp.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, req.AccountDebitedDrawdown, resp.AccountDebitedDrawdown)
+}
+
+func TestFEDWireMessage_DrawdownResponseErrors(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	_, err := fwm.DrawdownResponse()
+	require.True(t, errors.Is(err, ErrNotDrawdownRequest))
+
+	req := mockDrawdownRequest()
+	req.TypeSubType.SubTypeCode = RefusalRequestCredit
+	_, err = req.DrawdownRefusal()
+	require.True(t, errors.Is(err, ErrNotDrawdownRequest))
+
+	req = mockDrawdownRequest()
+	req.InputMessageAccountabilityData = nil
+	_, err = req.DrawdownResponse()
+	require.EqualError(t, err, fieldError("InputMessageAccountabilityData", ErrFieldRequired).Error())
+}
+
+func TestFEDWireMessage_ReversalTransfer(t *testing.T) {
+	req := mockReversalRequest()
+
+	resp, err := req.ReversalTransfer()
+	require.NoError(t, err)
+	require.Equal(t, CustomerTransferPlus, resp.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, ReversalTransfer, resp.TypeSubType.SubTypeCode)
+	require.Equal(t, req.InputMessageAccountabilityData.Identifier(), resp.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, req.Originator.Personal, resp.Beneficiary.Personal)
+	require.Equal(t, req.Beneficiary.Personal, resp.Originator.Personal)
+	require.Equal(t, req.OriginatorFI.FinancialInstitution, resp.BeneficiaryFI.FinancialInstitution)
+	require.Equal(t, req.BeneficiaryFI.FinancialInstitution, resp.OriginatorFI.FinancialInstitution)
+
+	// a prior day request refers to the original transfer
+	req.TypeSubType.SubTypeCode = RequestReversalPriorDayTransfer
+	req.PreviousMessageIdentifier = mockPreviousMessageIdentifier()
+	resp, err = req.ReversalTransfer()
+	require.NoError(t, err)
+	require.Equal(t, ReversalPriorDayTransfer, resp.TypeSubType.SubTypeCode)
+	require.Equal(t, req.PreviousMessageIdentifier.PreviousMessageIdentifier, resp.PreviousMessageIdentifier.PreviousMessageIdentifier)
+}
+
+func TestFEDWireMessage_ReversalTransferOptionF(t *testing.T) {
+	req := mockReversalRequest()
+	req.Originator = nil
+	req.OriginatorOptionF = mockOriginatorOptionF()
+
+	resp, err := req.ReversalTransfer()
+	require.NoError(t, err)
+	require.Equal(t, TaxIdentificationNumber, resp.Beneficiary.Personal.IdentificationCode)
+	require.Equal(t, "123-45-6789", resp.Beneficiary.Personal.Identifier)
+	require.Equal(t, "Name 1234", resp.Beneficiary.Personal.Name)
+}
+
+func TestFEDWireMessage_ReversalTransferServiceMessage(t *testing.T) {
+	req := mockReversalRequest()
+	req.BusinessFunctionCode.BusinessFunctionCode = BFCServiceMessage
+
+	resp, err := req.ReversalTransfer()
+	require.NoError(t, err)
+	require.Equal(t, CustomerTransfer, resp.BusinessFunctionCode.BusinessFunctionCode)
+}
+
+func TestFEDWireMessage_ReversalTransferErrors(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	_, err := fwm.ReversalTransfer()
+	require.True(t, errors.Is(err, ErrNotReversalRequest))
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+)
+
+// ReturnType is how the funds of a received message are returned
+type ReturnType string
+
+const (
+	// ReturnSameDayReversal returns funds received on the current cycle date with a ReversalTransfer (02)
+	ReturnSameDayReversal ReturnType = "sameDayReversal"
+	// ReturnPriorDayReversal returns funds received on a prior cycle date with a ReversalPriorDayTransfer (08)
+	ReturnPriorDayReversal ReturnType = "priorDayReversal"
+	// ReturnFundsReturned returns funds with a FEDFundsReturned (FFR) settlement transfer
+	ReturnFundsReturned ReturnType = "fundsReturned"
+)
+
+// returnNotes are the first line of the FIAdditionalFIToFI note for each ReturnType
+var returnNotes = map[ReturnType]string{
+	ReturnSameDayReversal:  "SAME DAY REVERSAL",
+	ReturnPriorDayReversal: "PRIOR DAY REVERSAL",
+	ReturnFundsReturned:    "FUNDS RETURNED",
+}
+
+// ReturnFunds returns the outgoing message which returns the funds of a received message.
+//
+// Reversals keep the BusinessFunctionCode and TypeCode of the received message and use SubTypeCode
+// ReversalTransfer or ReversalPriorDayTransfer, while ReturnFundsReturned sends a FEDFundsReturned
+// settlement transfer. In each case the full Amount is returned, the depository institutions and parties are
+// swapped and PreviousMessageIdentifier is set to the IMAD of the received message.
+//
+// FIAdditionalFIToFI records the return type, the IMAD and OMAD of the received message and the reason, which
+// is wrapped between words across the remaining lines. The returned message has no
+// InputMessageAccountabilityData, the caller assigns one before sending.
+func (fwm *FEDWireMessage) ReturnFunds(returnType ReturnType, reason string) (*FEDWireMessage, error) {
+	note, ok := returnNotes[returnType]
+	if !ok {
+		return nil, fieldError("ReturnType", ErrInvalidProperty, returnType)
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fieldError("Reason", ErrFieldRequired)
+	}
+	if fwm.TypeSubType == nil {
+		return nil, fieldError("TypeSubType", ErrFieldRequired)
+	}
+	if fwm.BusinessFunctionCode == nil {
+		return nil, fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+	if err := fwm.checkResponseFields(); err != nil {
+		return nil, err
+	}
+
+	var resp *FEDWireMessage
+	switch returnType {
+	case ReturnSameDayReversal, ReturnPriorDayReversal:
+		subTypeCode := ReversalTransfer
+		if returnType == ReturnPriorDayReversal {
+			subTypeCode = ReversalPriorDayTransfer
+		}
+		bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
+		if fwm.TypeSubType.SubTypeCode != BasicFundsTransfer ||
+			!businessFunctionTypeSubTypes(bfc).Contains(fwm.TypeSubType.TypeCode+subTypeCode) {
+			return nil, fieldError("BusinessFunctionCode", ErrNotReturnable, bfc)
+		}
+		resp = fwm.newResponse(bfc, subTypeCode)
+	case ReturnFundsReturned:
+		resp = fwm.newResponse(FEDFundsReturned, BasicFundsTransfer)
+		resp.TypeSubType.TypeCode = SettlementTransfer
+	}
+	if err := fwm.reverseParties(resp); err != nil {
+		return nil, err
+	}
+
+	lines := []string{note, "IMAD " + fwm.InputMessageAccountabilityData.Identifier()}
+	if fwm.OutputMessageAccountabilityData != nil {
+		lines = append(lines, "OMAD "+fwm.OutputMessageAccountabilityData.Identifier())
+	}
+	lines = append(lines, wrapWords(reason, 35)...)
+	if len(lines) > 6 {
+		return nil, fieldError("Reason", ErrValidLength)
+	}
+	for len(lines) < 6 {
+		lines = append(lines, "")
+	}
+	fifi := NewFIAdditionalFIToFI()
+	fifi.AdditionalFIToFI = AdditionalFIToFI{
+		LineOne:   lines[0],
+		LineTwo:   lines[1],
+		LineThree: lines[2],
+		LineFour:  lines[3],
+		LineFive:  lines[4],
+		LineSix:   lines[5],
+	}
+	if err := fifi.Validate(); err != nil {
+		return nil, err
+	}
+	resp.FIAdditionalFIToFI = fifi
+
+	if err := resp.verifyResponse(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// wrapWords splits s into lines of at most width characters, breaking between words. Words longer than width are
+// broken across lines.
+func wrapWords(s string, width int) []string {
+	var lines []string
+	var line []rune
+	for _, word := range strings.Fields(s) {
+		w := []rune(word)
+		if len(line) > 0 && len(line)+1+len(w) > width {
+			lines = append(lines, string(line))
+			line = nil
+		}
+		if len(line) > 0 {
+			line = append(line, ' ')
+		}
+		for len(line)+len(w) > width {
+			n := width - len(line)
+			lines = append(lines, string(append(line, w[:n]...)))
+			line, w = nil, w[n:]
+		}
+		line = append(line, w...)
+	}
+	if len(line) > 0 {
+		lines = append(lines, string(line))
+	}
+	return lines
+}
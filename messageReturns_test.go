@@ -0,0 +1,92 @@
+package wire
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockReceivedTransfer creates a received CustomerTransfer FEDWireMessage
+func mockReceivedTransfer() *FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.OutputMessageAccountabilityData = mockOutputMessageAccountabilityData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	return &fwm
+}
+
+func TestFEDWireMessage_ReturnFundsReversal(t *testing.T) {
+	received := mockReceivedTransfer()
+
+	resp, err := received.ReturnFunds(ReturnSameDayReversal, "Beneficiary account closed")
+	require.NoError(t, err)
+	require.Equal(t, CustomerTransfer, resp.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, ReversalTransfer, resp.TypeSubType.SubTypeCode)
+	require.Equal(t, received.Amount.Amount, resp.Amount.Amount)
+	require.Equal(t, received.InputMessageAccountabilityData.Identifier(), resp.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, received.Originator.Personal, resp.Beneficiary.Personal)
+
+	note := resp.FIAdditionalFIToFI.AdditionalFIToFI
+	require.Equal(t, "SAME DAY REVERSAL", note.LineOne)
+	require.Equal(t, "IMAD "+received.InputMessageAccountabilityData.Identifier(), note.LineTwo)
+	require.Equal(t, "OMAD "+received.OutputMessageAccountabilityData.Identifier(), note.LineThree)
+	require.Equal(t, "Beneficiary account closed", note.LineFour)
+
+	resp, err = received.ReturnFunds(ReturnPriorDayReversal, "Duplicate payment")
+	require.NoError(t, err)
+	require.Equal(t, ReversalPriorDayTransfer, resp.TypeSubType.SubTypeCode)
+}
+
+func TestFEDWireMessage_ReturnFundsFundsReturned(t *testing.T) {
+	received := mockReceivedTransfer()
+	received.OutputMessageAccountabilityData = nil
+
+	reason := strings.Repeat("Misdirected ", 6)
+	resp, err := received.ReturnFunds(ReturnFundsReturned, reason)
+	require.NoError(t, err)
+	require.Equal(t, FEDFundsReturned, resp.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, SettlementTransfer+BasicFundsTransfer, resp.TypeSubType.TypeCode+resp.TypeSubType.SubTypeCode)
+	require.Equal(t, "Misdirected Misdirected Misdirected", resp.FIAdditionalFIToFI.AdditionalFIToFI.LineThree)
+	require.Equal(t, "Misdirected Misdirected Misdirected", resp.FIAdditionalFIToFI.AdditionalFIToFI.LineFour)
+}
+
+func TestFEDWireMessage_ReturnFundsReason(t *testing.T) {
+	received := mockReceivedTransfer()
+
+	// lines break between words
+	reason := "Beneficiary account closed at the request of Jose Muller, funds returned to the originator"
+	resp, err := received.ReturnFunds(ReturnSameDayReversal, reason)
+	require.NoError(t, err)
+	fifi := resp.FIAdditionalFIToFI.AdditionalFIToFI
+	require.Equal(t, "Beneficiary account closed at the", fifi.LineFour)
+	require.Equal(t, "request of Jose Muller, funds", fifi.LineFive)
+	require.Equal(t, "returned to the originator", fifi.LineSix)
+
+	// and count characters rather than bytes
+	require.Equal(t, []string{"abc de", "fghijk", "lm"}, wrapWords(" abc  de fghijklm ", 6))
+	require.Equal(t, []string{"ééé", "éé"}, wrapWords("ééééé", 3))
+	require.Empty(t, wrapWords(" ", 6))
+}
+
+func TestFEDWireMessage_ReturnFundsErrors(t *testing.T) {
+	received := mockReceivedTransfer()
+
+	_, err := received.ReturnFunds("other", "reason")
+	require.True(t, errors.Is(err, ErrInvalidProperty))
+
+	_, err = received.ReturnFunds(ReturnSameDayReversal, " ")
+	require.EqualError(t, err, fieldError("Reason", ErrFieldRequired).Error())
+
+	_, err = received.ReturnFunds(ReturnSameDayReversal, strings.Repeat("x", 35*4))
+	require.EqualError(t, err, fieldError("Reason", ErrValidLength).Error())
+
+	drawdown := mockDrawdownRequest()
+	_, err = drawdown.ReturnFunds(ReturnSameDayReversal, "reason")
+	require.True(t, errors.Is(err, ErrNotReturnable))
+
+	received.InputMessageAccountabilityData = nil
+	_, err = received.ReturnFunds(ReturnFundsReturned, "reason")
+	require.EqualError(t, err, fieldError("InputMessageAccountabilityData", ErrFieldRequired).Error())
+}
@@ -0,0 +1,268 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// MessageStatus is the outcome the Fedwire Funds Service reports for a message in MessageDisposition
+type MessageStatus string
+
+const (
+	// MessageStatusUnknown is a message without a recognized MessageStatusIndicator
+	MessageStatusUnknown MessageStatus = "unknown"
+	// MessageStatusInProcess is a message in process or intercepted, a final status follows
+	MessageStatusInProcess MessageStatus = "inProcess"
+	// MessageStatusAccepted is a message processed successfully with accountability
+	MessageStatusAccepted MessageStatus = "accepted"
+	// MessageStatusAcceptedWithoutAccountability is a message processed successfully without accountability
+	MessageStatusAcceptedWithoutAccountability MessageStatus = "acceptedWithoutAccountability"
+	// MessageStatusRejected is a message rejected due to an error condition described by ErrorWire
+	MessageStatusRejected MessageStatus = "rejected"
+)
+
+// messageStatuses maps MessageStatusIndicator values to a MessageStatus
+var messageStatuses = map[string]MessageStatus{
+	MessageStatusIndicatorInProcess:                       MessageStatusInProcess,
+	MessageStatusIndicatorSuccessful:                      MessageStatusAccepted,
+	MessageStatusIndicatorRejected:                        MessageStatusRejected,
+	MessageStatusIndicatorSuccessfulWithoutAccountability: MessageStatusAcceptedWithoutAccountability,
+}
+
+// IsAccepted returns true if the message was processed successfully
+func (s MessageStatus) IsAccepted() bool {
+	return s == MessageStatusAccepted || s == MessageStatusAcceptedWithoutAccountability
+}
+
+// IsFinal returns true if no further status will be reported for the message
+func (s MessageStatus) IsFinal() bool {
+	return s.IsAccepted() || s == MessageStatusRejected
+}
+
+// Status returns the MessageStatus of the MessageStatusIndicator
+func (md *MessageDisposition) Status() MessageStatus {
+	if status, ok := messageStatuses[md.MessageStatusIndicator]; ok {
+		return status
+	}
+	return MessageStatusUnknown
+}
+
+// Status returns the MessageStatus of an output message. Messages without MessageDisposition are
+// MessageStatusUnknown, unless ErrorWire reports an error.
+func (fwm *FEDWireMessage) Status() MessageStatus {
+	if fwm.MessageDisposition != nil {
+		return fwm.MessageDisposition.Status()
+	}
+	if fwm.ErrorWire != nil {
+		if fwm.ErrorWire.ErrorCategory == ErrorCategoryInProcess {
+			return MessageStatusInProcess
+		}
+		if fwm.ErrorWire.ErrorCategory != "" {
+			return MessageStatusRejected
+		}
+	}
+	return MessageStatusUnknown
+}
+
+// ErrorCategoryInfo describes an ErrorWire ErrorCategory
+type ErrorCategoryInfo struct {
+	Category    string
+	Name        string
+	Description string
+	// Resendable is true when the message may be sent again once the condition is resolved, with a new IMAD
+	Resendable bool
+}
+
+// errorCategories are the ErrorWire ErrorCategory values of the Fedwire Funds Service
+var errorCategories = map[string]ErrorCategoryInfo{
+	ErrorCategoryDataError: {
+		Category:    ErrorCategoryDataError,
+		Name:        "Data Error",
+		Description: "a tag is missing, invalid or not permitted for the business function code",
+		Resendable:  true,
+	},
+	ErrorCategoryInsufficientBalance: {
+		Category:    ErrorCategoryInsufficientBalance,
+		Name:        "Insufficient Balance",
+		Description: "the sender's account balance or credit limit does not cover the amount",
+		Resendable:  true,
+	},
+	ErrorCategoryAccountabilityError: {
+		Category:    ErrorCategoryAccountabilityError,
+		Name:        "Accountability Error",
+		Description: "the input message accountability data could not be accepted",
+		Resendable:  true,
+	},
+	ErrorCategoryInProcess: {
+		Category:    ErrorCategoryInProcess,
+		Name:        "In Process or Intercepted",
+		Description: "the message is held for processing and a final status follows",
+	},
+	ErrorCategoryCutoffHourError: {
+		Category:    ErrorCategoryCutoffHourError,
+		Name:        "Cutoff Hour Error",
+		Description: "the message was received after the cutoff for its business function code or outside operating hours",
+		Resendable:  true,
+	},
+	ErrorCategoryDuplicateIMAD: {
+		Category:    ErrorCategoryDuplicateIMAD,
+		Name:        "Duplicate IMAD",
+		Description: "the IMAD was already used by another message on the cycle date",
+		Resendable:  true,
+	},
+}
+
+// LookupErrorCategory returns the description of an ErrorWire ErrorCategory
+func LookupErrorCategory(category string) (ErrorCategoryInfo, bool) {
+	info, ok := errorCategories[category]
+	return info, ok
+}
+
+var (
+	errorCodesMu sync.RWMutex
+	// errorCodes are descriptions of ErrorWire ErrorCode values keyed by ErrorCategory and ErrorCode. It starts
+	// with the description of each ErrorCategory under its prefix alone, which describes the codes of the category
+	// without their own description.
+	errorCodes = func() map[string]string {
+		codes := make(map[string]string)
+		for category, info := range errorCategories {
+			codes[category] = info.Description
+		}
+		return codes
+	}()
+)
+
+// RegisterErrorCode records the description of an ErrorWire ErrorCode within an ErrorCategory. An empty code
+// replaces the description of the codes of the category without their own.
+//
+// The Fedwire Funds Service publishes its error codes to participants only, so this package describes each code
+// by its ErrorCategory. Callers register the codes they handle for ErrorWire.Describe to explain them when a
+// message carries no ErrorDescription.
+func RegisterErrorCode(category, code, description string) {
+	errorCodesMu.Lock()
+	defer errorCodesMu.Unlock()
+	errorCodes[category+code] = description
+}
+
+// LookupErrorCode returns the description of an ErrorWire ErrorCode within an ErrorCategory: the one registered
+// with RegisterErrorCode, or else the description of the ErrorCategory
+func LookupErrorCode(category, code string) (string, bool) {
+	errorCodesMu.RLock()
+	defer errorCodesMu.RUnlock()
+	if description, ok := errorCodes[category+code]; ok {
+		return description, true
+	}
+	description, ok := errorCodes[category]
+	return description, ok
+}
+
+// Category returns the description of the ErrorCategory
+func (ew *ErrorWire) Category() (ErrorCategoryInfo, bool) {
+	return LookupErrorCategory(ew.ErrorCategory)
+}
+
+// Describe returns a readable explanation of the error: the ErrorCategory name, the ErrorCode and the
+// ErrorDescription, or the description LookupErrorCode returns when there is no ErrorDescription.
+func (ew *ErrorWire) Describe() string {
+	name := ew.ErrorCategory
+	if info, ok := ew.Category(); ok {
+		name = info.Name
+	}
+	description := ew.ErrorDescription
+	if description == "" && ew.ErrorCode != "" {
+		description, _ = LookupErrorCode(ew.ErrorCategory, ew.ErrorCode)
+	}
+	switch {
+	case ew.ErrorCode == "" && description == "":
+		return name
+	case description == "":
+		return fmt.Sprintf("%s %s", name, ew.ErrorCode)
+	}
+	return fmt.Sprintf("%s %s: %s", name, ew.ErrorCode, description)
+}
+
+// Time returns the receipt time in the location of cycleDate. ReceiptDate has no year, which is taken as the one
+// placing the receipt nearest to cycleDate.
+func (rts *ReceiptTimeStamp) Time(cycleDate time.Time) (time.Time, error) {
+	t, err := monthDayTime(rts.ReceiptDate, rts.ReceiptTime, cycleDate)
+	if err != nil {
+		return time.Time{}, fieldError("ReceiptTimeStamp", err, rts.ReceiptDate+rts.ReceiptTime)
+	}
+	return t, nil
+}
+
+// Time returns the output time in loc, taking the year of OutputDate from OutputCycleDate
+func (omad *OutputMessageAccountabilityData) Time(loc *time.Location) (time.Time, error) {
+	cycleDate, err := time.ParseInLocation(cycleDateFormat, omad.OutputCycleDate, loc)
+	if err != nil {
+		return time.Time{}, fieldError("OutputCycleDate", ErrValidDate, omad.OutputCycleDate)
+	}
+	t, err := monthDayTime(omad.OutputDate, omad.OutputTime, cycleDate)
+	if err != nil {
+		return time.Time{}, fieldError("OutputMessageAccountabilityData", err, omad.OutputDate+omad.OutputTime)
+	}
+	return t, nil
+}
+
+// CycleDate returns the InputCycleDate in loc
+func (imad *InputMessageAccountabilityData) CycleDate(loc *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation(cycleDateFormat, imad.InputCycleDate, loc)
+	if err != nil {
+		return time.Time{}, fieldError("InputCycleDate", ErrValidDate, imad.InputCycleDate)
+	}
+	return t, nil
+}
+
+// monthDayTime returns the MMDD date and HHMM time in the location of ref, in the year nearest to ref
+func monthDayTime(mmdd, hhmm string, ref time.Time) (time.Time, error) {
+	t, err := time.Parse("0102 1504", mmdd+" "+hhmm)
+	if err != nil {
+		return time.Time{}, ErrValidDate
+	}
+	nearest := time.Time{}
+	for _, year := range []int{ref.Year() - 1, ref.Year(), ref.Year() + 1} {
+		candidate := time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, ref.Location())
+		if candidate.Month() != t.Month() {
+			// February 29th outside a leap year
+			continue
+		}
+		if nearest.IsZero() || absDuration(candidate.Sub(ref)) < absDuration(nearest.Sub(ref)) {
+			nearest = candidate
+		}
+	}
+	return nearest, nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// Acknowledges returns true if fwm is an output message for the submitted message, which is when they share an IMAD
+func (fwm *FEDWireMessage) Acknowledges(submitted *FEDWireMessage) bool {
+	if fwm.InputMessageAccountabilityData == nil || submitted.InputMessageAccountabilityData == nil {
+		return false
+	}
+	return fwm.InputMessageAccountabilityData.Identifier() == submitted.InputMessageAccountabilityData.Identifier()
+}
+
+// MatchAcknowledgment returns the message in submitted which the output message ack acknowledges, matched by
+// IMAD. ErrNoMatchingMessage is returned when no submitted message has the IMAD of ack.
+func MatchAcknowledgment(ack *FEDWireMessage, submitted []*FEDWireMessage) (*FEDWireMessage, error) {
+	if ack.InputMessageAccountabilityData == nil {
+		return nil, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	for _, fwm := range submitted {
+		if fwm != nil && ack.Acknowledges(fwm) {
+			return fwm, nil
+		}
+	}
+	return nil, ErrNoMatchingMessage
+}
@@ -0,0 +1,166 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageDisposition_Status(t *testing.T) {
+	md := NewMessageDisposition()
+	require.Equal(t, MessageStatusUnknown, md.Status())
+
+	md.MessageStatusIndicator = MessageStatusIndicatorInProcess
+	require.Equal(t, MessageStatusInProcess, md.Status())
+	require.False(t, md.Status().IsFinal())
+
+	md.MessageStatusIndicator = MessageStatusIndicatorSuccessful
+	require.Equal(t, MessageStatusAccepted, md.Status())
+	require.True(t, md.Status().IsAccepted())
+
+	md.MessageStatusIndicator = MessageStatusIndicatorSuccessfulWithoutAccountability
+	require.True(t, md.Status().IsAccepted())
+
+	md.MessageStatusIndicator = MessageStatusIndicatorRejected
+	require.Equal(t, MessageStatusRejected, md.Status())
+	require.True(t, md.Status().IsFinal())
+	require.False(t, md.Status().IsAccepted())
+}
+
+func TestFEDWireMessage_Status(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	require.Equal(t, MessageStatusUnknown, fwm.Status())
+
+	fwm.ErrorWire = mockErrorWire()
+	require.Equal(t, MessageStatusRejected, fwm.Status())
+
+	fwm.ErrorWire.ErrorCategory = ErrorCategoryInProcess
+	require.Equal(t, MessageStatusInProcess, fwm.Status())
+
+	fwm.MessageDisposition = NewMessageDisposition()
+	fwm.MessageDisposition.MessageStatusIndicator = MessageStatusIndicatorSuccessful
+	require.Equal(t, MessageStatusAccepted, fwm.Status())
+}
+
+func TestErrorWire_Describe(t *testing.T) {
+	ew := NewErrorWire()
+	ew.ErrorCategory = ErrorCategoryDuplicateIMAD
+	require.Equal(t, "Duplicate IMAD", ew.Describe())
+
+	info, ok := ew.Category()
+	require.True(t, ok)
+	require.True(t, info.Resendable)
+
+	ew.ErrorCategory = ErrorCategoryDataError
+	ew.ErrorCode = "T99"
+	require.Equal(t, "Data Error T99: a tag is missing, invalid or not permitted for the business function code", ew.Describe())
+
+	RegisterErrorCode(ErrorCategoryDataError, "T99", "Test error code")
+	require.Equal(t, "Data Error T99: Test error code", ew.Describe())
+
+	ew.ErrorDescription = "Invalid Amount"
+	require.Equal(t, "Data Error T99: Invalid Amount", ew.Describe())
+
+	_, ok = LookupErrorCategory("Z")
+	require.False(t, ok)
+	info, ok = LookupErrorCategory(ErrorCategoryInProcess)
+	require.True(t, ok)
+	require.False(t, info.Resendable)
+}
+
+func TestLookupErrorCode(t *testing.T) {
+	// codes without their own description are described by their category
+	description, ok := LookupErrorCode(ErrorCategoryInsufficientBalance, "F01")
+	require.True(t, ok)
+	require.Equal(t, "the sender's account balance or credit limit does not cover the amount", description)
+
+	description, ok = LookupErrorCode(ErrorCategoryDuplicateIMAD, "")
+	require.True(t, ok)
+	require.Equal(t, "the IMAD was already used by another message on the cycle date", description)
+
+	for category := range errorCategories {
+		description, ok := LookupErrorCode(category, "000")
+		require.True(t, ok, category)
+		require.NotEmpty(t, description, category)
+	}
+
+	_, ok = LookupErrorCode("Z", "123")
+	require.False(t, ok)
+
+	RegisterErrorCode(ErrorCategoryCutoffHourError, "W42", "Test cutoff error code")
+	description, ok = LookupErrorCode(ErrorCategoryCutoffHourError, "W42")
+	require.True(t, ok)
+	require.Equal(t, "Test cutoff error code", description)
+
+	// codes are registered within their category
+	description, _ = LookupErrorCode(ErrorCategoryDataError, "W42")
+	require.Equal(t, errorCategories[ErrorCategoryDataError].Description, description)
+}
+
+func TestReceiptTimeStamp_Time(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	rts := NewReceiptTimeStamp()
+	rts.ReceiptDate = "0602"
+	rts.ReceiptTime = "1345"
+	received, err := rts.Time(time.Date(2025, time.June, 2, 0, 0, 0, 0, loc))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, time.June, 2, 13, 45, 0, 0, loc), received)
+
+	// received the evening before the first cycle of the year
+	rts.ReceiptDate = "1231"
+	rts.ReceiptTime = "2130"
+	received, err = rts.Time(time.Date(2026, time.January, 2, 0, 0, 0, 0, loc))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, time.December, 31, 21, 30, 0, 0, loc), received)
+
+	rts.ReceiptTime = "2561"
+	_, err = rts.Time(time.Now())
+	require.True(t, errors.Is(err, ErrValidDate))
+}
+
+func TestOutputMessageAccountabilityData_Time(t *testing.T) {
+	omad := mockOutputMessageAccountabilityData()
+	omad.OutputCycleDate = "20250602"
+	omad.OutputDate = "0602"
+	omad.OutputTime = "1000"
+
+	output, err := omad.Time(time.UTC)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC), output)
+
+	omad.OutputCycleDate = "2025"
+	_, err = omad.Time(time.UTC)
+	require.True(t, errors.Is(err, ErrValidDate))
+}
+
+func TestMatchAcknowledgment(t *testing.T) {
+	first, second := mockCustomerTransferData(), mockCustomerTransferData()
+	second.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	second.InputMessageAccountabilityData.InputSequenceNumber = "000002"
+
+	ack := mockCustomerTransferData()
+	ack.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	ack.InputMessageAccountabilityData.InputSequenceNumber = "000002"
+	ack.MessageDisposition = NewMessageDisposition()
+	ack.MessageDisposition.MessageStatusIndicator = MessageStatusIndicatorSuccessful
+
+	fwm, err := MatchAcknowledgment(&ack, []*FEDWireMessage{&first, &second})
+	require.NoError(t, err)
+	require.Equal(t, &second, fwm)
+	require.False(t, ack.Acknowledges(&first))
+
+	_, err = MatchAcknowledgment(&ack, []*FEDWireMessage{&first})
+	require.Equal(t, ErrNoMatchingMessage, err)
+
+	ack.InputMessageAccountabilityData = nil
+	_, err = MatchAcknowledgment(&ack, []*FEDWireMessage{&first})
+	require.Error(t, err)
+}
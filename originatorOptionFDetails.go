@@ -0,0 +1,265 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// OptionFPartyIdentifier is the decoded form of OriginatorOptionF PartyIdentifier
+//
+// PartyIdentifier is either an account number (e.g., /123456) or a unique identifier made of a
+// 4 character code, an optional 2 character ISO country code and the identifier itself
+// (e.g., TXID/US/123-45-6789 or SOSE/123-45-6789).
+type OptionFPartyIdentifier struct {
+	// Account is the account number when PartyIdentifier is in the /Account Number format
+	Account string `json:"account,omitempty"`
+	// Code is the unique identifier code (ARNU, CCPT, CUST, DRLC, EMPL, NIDN, SOSE, TXID)
+	Code string `json:"code,omitempty"`
+	// Country is the ISO country code of the issuer of the unique identifier
+	Country string `json:"country,omitempty"`
+	// Identifier is the unique identifier value
+	Identifier string `json:"identifier,omitempty"`
+}
+
+// IsAccount returns true when the PartyIdentifier is an account number
+func (pi OptionFPartyIdentifier) IsAccount() bool {
+	return pi.Code == ""
+}
+
+// String returns the PartyIdentifier as it is written in OriginatorOptionF
+func (pi OptionFPartyIdentifier) String() string {
+	if pi.IsAccount() {
+		return "/" + pi.Account
+	}
+	if pi.Country != "" {
+		return pi.Code + "/" + pi.Country + "/" + pi.Identifier
+	}
+	return pi.Code + "/" + pi.Identifier
+}
+
+// ParseOptionFPartyIdentifier decodes an OriginatorOptionF PartyIdentifier
+func ParseOptionFPartyIdentifier(s string) (OptionFPartyIdentifier, error) {
+	v := &validator{}
+	if err := v.validatePartyIdentifier(s); err != nil {
+		return OptionFPartyIdentifier{}, err
+	}
+	if s[:1] == "/" {
+		return OptionFPartyIdentifier{Account: strings.TrimSpace(s[1:])}, nil
+	}
+	pi := OptionFPartyIdentifier{Code: s[:4]}
+	pi.Country, pi.Identifier = splitOptionFCountry(strings.TrimSpace(s[5:]))
+	return pi, nil
+}
+
+// OptionFLocation is a country and town pair used by Line Code 3 (Country and Town)
+// and Line Code 5 (Place of Birth), e.g., 3/US/NEW YORK, NY 10000
+type OptionFLocation struct {
+	// Country is the ISO country code
+	Country string `json:"country,omitempty"`
+	// Town is the town or city, which may include a postal code
+	Town string `json:"town,omitempty"`
+}
+
+// IsZero returns true when neither Country nor Town is set
+func (ct OptionFLocation) IsZero() bool {
+	return ct.Country == "" && ct.Town == ""
+}
+
+// String returns the country and town as they are written after a line code
+func (ct OptionFLocation) String() string {
+	if ct.Country == "" {
+		return ct.Town
+	}
+	return ct.Country + "/" + ct.Town
+}
+
+// OriginatorOptionFDetails is a typed view of OriginatorOptionF
+//
+// Use OriginatorOptionF.Details to decode the PartyIdentifier, Name and numbered lines of a tag
+// and OriginatorOptionFDetails.Build to produce OriginatorOptionF content from these fields.
+type OriginatorOptionFDetails struct {
+	// PartyIdentifier is the decoded PartyIdentifier
+	PartyIdentifier OptionFPartyIdentifier `json:"partyIdentifier"`
+	// Name is the originator name (Line Code 1). Additional Line Code 1 lines are appended.
+	Name []string `json:"name,omitempty"`
+	// Address holds the address lines (Line Code 2)
+	Address []string `json:"address,omitempty"`
+	// CountryTown is the country and town (Line Code 3)
+	CountryTown OptionFLocation `json:"countryTown,omitempty"`
+	// DateOfBirth is the date of birth in YYYYMMDD format (Line Code 4)
+	DateOfBirth string `json:"dateOfBirth,omitempty"`
+	// PlaceOfBirth is the country and town of birth (Line Code 5)
+	PlaceOfBirth OptionFLocation `json:"placeOfBirth,omitempty"`
+	// CustomerIdentificationNumber is the customer identification number (Line Code 6)
+	CustomerIdentificationNumber string `json:"customerIdentificationNumber,omitempty"`
+	// NationalIdentityNumber is the national identity number (Line Code 7)
+	NationalIdentityNumber string `json:"nationalIdentityNumber,omitempty"`
+	// AdditionalInformation holds additional information lines (Line Code 8)
+	AdditionalInformation []string `json:"additionalInformation,omitempty"`
+}
+
+// Details decodes OriginatorOptionF PartyIdentifier, Name, LineOne, LineTwo and LineThree
+// into an OriginatorOptionFDetails
+func (oof *OriginatorOptionF) Details() (*OriginatorOptionFDetails, error) {
+	pi, err := ParseOptionFPartyIdentifier(oof.PartyIdentifier)
+	if err != nil {
+		return nil, fieldError("PartyIdentifier", err, oof.PartyIdentifier)
+	}
+	if err := oof.validateOptionFName(oof.Name); err != nil {
+		return nil, fieldError("Name", err, oof.Name)
+	}
+	details := &OriginatorOptionFDetails{
+		PartyIdentifier: pi,
+		Name:            []string{strings.TrimSpace(oof.Name[2:])},
+	}
+
+	lines := []struct {
+		name  string
+		value string
+	}{
+		{"LineOne", oof.LineOne},
+		{"LineTwo", oof.LineTwo},
+		{"LineThree", oof.LineThree},
+	}
+	for _, line := range lines {
+		if line.value == "" {
+			continue
+		}
+		if err := oof.validateOptionFLine(line.value); err != nil {
+			return nil, fieldError(line.name, err, line.value)
+		}
+		if err := details.addLine(line.value[:1], strings.TrimSpace(line.value[2:])); err != nil {
+			return nil, fieldError(line.name, err, line.value)
+		}
+	}
+	return details, nil
+}
+
+// addLine decodes the value of a numbered line into the matching field
+func (d *OriginatorOptionFDetails) addLine(code, value string) error {
+	switch code {
+	case OptionFName:
+		d.Name = append(d.Name, value)
+	case OptionFAddress:
+		d.Address = append(d.Address, value)
+	case OptionFCountryTown:
+		d.CountryTown = parseOptionFCountryTown(value)
+	case OptionFDOB:
+		if _, err := time.Parse("20060102", value); err != nil {
+			return ErrValidDate
+		}
+		d.DateOfBirth = value
+	case OptionFBirthPlace:
+		d.PlaceOfBirth = parseOptionFCountryTown(value)
+	case OptionFCustomerIdentificationNumber:
+		d.CustomerIdentificationNumber = value
+	case OptionFNationalIdentityNumber:
+		d.NationalIdentityNumber = value
+	case OptionFAdditionalInformation:
+		d.AdditionalInformation = append(d.AdditionalInformation, value)
+	default:
+		return ErrOptionFLine
+	}
+	return nil
+}
+
+// Build returns an OriginatorOptionF populated from the details
+//
+// The first Name becomes the Name field and the remaining values are written to LineOne, LineTwo
+// and LineThree in line code order. An error is returned when the details need more than three
+// lines or the resulting OriginatorOptionF does not validate.
+func (d *OriginatorOptionFDetails) Build() (*OriginatorOptionF, error) {
+	if len(d.Name) == 0 || strings.TrimSpace(d.Name[0]) == "" {
+		return nil, fieldError("Name", ErrOptionFName)
+	}
+	if d.DateOfBirth != "" {
+		if _, err := time.Parse("20060102", d.DateOfBirth); err != nil {
+			return nil, fieldError("DateOfBirth", ErrValidDate, d.DateOfBirth)
+		}
+	}
+
+	var lines []string
+	for _, name := range d.Name[1:] {
+		lines = append(lines, OptionFName+"/"+name)
+	}
+	for _, address := range d.Address {
+		lines = append(lines, OptionFAddress+"/"+address)
+	}
+	if !d.CountryTown.IsZero() {
+		lines = append(lines, OptionFCountryTown+"/"+d.CountryTown.String())
+	}
+	if d.DateOfBirth != "" {
+		lines = append(lines, OptionFDOB+"/"+d.DateOfBirth)
+	}
+	if !d.PlaceOfBirth.IsZero() {
+		lines = append(lines, OptionFBirthPlace+"/"+d.PlaceOfBirth.String())
+	}
+	if d.CustomerIdentificationNumber != "" {
+		lines = append(lines, OptionFCustomerIdentificationNumber+"/"+d.CustomerIdentificationNumber)
+	}
+	if d.NationalIdentityNumber != "" {
+		lines = append(lines, OptionFNationalIdentityNumber+"/"+d.NationalIdentityNumber)
+	}
+	for _, info := range d.AdditionalInformation {
+		lines = append(lines, OptionFAdditionalInformation+"/"+info)
+	}
+	if len(lines) > 3 {
+		return nil, fieldError("Lines", ErrOptionFTooManyLines, len(lines))
+	}
+
+	oof := NewOriginatorOptionF()
+	oof.PartyIdentifier = d.PartyIdentifier.String()
+	oof.Name = OptionFName + "/" + d.Name[0]
+	targets := []*string{&oof.LineOne, &oof.LineTwo, &oof.LineThree}
+	for i, line := range lines {
+		*targets[i] = line
+	}
+
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"PartyIdentifier", oof.PartyIdentifier},
+		{"Name", oof.Name},
+		{"LineOne", oof.LineOne},
+		{"LineTwo", oof.LineTwo},
+		{"LineThree", oof.LineThree},
+	}
+	for _, field := range fields {
+		if utf8.RuneCountInString(field.value) > 35 {
+			return nil, fieldError(field.name, ErrValidLength, field.value)
+		}
+	}
+	if err := oof.Validate(); err != nil {
+		return nil, err
+	}
+	return oof, nil
+}
+
+// parseOptionFCountryTown splits a Line Code 3 or 5 value into country and town
+func parseOptionFCountryTown(s string) OptionFLocation {
+	country, town := splitOptionFCountry(s)
+	return OptionFLocation{Country: country, Town: town}
+}
+
+// splitOptionFCountry splits a leading 2 character ISO country code followed by a slash from s
+func splitOptionFCountry(s string) (string, string) {
+	if len(s) > 3 && s[2] == '/' && isUpperAlpha(s[:2]) {
+		return s[:2], s[3:]
+	}
+	return "", s
+}
+
+func isUpperAlpha(s string) bool {
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
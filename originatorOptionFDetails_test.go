@@ -0,0 +1,103 @@
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOptionFPartyIdentifier(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected OptionFPartyIdentifier
+	}{
+		{"/123456", OptionFPartyIdentifier{Account: "123456"}},
+		{"TXID/123-45-6789", OptionFPartyIdentifier{Code: "TXID", Identifier: "123-45-6789"}},
+		{"CCPT/GB/P1234567", OptionFPartyIdentifier{Code: "CCPT", Country: "GB", Identifier: "P1234567"}},
+	}
+	for _, test := range tests {
+		pi, err := ParseOptionFPartyIdentifier(test.input)
+		require.NoError(t, err, test.input)
+		require.Equal(t, test.expected, pi)
+		require.Equal(t, test.input, pi.String())
+	}
+
+	_, err := ParseOptionFPartyIdentifier("ZZZZ/123")
+	require.ErrorIs(t, err, ErrPartyIdentifier)
+}
+
+func TestOriginatorOptionF_Details(t *testing.T) {
+	oof := NewOriginatorOptionF()
+	oof.PartyIdentifier = "TXID/US/123-45-6789"
+	oof.Name = "1/SMITH JOHN"
+	oof.LineOne = "2/1000 COLONIAL FARM RD"
+	oof.LineTwo = "3/US/POTTSTOWN, PA 19464"
+	oof.LineThree = "4/19800131"
+
+	details, err := oof.Details()
+	require.NoError(t, err)
+	require.Equal(t, OptionFPartyIdentifier{Code: "TXID", Country: "US", Identifier: "123-45-6789"}, details.PartyIdentifier)
+	require.Equal(t, []string{"SMITH JOHN"}, details.Name)
+	require.Equal(t, []string{"1000 COLONIAL FARM RD"}, details.Address)
+	require.Equal(t, OptionFLocation{Country: "US", Town: "POTTSTOWN, PA 19464"}, details.CountryTown)
+	require.Equal(t, "19800131", details.DateOfBirth)
+
+	oof.LineThree = "4/19801331"
+	_, err = oof.Details()
+	require.EqualError(t, err, fieldError("LineThree", ErrValidDate, oof.LineThree).Error())
+
+	oof.Name = "2/SMITH JOHN"
+	_, err = oof.Details()
+	require.EqualError(t, err, fieldError("Name", ErrOptionFName, oof.Name).Error())
+}
+
+func TestOriginatorOptionFDetails_Build(t *testing.T) {
+	details := &OriginatorOptionFDetails{
+		PartyIdentifier:        OptionFPartyIdentifier{Account: "123456"},
+		Name:                   []string{"SMITH JOHN"},
+		PlaceOfBirth:           OptionFLocation{Country: "US", Town: "NEW YORK"},
+		NationalIdentityNumber: "111-22-3456",
+		AdditionalInformation:  []string{"DUAL CITIZEN"},
+	}
+
+	oof, err := details.Build()
+	require.NoError(t, err)
+	require.Equal(t, "/123456", oof.PartyIdentifier)
+	require.Equal(t, "1/SMITH JOHN", oof.Name)
+	require.Equal(t, "5/US/NEW YORK", oof.LineOne)
+	require.Equal(t, "7/111-22-3456", oof.LineTwo)
+	require.Equal(t, "8/DUAL CITIZEN", oof.LineThree)
+
+	// round trip
+	parsed, err := oof.Details()
+	require.NoError(t, err)
+	require.Equal(t, details, parsed)
+}
+
+func TestOriginatorOptionFDetails_BuildErrors(t *testing.T) {
+	details := &OriginatorOptionFDetails{
+		PartyIdentifier: OptionFPartyIdentifier{Account: "123456"},
+	}
+	_, err := details.Build()
+	require.True(t, errors.Is(err, ErrOptionFName))
+
+	details.Name = []string{"SMITH JOHN"}
+	details.Address = []string{"1 MAIN ST", "APT 2", "FLOOR 3", "BUILDING 4"}
+	_, err = details.Build()
+	require.True(t, errors.Is(err, ErrOptionFTooManyLines))
+
+	details.Address = []string{"THIS ADDRESS LINE IS FAR TOO LONG TO FIT"}
+	_, err = details.Build()
+	require.True(t, errors.Is(err, ErrValidLength))
+
+	details.Address = nil
+	details.DateOfBirth = "1980-01-31"
+	_, err = details.Build()
+	require.True(t, errors.Is(err, ErrValidDate))
+
+	details.DateOfBirth = ""
+	details.PartyIdentifier = OptionFPartyIdentifier{Code: "ZZZZ", Identifier: "123"}
+	_, err = details.Build()
+	require.True(t, errors.Is(err, ErrPartyIdentifier))
+}
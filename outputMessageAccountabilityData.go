@@ -116,6 +116,12 @@ func (omad *OutputMessageAccountabilityData) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Identifier returns the 22 character OMAD (OutputCycleDate, OutputDestinationID and OutputSequenceNumber)
+// which identifies the message as it was received
+func (omad *OutputMessageAccountabilityData) Identifier() string {
+	return omad.OutputCycleDateField() + omad.OutputDestinationIDField() + omad.OutputSequenceNumberField()
+}
+
 // String returns a fixed-width OutputMessageAccountabilityData record
 func (omad *OutputMessageAccountabilityData) String() string {
 	return omad.Format(FormatOptions{
@@ -109,3 +109,11 @@ func TestStringOutputMessageAccountabilityDataOptions(t *testing.T) {
 	require.Equal(t, "{1120}                000001            ", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+// TestOutputMessageAccountabilityDataIdentifier validates the OMAD identifier
+func TestOutputMessageAccountabilityDataIdentifier(t *testing.T) {
+	omad := mockOutputMessageAccountabilityData()
+
+	require.Len(t, omad.Identifier(), 22)
+	require.Equal(t, omad.OutputCycleDateField()+omad.OutputDestinationIDField()+omad.OutputSequenceNumberField(), omad.Identifier())
+}
@@ -6,6 +6,7 @@ package wire
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"regexp"
@@ -13,6 +14,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/moov-io/base"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Reader reads records from a ACH-encoded file.
@@ -77,14 +79,26 @@ func NewReader(r io.Reader, opts ...FilePropertyFunc) *Reader {
 // on the first character of each line. It also enforces FED Wire formatting rules and returns
 // the appropriate error if issues are found.
 func (r *Reader) Read() (File, error) {
-	return r.read(nil)
+	return r.read(context.Background(), nil)
 }
 
 func (r *Reader) ReadWithOpts(opts *ValidateOpts) (File, error) {
-	return r.read(opts)
+	return r.read(context.Background(), opts)
 }
 
-func (r *Reader) read(opts *ValidateOpts) (File, error) {
+// ReadContext reads the file as ReadWithOpts does, with ctx as the parent of its trace spans. opts may be nil.
+func (r *Reader) ReadContext(ctx context.Context, opts *ValidateOpts) (File, error) {
+	return r.read(ctx, opts)
+}
+
+func (r *Reader) read(ctx context.Context, opts *ValidateOpts) (file File, err error) {
+	ctx, span := startSpan(ctx, "wire.Reader.Read")
+	defer func() {
+		span.SetAttributes(attribute.Int("wire.tags", r.lineNum))
+		span.SetAttributes(messageAttributes(&file.FEDWireMessage)...)
+		endSpan(span, err)
+	}()
+
 	spiltString := func(line string) []string {
 
 		// strip new lines
@@ -122,7 +136,7 @@ func (r *Reader) read(opts *ValidateOpts) (File, error) {
 		if opts != nil {
 			r.File.SetValidation(opts)
 		}
-		err := r.File.Validate()
+		err := r.File.ValidateContext(ctx)
 		if err == nil {
 			return r.File, nil
 		}
@@ -0,0 +1,423 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNoRemittanceData is returned when a message has none of the structured remittance tags {8250} to {8750}
+	ErrNoRemittanceData = errors.New("message has no structured remittance tags")
+)
+
+// RemittanceParty is the originator or beneficiary of a RemittanceAdvice
+type RemittanceParty struct {
+	Name string
+	// Identification is the identification code and number, such as "DUNS 123456789"
+	Identification string
+	Address        []string
+	Contact        []string
+}
+
+// RemittanceDocument is the document a payment settles, from PrimaryRemittanceDocument {8400} and the remittance
+// amount and date tags
+type RemittanceDocument struct {
+	// Type is the label of the DocumentTypeCode, or the ProprietaryDocumentTypeCode
+	Type   string
+	Number string
+	Issuer string
+	Date   string
+
+	// Amounts are formatted with their currency code, such as "USD 1,234.56"
+	GrossAmount string
+	Discount    string
+	Adjustment  string
+	AmountPaid  string
+
+	// AdjustmentReason is the label of the AdjustmentReasonCode
+	AdjustmentReason string
+	// AdjustmentDirection is "Credit" or "Debit"
+	AdjustmentDirection string
+	AdjustmentInfo      string
+
+	// Related is the SecondaryRemittanceDocument {8700}, such as a purchase order
+	Related *RemittanceDocument
+}
+
+// RemittanceAdvice is the readable remittance data of a FEDWireMessage, which WritePDF lays out as a document
+type RemittanceAdvice struct {
+	// Amount is the amount paid, formatted with its currency code
+	Amount          string
+	PaymentDate     string
+	IMAD            string
+	SenderReference string
+
+	RemittanceIdentification string
+	// RemittanceLocation is where the remittance data of RelatedRemittance {8250} can be found
+	RemittanceLocation string
+
+	Originator  *RemittanceParty
+	Beneficiary *RemittanceParty
+	Document    *RemittanceDocument
+	FreeText    []string
+}
+
+// NewRemittanceAdvice returns the RemittanceAdvice of fwm, or ErrNoRemittanceData when it has no structured
+// remittance tags
+func NewRemittanceAdvice(fwm *FEDWireMessage) (*RemittanceAdvice, error) {
+	if !hasRemittanceData(fwm) {
+		return nil, ErrNoRemittanceData
+	}
+
+	ra := &RemittanceAdvice{}
+	if fwm.Amount != nil {
+		ra.Amount = "USD " + formatAmount(fwm.Amount.Amount)
+	}
+	if fwm.ActualAmountPaid != nil {
+		ra.Amount = formatRemittanceAmount(fwm.ActualAmountPaid.RemittanceAmount)
+	}
+	if imad := fwm.InputMessageAccountabilityData; imad != nil {
+		ra.IMAD = imad.Identifier()
+		ra.PaymentDate = formatDocumentDate(imad.InputCycleDate)
+	}
+	if fwm.SenderReference != nil {
+		ra.SenderReference = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if rr := fwm.RelatedRemittance; rr != nil {
+		ra.RemittanceIdentification = strings.TrimSpace(rr.RemittanceIdentification)
+		ra.RemittanceLocation = strings.TrimSpace(strings.TrimSpace(rr.RemittanceLocationMethod) + " " + strings.TrimSpace(rr.RemittanceLocationElectronicAddress))
+	}
+
+	switch {
+	case fwm.RemittanceOriginator != nil:
+		ro := fwm.RemittanceOriginator
+		ra.Originator = remittanceParty(ro.RemittanceData, ro.IdentificationCode, ro.IdentificationNumber, ro.IdentificationNumberIssuer)
+		ra.Originator.Contact = nonEmpty(ro.ContactName, ro.ContactPhoneNumber, ro.ContactMobileNumber, ro.ContactFaxNumber, ro.ContactElectronicAddress, ro.ContactOther)
+	case fwm.Originator != nil:
+		ra.Originator = remittancePartyOf(personalParty(fwm.Originator.Personal))
+	case fwm.RelatedRemittance != nil:
+		ra.Originator = remittanceParty(fwm.RelatedRemittance.RemittanceData, "", "", "")
+	}
+	switch {
+	case fwm.RemittanceBeneficiary != nil:
+		rb := fwm.RemittanceBeneficiary
+		ra.Beneficiary = remittanceParty(rb.RemittanceData, rb.IdentificationCode, rb.IdentificationNumber, rb.IdentificationNumberIssuer)
+	case fwm.Beneficiary != nil:
+		ra.Beneficiary = remittancePartyOf(personalParty(fwm.Beneficiary.Personal))
+	}
+
+	if hasRemittanceDocument(fwm) {
+		doc := &RemittanceDocument{}
+		if prd := fwm.PrimaryRemittanceDocument; prd != nil {
+			doc.Type = documentType(prd.DocumentTypeCode, prd.ProprietaryDocumentTypeCode)
+			doc.Number = strings.TrimSpace(prd.DocumentIdentificationNumber)
+			doc.Issuer = strings.TrimSpace(prd.Issuer)
+		}
+		if fwm.DateRemittanceDocument != nil {
+			doc.Date = formatDocumentDate(fwm.DateRemittanceDocument.DateRemittanceDocument)
+		}
+		if fwm.GrossAmountRemittanceDocument != nil {
+			doc.GrossAmount = formatRemittanceAmount(fwm.GrossAmountRemittanceDocument.RemittanceAmount)
+		}
+		if fwm.AmountNegotiatedDiscount != nil {
+			doc.Discount = formatRemittanceAmount(fwm.AmountNegotiatedDiscount.RemittanceAmount)
+		}
+		if fwm.ActualAmountPaid != nil {
+			doc.AmountPaid = formatRemittanceAmount(fwm.ActualAmountPaid.RemittanceAmount)
+		}
+		if adj := fwm.Adjustment; adj != nil {
+			doc.Adjustment = formatRemittanceAmount(adj.RemittanceAmount)
+			doc.AdjustmentReason = AdjustmentReasonCodeLabel(adj.AdjustmentReasonCode)
+			switch adj.CreditDebitIndicator {
+			case CreditIndicator:
+				doc.AdjustmentDirection = "Credit"
+			case DebitIndicator:
+				doc.AdjustmentDirection = "Debit"
+			}
+			doc.AdjustmentInfo = strings.TrimSpace(adj.AdditionalInfo)
+		}
+		if srd := fwm.SecondaryRemittanceDocument; srd != nil {
+			doc.Related = &RemittanceDocument{
+				Type:   documentType(srd.DocumentTypeCode, srd.ProprietaryDocumentTypeCode),
+				Number: strings.TrimSpace(srd.DocumentIdentificationNumber),
+				Issuer: strings.TrimSpace(srd.Issuer),
+			}
+		}
+		ra.Document = doc
+	}
+
+	if rft := fwm.RemittanceFreeText; rft != nil {
+		ra.FreeText = nonEmpty(rft.LineOne, rft.LineTwo, rft.LineThree)
+	}
+	return ra, nil
+}
+
+func hasRemittanceData(fwm *FEDWireMessage) bool {
+	return fwm.RelatedRemittance != nil || fwm.RemittanceOriginator != nil || fwm.RemittanceBeneficiary != nil ||
+		hasRemittanceDocument(fwm) || fwm.RemittanceFreeText != nil
+}
+
+func hasRemittanceDocument(fwm *FEDWireMessage) bool {
+	return fwm.PrimaryRemittanceDocument != nil || fwm.ActualAmountPaid != nil || fwm.GrossAmountRemittanceDocument != nil ||
+		fwm.AmountNegotiatedDiscount != nil || fwm.Adjustment != nil || fwm.DateRemittanceDocument != nil ||
+		fwm.SecondaryRemittanceDocument != nil
+}
+
+func remittanceParty(rd RemittanceData, code, number, issuer string) *RemittanceParty {
+	party := &RemittanceParty{
+		Name: strings.TrimSpace(rd.Name),
+		Address: nonEmpty(rd.AddressLineOne, rd.AddressLineTwo, rd.AddressLineThree, rd.AddressLineFour,
+			rd.AddressLineFive, rd.AddressLineSix, rd.AddressLineSeven),
+	}
+	if len(party.Address) == 0 {
+		// structured address
+		party.Address = nonEmpty(
+			rd.Department,
+			rd.SubDepartment,
+			strings.TrimSpace(rd.BuildingNumber)+" "+strings.TrimSpace(rd.StreetName),
+			strings.Join(nonEmpty(rd.TownName, rd.CountrySubDivisionState, rd.PostCode), " "),
+			rd.Country,
+		)
+	}
+	if id := strings.Join(nonEmpty(code, number), " "); id != "" {
+		party.Identification = id
+		if issuer = strings.TrimSpace(issuer); issuer != "" {
+			party.Identification += " (" + issuer + ")"
+		}
+	}
+	return party
+}
+
+func remittancePartyOf(p *ConfirmationParty) *RemittanceParty {
+	return &RemittanceParty{Name: p.Name, Address: p.Address}
+}
+
+func documentType(code, proprietary string) string {
+	if code == ProprietaryDocumentType && strings.TrimSpace(proprietary) != "" {
+		return strings.TrimSpace(proprietary)
+	}
+	return DocumentTypeCodeLabel(code)
+}
+
+// formatRemittanceAmount formats a RemittanceAmount, such as 1234.56 in USD, as "USD 1,234.56"
+func formatRemittanceAmount(ra RemittanceAmount) string {
+	amount := strings.TrimSpace(ra.Amount)
+	whole, fraction, hasFraction := strings.Cut(amount, ".")
+	whole = strings.TrimLeft(whole, "0")
+	if whole == "" {
+		whole = "0"
+	}
+	var buf strings.Builder
+	if code := strings.TrimSpace(ra.CurrencyCode); code != "" {
+		buf.WriteString(code + " ")
+	}
+	for i, r := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteRune(r)
+	}
+	if hasFraction {
+		for len(fraction) < 2 {
+			fraction += "0"
+		}
+		buf.WriteString("." + fraction)
+	}
+	return buf.String()
+}
+
+// formatDocumentDate formats a CCYYMMDD date as "Apr 10, 2019", or returns it unchanged when it is not a date
+func formatDocumentDate(date string) string {
+	t, err := time.Parse(cycleDateFormat, strings.TrimSpace(date))
+	if err != nil {
+		return strings.TrimSpace(date)
+	}
+	return t.Format("Jan 2, 2006")
+}
+
+// remittanceAdvicePDF lays out a RemittanceAdvice top to bottom, starting new pages as needed
+type remittanceAdvicePDF struct {
+	doc *pdfDocument
+	y   float64
+}
+
+const (
+	pdfMargin     = 54
+	pdfLineHeight = 13
+	pdfFontSize   = 10
+	pdfLabelWidth = 130
+)
+
+// WritePDF writes the remittance advice to w as a PDF document. Only the standard PDF fonts are used, so characters
+// outside of Latin-1 are shown as a question mark.
+func (ra *RemittanceAdvice) WritePDF(w io.Writer) error {
+	p := &remittanceAdvicePDF{doc: &pdfDocument{title: "Remittance Advice"}}
+	p.newPage()
+
+	p.doc.text(pdfMargin, p.y, pdfBold, 18, "Remittance Advice")
+	p.y -= 30
+
+	p.row("Amount Paid", ra.Amount)
+	p.row("Payment Date", ra.PaymentDate)
+	p.row("IMAD", ra.IMAD)
+	p.row("Sender Reference", ra.SenderReference)
+	p.row("Remittance Identification", ra.RemittanceIdentification)
+	p.row("Remittance Location", ra.RemittanceLocation)
+
+	if ra.Originator != nil || ra.Beneficiary != nil {
+		p.space()
+		p.parties(ra.Originator, ra.Beneficiary)
+	}
+	if ra.Document != nil {
+		p.space()
+		p.document(ra.Document)
+	}
+	if len(ra.FreeText) > 0 {
+		p.space()
+		p.heading("Notes")
+		for _, line := range ra.FreeText {
+			p.line(pdfRegular, line)
+		}
+	}
+	return p.doc.writeTo(w)
+}
+
+func (p *remittanceAdvicePDF) newPage() {
+	p.doc.addPage()
+	p.y = pdfPageHeight - pdfMargin - 18
+}
+
+// ensure starts a new page unless height fits on the current page
+func (p *remittanceAdvicePDF) ensure(height float64) {
+	if p.y-height < pdfMargin {
+		p.newPage()
+	}
+}
+
+func (p *remittanceAdvicePDF) space() {
+	p.y -= pdfLineHeight
+}
+
+func (p *remittanceAdvicePDF) heading(s string) {
+	p.ensure(3 * pdfLineHeight)
+	p.doc.text(pdfMargin, p.y, pdfBold, 12, s)
+	p.y -= 4
+	p.doc.line(pdfMargin, p.y, pdfPageWidth-pdfMargin, p.y)
+	p.y -= pdfLineHeight
+}
+
+func (p *remittanceAdvicePDF) line(font, s string) {
+	p.ensure(pdfLineHeight)
+	p.doc.text(pdfMargin, p.y, font, pdfFontSize, pdfTruncate(s, pdfFontSize, pdfPageWidth-2*pdfMargin))
+	p.y -= pdfLineHeight
+}
+
+// row writes a label and value, skipping empty values
+func (p *remittanceAdvicePDF) row(label, value string) {
+	if value == "" {
+		return
+	}
+	p.ensure(pdfLineHeight)
+	p.doc.text(pdfMargin, p.y, pdfBold, pdfFontSize, label)
+	p.doc.text(pdfMargin+pdfLabelWidth, p.y, pdfRegular, pdfFontSize, pdfTruncate(value, pdfFontSize, pdfPageWidth-2*pdfMargin-pdfLabelWidth))
+	p.y -= pdfLineHeight
+}
+
+// parties writes the originator and beneficiary side by side
+func (p *remittanceAdvicePDF) parties(originator, beneficiary *RemittanceParty) {
+	columns := [2][]string{partyLines(originator), partyLines(beneficiary)}
+	rows := len(columns[0])
+	if len(columns[1]) > rows {
+		rows = len(columns[1])
+	}
+	half := float64(pdfPageWidth-2*pdfMargin) / 2
+
+	p.ensure(float64(rows+3) * pdfLineHeight)
+	p.doc.text(pdfMargin, p.y, pdfBold, 12, "From")
+	p.doc.text(pdfMargin+half, p.y, pdfBold, 12, "To")
+	p.y -= 4
+	p.doc.line(pdfMargin, p.y, pdfPageWidth-pdfMargin, p.y)
+	p.y -= pdfLineHeight
+	for i := 0; i < rows; i++ {
+		p.ensure(pdfLineHeight)
+		for c, lines := range columns {
+			if i < len(lines) {
+				font := pdfRegular
+				if i == 0 {
+					font = pdfBold
+				}
+				p.doc.text(pdfMargin+float64(c)*half, p.y, font, pdfFontSize, pdfTruncate(lines[i], pdfFontSize, half-10))
+			}
+		}
+		p.y -= pdfLineHeight
+	}
+}
+
+func partyLines(party *RemittanceParty) []string {
+	if party == nil {
+		return nil
+	}
+	lines := []string{party.Name}
+	lines = append(lines, party.Address...)
+	if party.Identification != "" {
+		lines = append(lines, party.Identification)
+	}
+	return append(lines, party.Contact...)
+}
+
+// documentColumns are the headings and widths of the document table. Amount columns are right aligned.
+var documentColumns = []struct {
+	heading string
+	width   float64
+	amount  bool
+}{
+	{"Document", 96, false},
+	{"Number", 84, false},
+	{"Date", 64, false},
+	{"Gross Amount", 65, true},
+	{"Discount", 65, true},
+	{"Adjustment", 65, true},
+	{"Amount Paid", 65, true},
+}
+
+// document writes the table of the remittance document, followed by the adjustment and related document
+func (p *remittanceAdvicePDF) document(doc *RemittanceDocument) {
+	const size = 8
+	table := func(font string, values []string) {
+		p.ensure(pdfLineHeight)
+		x := float64(pdfMargin)
+		for i, col := range documentColumns {
+			value := pdfTruncate(values[i], size, col.width-6)
+			if col.amount {
+				p.doc.textRight(x+col.width, p.y, font, size, value)
+			} else {
+				p.doc.text(x, p.y, font, size, value)
+			}
+			x += col.width
+		}
+		p.y -= pdfLineHeight
+	}
+
+	p.heading("Remittance Document")
+	headings := make([]string, len(documentColumns))
+	for i, col := range documentColumns {
+		headings[i] = col.heading
+	}
+	table(pdfBold, headings)
+	table(pdfRegular, []string{doc.Type, doc.Number, doc.Date, doc.GrossAmount, doc.Discount, doc.Adjustment, doc.AmountPaid})
+
+	p.space()
+	p.row("Issuer", doc.Issuer)
+	p.row("Adjustment Reason", strings.Join(nonEmpty(doc.AdjustmentReason, doc.AdjustmentDirection), ", "))
+	p.row("Adjustment Information", doc.AdjustmentInfo)
+	if related := doc.Related; related != nil {
+		p.row("Related Document", strings.Join(nonEmpty(related.Type, related.Number), " "))
+		p.row("Related Document Issuer", related.Issuer)
+	}
+}
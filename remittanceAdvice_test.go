@@ -0,0 +1,142 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mockRemittanceAdviceMessage() *FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.RemittanceOriginator = mockRemittanceOriginator()
+	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
+	fwm.PrimaryRemittanceDocument = mockPrimaryRemittanceDocument()
+	fwm.ActualAmountPaid = mockActualAmountPaid()
+	fwm.GrossAmountRemittanceDocument = mockGrossAmountRemittanceDocument()
+	fwm.AmountNegotiatedDiscount = mockAmountNegotiatedDiscount()
+	fwm.Adjustment = mockAdjustment()
+	fwm.DateRemittanceDocument = mockDateRemittanceDocument()
+	fwm.SecondaryRemittanceDocument = mockSecondaryRemittanceDocument()
+	fwm.RemittanceFreeText = mockRemittanceFreeText()
+	return &fwm
+}
+
+func TestNewRemittanceAdvice(t *testing.T) {
+	fwm := mockRemittanceAdviceMessage()
+	ra, err := NewRemittanceAdvice(fwm)
+	require.NoError(t, err)
+
+	require.Equal(t, "USD 1,234.56", ra.Amount)
+	require.Equal(t, fwm.InputMessageAccountabilityData.Identifier(), ra.IMAD)
+	require.Equal(t, "Name", ra.Originator.Name)
+	require.Equal(t, "CUST 111111 (Bank)", ra.Originator.Identification)
+	require.Len(t, ra.Originator.Address, 7)
+	require.Contains(t, ra.Originator.Contact, "Contact Name")
+
+	doc := ra.Document
+	require.Equal(t, DocumentTypeCodeLabel(fwm.PrimaryRemittanceDocument.DocumentTypeCode), doc.Type)
+	require.Equal(t, fwm.PrimaryRemittanceDocument.DocumentIdentificationNumber, doc.Number)
+	require.Equal(t, "USD 1,234.56", doc.AmountPaid)
+	require.Equal(t, "Pricing Error", doc.AdjustmentReason)
+	require.Equal(t, "Credit", doc.AdjustmentDirection)
+	require.Equal(t, "Adjustment Additional Information", doc.AdjustmentInfo)
+	require.NotNil(t, doc.Related)
+	require.Len(t, ra.FreeText, 3)
+
+	_, err = NewRemittanceAdvice(mockConfirmationMessage())
+	require.Equal(t, ErrNoRemittanceData, err)
+}
+
+func TestNewRemittanceAdvice_structuredAddress(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.RemittanceBeneficiary = mockRemittanceBeneficiary()
+	rd := &fwm.RemittanceBeneficiary.RemittanceData
+	rd.AddressLineOne, rd.AddressLineTwo, rd.AddressLineThree = "", "", ""
+	rd.AddressLineFour, rd.AddressLineFive, rd.AddressLineSix, rd.AddressLineSeven = "", "", "", ""
+	rd.Department, rd.SubDepartment = "", ""
+	rd.BuildingNumber, rd.StreetName = "16", "Street Name"
+	rd.TownName, rd.CountrySubDivisionState, rd.PostCode, rd.Country = "AnyTown", "PA", "19405", "US"
+	fwm.Originator = mockOriginator()
+
+	ra, err := NewRemittanceAdvice(&fwm)
+	require.NoError(t, err)
+	require.Equal(t, []string{"16 Street Name", "AnyTown PA 19405", "US"}, ra.Beneficiary.Address)
+	require.Equal(t, fwm.Originator.Personal.Name, ra.Originator.Name)
+	require.Nil(t, ra.Document)
+}
+
+func TestFormatRemittanceAmount(t *testing.T) {
+	tests := map[string]string{
+		"1234.56":    "USD 1,234.56",
+		"1234.5":     "USD 1,234.50",
+		"0.01":       "USD 0.01",
+		"1000000":    "USD 1,000,000",
+		"1234.56789": "USD 1,234.56789",
+	}
+	for amount, want := range tests {
+		require.Equal(t, want, formatRemittanceAmount(RemittanceAmount{CurrencyCode: "USD", Amount: amount}), amount)
+	}
+	require.Equal(t, "Apr 10, 2019", formatDocumentDate("20190410"))
+	require.Equal(t, "2019-04", formatDocumentDate("2019-04"))
+}
+
+func TestRemittanceAdvice_WritePDF(t *testing.T) {
+	ra, err := NewRemittanceAdvice(mockRemittanceAdviceMessage())
+	require.NoError(t, err)
+	ra.FreeText = append(ra.FreeText, "Paid (in full) \\ thank you", "Zahlung für Straße – 3")
+
+	var buf bytes.Buffer
+	require.NoError(t, ra.WritePDF(&buf))
+	pdf := buf.String()
+
+	require.True(t, strings.HasPrefix(pdf, "%PDF-1.4\n"))
+	require.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+	require.Contains(t, pdf, "(Remittance Advice)")
+	require.Contains(t, pdf, "(USD 1,234.56)")
+	require.Contains(t, pdf, `(Paid \(in full\) \\ thank you)`)
+	require.Contains(t, pdf, `(Zahlung f\374r Stra\337e ? 3)`)
+	requireValidXref(t, pdf)
+}
+
+func TestPDFDocument_pages(t *testing.T) {
+	doc := &pdfDocument{title: "Pages"}
+	doc.text(10, 10, pdfRegular, 10, "one")
+	doc.addPage()
+	doc.text(10, 10, pdfBold, 10, "two")
+
+	var buf bytes.Buffer
+	require.NoError(t, doc.writeTo(&buf))
+	require.Contains(t, buf.String(), "/Kids [6 0 R 8 0 R] /Count 2")
+	requireValidXref(t, buf.String())
+
+	require.Equal(t, "abc...", pdfTruncate("abcdefghijklmnop", 10, pdfTextWidth("abc...", 10)))
+	require.Equal(t, "abc", pdfTruncate("abc", 10, 100))
+}
+
+// requireValidXref checks each cross-reference table entry points at its object
+func requireValidXref(t *testing.T, pdf string) {
+	t.Helper()
+
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(pdf)
+	require.Len(t, m, 2)
+	start, err := strconv.Atoi(m[1])
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(pdf[start:], "xref\n"))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(pdf[start:], -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(entry[1])
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(pdf[offset:], fmt.Sprintf("%d 0 obj\n", i+1)), "object %d", i+1)
+	}
+}
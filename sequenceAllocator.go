@@ -0,0 +1,215 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// maxSequenceNumber is the largest six digit InputSequenceNumber or OutputSequenceNumber
+	maxSequenceNumber = 999999
+)
+
+// SequenceAllocator hands out the sequence numbers of InputMessageAccountabilityData (IMAD) and
+// OutputMessageAccountabilityData (OMAD).
+//
+// Sequence numbers increase monotonically per source and cycle date, starting at 1, and restart when a later cycle
+// date is requested. Implementations must be safe for concurrent use. Use separate allocators for IMAD input sources
+// and OMAD destination IDs.
+type SequenceAllocator interface {
+	// Next returns the next sequence number of source on the CCYYMMDD cycleDate
+	Next(source, cycleDate string) (int, error)
+}
+
+// NextIMAD returns InputMessageAccountabilityData with the next InputSequenceNumber of inputSource on inputCycleDate.
+// inputSource and inputCycleDate are validated first, so invalid values don't use up a sequence number.
+func NextIMAD(a SequenceAllocator, inputSource, inputCycleDate string) (*InputMessageAccountabilityData, error) {
+	if err := checkSequenceSource("InputSource", inputSource); err != nil {
+		return nil, err
+	}
+	if err := checkCycleDate(inputCycleDate); err != nil {
+		return nil, err
+	}
+	seq, err := a.Next(inputSource, inputCycleDate)
+	if err != nil {
+		return nil, err
+	}
+	imad := NewInputMessageAccountabilityData()
+	imad.InputCycleDate = inputCycleDate
+	imad.InputSource = inputSource
+	imad.InputSequenceNumber = fmt.Sprintf("%06d", seq)
+	if err := imad.Validate(); err != nil {
+		return nil, err
+	}
+	return imad, nil
+}
+
+// NextOMAD returns OutputMessageAccountabilityData with the next OutputSequenceNumber of outputDestinationID on
+// outputCycleDate, output at t. outputDestinationID and outputCycleDate are validated first, as for NextIMAD.
+func NextOMAD(a SequenceAllocator, outputDestinationID, outputCycleDate string, t time.Time) (*OutputMessageAccountabilityData, error) {
+	if err := checkSequenceSource("OutputDestinationID", outputDestinationID); err != nil {
+		return nil, err
+	}
+	if err := checkCycleDate(outputCycleDate); err != nil {
+		return nil, err
+	}
+	seq, err := a.Next(outputDestinationID, outputCycleDate)
+	if err != nil {
+		return nil, err
+	}
+	omad := NewOutputMessageAccountabilityData()
+	omad.OutputCycleDate = outputCycleDate
+	omad.OutputDestinationID = outputDestinationID
+	omad.OutputSequenceNumber = fmt.Sprintf("%06d", seq)
+	omad.OutputDate = t.Format("0102")
+	omad.OutputTime = t.Format("1504")
+	if err := omad.Validate(); err != nil {
+		return nil, err
+	}
+	return omad, nil
+}
+
+// checkSequenceSource returns an error if source can't be the eight character alphanumeric field
+func checkSequenceSource(field, source string) error {
+	if source == "" {
+		return fieldError(field, ErrFieldRequired, source)
+	}
+	if len(source) > 8 {
+		return fieldError(field, ErrValidLength, source)
+	}
+	if err := (&validator{}).isAlphanumeric(source); err != nil {
+		return fieldError(field, err, source)
+	}
+	return nil
+}
+
+// sequenceState is the last sequence number allocated to a source
+type sequenceState struct {
+	CycleDate string `json:"cycleDate"`
+	Sequence  int    `json:"sequence"`
+}
+
+// next advances the state for cycleDate and returns the allocated sequence number
+func (s *sequenceState) next(source, cycleDate string) (int, error) {
+	if err := checkCycleDate(cycleDate); err != nil {
+		return 0, err
+	}
+	switch {
+	case cycleDate < s.CycleDate:
+		return 0, fieldError("CycleDate", ErrCycleDateRollback, cycleDate)
+	case cycleDate > s.CycleDate:
+		s.CycleDate = cycleDate
+		s.Sequence = 0
+	}
+	if s.Sequence >= maxSequenceNumber {
+		return 0, fieldError("SequenceNumber", ErrSequenceExhausted, source)
+	}
+	s.Sequence++
+	return s.Sequence, nil
+}
+
+// checkCycleDate returns an error if cycleDate is not a CCYYMMDD date
+func checkCycleDate(cycleDate string) error {
+	if _, err := time.Parse(cycleDateFormat, cycleDate); err != nil || len(cycleDate) != 8 {
+		return fieldError("CycleDate", ErrValidDate, cycleDate)
+	}
+	return nil
+}
+
+// FileSequenceAllocator is a SequenceAllocator which persists the last sequence number of each source to a JSON
+// file, so allocation continues after a restart. The file is rewritten atomically on every allocation.
+//
+// FileSequenceAllocator is safe for concurrent use within one process. Only one process may use a file at a time.
+type FileSequenceAllocator struct {
+	path string
+
+	mu      sync.Mutex
+	sources map[string]*sequenceState
+}
+
+// NewFileSequenceAllocator returns a FileSequenceAllocator persisting to path, loading any existing state
+func NewFileSequenceAllocator(path string) (*FileSequenceAllocator, error) {
+	a := &FileSequenceAllocator{
+		path:    path,
+		sources: make(map[string]*sequenceState),
+	}
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return a, nil
+		}
+		return nil, fmt.Errorf("reading sequence file: %v", err)
+	}
+	if len(bs) > 0 {
+		if err := json.Unmarshal(bs, &a.sources); err != nil {
+			return nil, fmt.Errorf("reading sequence file %s: %v", path, err)
+		}
+	}
+	return a, nil
+}
+
+// Next returns the next sequence number of source on cycleDate. The number is persisted before it is returned.
+func (a *FileSequenceAllocator) Next(source, cycleDate string) (int, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	state, ok := a.sources[source]
+	if !ok {
+		state = &sequenceState{}
+	}
+	prev := *state
+	seq, err := state.next(source, cycleDate)
+	if err != nil {
+		return 0, err
+	}
+	a.sources[source] = state
+	if err := a.save(); err != nil {
+		*state = prev
+		if !ok {
+			delete(a.sources, source)
+		}
+		return 0, err
+	}
+	return seq, nil
+}
+
+// save writes the state to a temporary file which replaces the sequence file
+func (a *FileSequenceAllocator) save() error {
+	bs, err := json.Marshal(a.sources)
+	if err != nil {
+		return err
+	}
+	fd, err := os.CreateTemp(filepath.Dir(a.path), filepath.Base(a.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("writing sequence file: %v", err)
+	}
+	tmp := fd.Name()
+	if _, err := fd.Write(bs); err != nil {
+		fd.Close()
+		os.Remove(tmp)
+		return fmt.Errorf("writing sequence file: %v", err)
+	}
+	if err := fd.Sync(); err != nil {
+		fd.Close()
+		os.Remove(tmp)
+		return fmt.Errorf("writing sequence file: %v", err)
+	}
+	if err := fd.Close(); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("writing sequence file: %v", err)
+	}
+	if err := os.Rename(tmp, a.path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("writing sequence file: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSequenceAllocator(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sequences.json")
+	a, err := NewFileSequenceAllocator(path)
+	require.NoError(t, err)
+
+	for i := 1; i <= 3; i++ {
+		seq, err := a.Next("Source08", "20250602")
+		require.NoError(t, err)
+		require.Equal(t, i, seq)
+	}
+
+	// sources are independent
+	seq, err := a.Next("Source09", "20250602")
+	require.NoError(t, err)
+	require.Equal(t, 1, seq)
+
+	// rollover restarts the sequence
+	seq, err = a.Next("Source08", "20250603")
+	require.NoError(t, err)
+	require.Equal(t, 1, seq)
+
+	_, err = a.Next("Source08", "20250602")
+	require.True(t, errors.Is(err, ErrCycleDateRollback))
+
+	_, err = a.Next("Source08", "2025-06-03")
+	require.True(t, errors.Is(err, ErrValidDate))
+}
+
+func TestFileSequenceAllocator_Restart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sequences.json")
+	a, err := NewFileSequenceAllocator(path)
+	require.NoError(t, err)
+	_, err = a.Next("Source08", "20250602")
+	require.NoError(t, err)
+	_, err = a.Next("Source08", "20250602")
+	require.NoError(t, err)
+
+	a, err = NewFileSequenceAllocator(path)
+	require.NoError(t, err)
+	seq, err := a.Next("Source08", "20250602")
+	require.NoError(t, err)
+	require.Equal(t, 3, seq)
+}
+
+func TestFileSequenceAllocator_Concurrent(t *testing.T) {
+	a, err := NewFileSequenceAllocator(filepath.Join(t.TempDir(), "sequences.json"))
+	require.NoError(t, err)
+
+	const n = 50
+	seqs := make(chan int, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			seq, err := a.Next("Source08", "20250602")
+			if err == nil {
+				seqs <- seq
+			}
+		}()
+	}
+	wg.Wait()
+	close(seqs)
+
+	seen := make(map[int]bool)
+	for seq := range seqs {
+		require.False(t, seen[seq])
+		seen[seq] = true
+	}
+	require.Len(t, seen, n)
+	for i := 1; i <= n; i++ {
+		require.True(t, seen[i])
+	}
+}
+
+func TestFileSequenceAllocator_Exhausted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sequences.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"Source08":{"cycleDate":"20250602","sequence":999999}}`), 0600))
+
+	a, err := NewFileSequenceAllocator(path)
+	require.NoError(t, err)
+	_, err = a.Next("Source08", "20250602")
+	require.True(t, errors.Is(err, ErrSequenceExhausted))
+
+	require.NoError(t, os.WriteFile(path, []byte(`{`), 0600))
+	_, err = NewFileSequenceAllocator(path)
+	require.Error(t, err)
+}
+
+func TestNextIMAD(t *testing.T) {
+	a, err := NewFileSequenceAllocator(filepath.Join(t.TempDir(), "sequences.json"))
+	require.NoError(t, err)
+
+	imad, err := NextIMAD(a, "Source08", "20250602")
+	require.NoError(t, err)
+	require.Equal(t, "20250602Source08000001", imad.Identifier())
+
+	imad, err = NextIMAD(a, "Source08", "20250602")
+	require.NoError(t, err)
+	require.Equal(t, "000002", imad.InputSequenceNumber)
+
+	omads, err := NewFileSequenceAllocator(filepath.Join(t.TempDir(), "omad.json"))
+	require.NoError(t, err)
+	omad, err := NextOMAD(omads, "Dest0001", "20250602", time.Date(2025, time.June, 2, 13, 45, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, "20250602Dest0001000001", omad.Identifier())
+	require.Equal(t, "0602", omad.OutputDate)
+	require.Equal(t, "1345", omad.OutputTime)
+}
+
+func TestNextIMAD_Invalid(t *testing.T) {
+	a, err := NewFileSequenceAllocator(filepath.Join(t.TempDir(), "sequences.json"))
+	require.NoError(t, err)
+	output := time.Date(2025, time.June, 2, 13, 45, 0, 0, time.UTC)
+
+	// invalid sources and cycle dates don't use up sequence numbers
+	for _, tc := range []struct {
+		source, cycleDate string
+		err               error
+	}{
+		{"", "20250602", ErrFieldRequired},
+		{"Source008", "20250602", ErrValidLength},
+		{"Source®", "20250602", ErrNonAlphanumeric},
+		{"Source08", "2025062", ErrValidDate},
+		{"Source08", "20251302", ErrValidDate},
+	} {
+		_, err := NextIMAD(a, tc.source, tc.cycleDate)
+		require.ErrorIs(t, err, tc.err, tc.source+" "+tc.cycleDate)
+		_, err = NextOMAD(a, tc.source, tc.cycleDate, output)
+		require.ErrorIs(t, err, tc.err, tc.source+" "+tc.cycleDate)
+	}
+
+	imad, err := NextIMAD(a, "Source08", "20250602")
+	require.NoError(t, err)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+	omad, err := NextOMAD(a, "Dest0001", "20250602", output)
+	require.NoError(t, err)
+	require.Equal(t, "000001", omad.OutputSequenceNumber)
+}
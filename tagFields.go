@@ -0,0 +1,43 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"reflect"
+)
+
+// TagField is the value of one field of a tag in a FEDWireMessage
+type TagField struct {
+	// Tag is the tag number, such as {3100}
+	Tag string `json:"tag"`
+	// Name is the FEDWireMessage field holding the tag, such as SenderDepositoryInstitution
+	Name string `json:"name"`
+	// Field is the path of the field within the tag, such as SenderABANumber. Field is empty for a tag without any
+	// values.
+	Field string `json:"field,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// Fields returns the non-blank field values of each tag present in fwm, in message order
+func (fwm *FEDWireMessage) Fields() []TagField {
+	v := reflect.ValueOf(fwm).Elem()
+
+	var fields []TagField
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+		tag, ok := fieldTags[name]
+		if !ok || v.Field(i).IsNil() {
+			continue
+		}
+		n := len(fields)
+		collectValues(v.Field(i).Elem(), "", func(field, value string) {
+			fields = append(fields, TagField{Tag: tag, Name: name, Field: field, Value: value})
+		})
+		if len(fields) == n {
+			fields = append(fields, TagField{Tag: tag, Name: name})
+		}
+	}
+	return fields
+}
@@ -0,0 +1,35 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFEDWireMessage_Fields(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.OutputMessageAccountabilityData = NewOutputMessageAccountabilityData()
+
+	fields := fwm.Fields()
+	require.Equal(t, TagField{Tag: TagOutputMessageAccountabilityData, Name: "OutputMessageAccountabilityData"}, fields[0])
+	require.Equal(t, TagField{Tag: TagSenderSupplied, Name: "SenderSupplied", Field: "FormatVersion", Value: FormatVersion}, fields[1])
+
+	var amount, address TagField
+	for _, f := range fields {
+		switch f.Field {
+		case "Amount":
+			amount = f
+		case "Personal.Address.AddressLineOne":
+			address = f
+		}
+	}
+	require.Equal(t, TagAmount, amount.Tag)
+	require.Equal(t, fwm.Amount.Amount, amount.Value)
+	require.Equal(t, TagBeneficiary, address.Tag)
+	require.Equal(t, fwm.Beneficiary.Personal.Address.AddressLineOne, address.Value)
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/base"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the spans of reading, validating and writing files
+const tracerName = "github.com/moov-io/wire"
+
+// startSpan starts a span with the global TracerProvider. Spans are only recorded once an application configures
+// one with otel.SetTracerProvider, otherwise they're discarded.
+func startSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// messageAttributes returns the attributes of a span describing fwm
+func messageAttributes(fwm *FEDWireMessage) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if fwm == nil {
+		return attrs
+	}
+	if fwm.BusinessFunctionCode != nil {
+		attrs = append(attrs, attribute.String("wire.business_function_code", strings.TrimSpace(fwm.BusinessFunctionCode.BusinessFunctionCode)))
+	}
+	if fwm.TypeSubType != nil {
+		attrs = append(attrs,
+			attribute.String("wire.type_code", fwm.TypeSubType.TypeCode),
+			attribute.String("wire.subtype_code", fwm.TypeSubType.SubTypeCode),
+		)
+	}
+	return attrs
+}
+
+// endSpan records err, if any, on span and ends it. Each error of an ErrorList is recorded as an event.
+//
+// Errors are recorded by their type and field, never by their text, as that holds the value which failed, such
+// as an account number or name, and spans are exported outside the application.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		var el base.ErrorList
+		if errors.As(err, &el) && len(el) > 0 {
+			span.SetAttributes(attribute.Int("wire.errors", len(el)))
+			for _, err := range el {
+				recordError(span, err)
+			}
+			err = el[0]
+		} else {
+			span.SetAttributes(attribute.Int("wire.errors", 1))
+			recordError(span, err)
+		}
+		span.SetStatus(codes.Error, errorType(err))
+	}
+	span.End()
+}
+
+// recordError adds err as an event of span with its type, the field of field errors and the tag and line of
+// parse errors
+func recordError(span trace.Span, err error) {
+	attrs := []attribute.KeyValue{attribute.String("error.type", errorType(err))}
+	var pe *base.ParseError
+	if errors.As(err, &pe) {
+		attrs = append(attrs,
+			attribute.String("wire.tag", pe.Record),
+			attribute.Int("wire.line", pe.Line),
+		)
+	}
+	var fe *FieldError
+	if errors.As(err, &fe) {
+		attrs = append(attrs, attribute.String("wire.field", fe.FieldName))
+	}
+	span.AddEvent("exception", trace.WithAttributes(attrs...))
+}
+
+// errorType returns the Go type of err, or of the error a ParseError wraps
+func errorType(err error) string {
+	var pe *base.ParseError
+	if errors.As(err, &pe) && pe.Err != nil {
+		err = pe.Err
+	}
+	return fmt.Sprintf("%T", err)
+}
@@ -0,0 +1,135 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// mockTracing records spans in memory until the test ends and returns a context with a parent span, so spans
+// of other tests are told apart by their trace ID
+func mockTracing(t *testing.T) (context.Context, *tracetest.InMemoryExporter) {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		provider.Shutdown(context.Background())
+	})
+
+	ctx, span := provider.Tracer("test").Start(context.Background(), t.Name())
+	t.Cleanup(func() { span.End() })
+	return ctx, exporter
+}
+
+// findSpan returns the span named name of the trace of ctx
+func findSpan(t *testing.T, ctx context.Context, exporter *tracetest.InMemoryExporter, name string) tracetest.SpanStub {
+	t.Helper()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID()
+	for _, span := range exporter.GetSpans() {
+		if span.Name == name && span.SpanContext.TraceID() == traceID {
+			return span
+		}
+	}
+	t.Fatalf("span %s not found", name)
+	return tracetest.SpanStub{}
+}
+
+// spanAttribute returns the value of the attribute key of span
+func spanAttribute(span tracetest.SpanStub, key attribute.Key) attribute.Value {
+	for _, attr := range span.Attributes {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestTracing(t *testing.T) {
+	ctx, exporter := mockTracing(t)
+
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	file, err := NewReader(bytes.NewReader(bs)).ReadContext(ctx, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf).WriteContext(ctx, &file))
+
+	read := findSpan(t, ctx, exporter, "wire.Reader.Read")
+	require.Equal(t, trace.SpanContextFromContext(ctx).SpanID(), read.Parent.SpanID())
+	require.Equal(t, "CTR", spanAttribute(read, "wire.business_function_code").AsString())
+	tags := int64(strings.Count(strings.TrimSpace(string(bs)), "\n") + 1)
+	require.Equal(t, tags, spanAttribute(read, "wire.tags").AsInt64())
+	require.Equal(t, codes.Unset, read.Status.Code)
+
+	written := findSpan(t, ctx, exporter, "wire.Writer.Write")
+	require.Equal(t, tags, spanAttribute(written, "wire.tags").AsInt64())
+
+	var verified int
+	for _, span := range exporter.GetSpans() {
+		if span.Name != "wire.FEDWireMessage.verify" || span.SpanContext.TraceID() != read.SpanContext.TraceID() {
+			continue
+		}
+		verified++
+		require.Contains(t, []trace.SpanID{read.SpanContext.SpanID(), written.SpanContext.SpanID()}, span.Parent.SpanID())
+		require.Equal(t, "10", spanAttribute(span, "wire.type_code").AsString())
+	}
+	require.Equal(t, 2, verified)
+}
+
+func TestTracing_errors(t *testing.T) {
+	ctx, exporter := mockTracing(t)
+
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	contents := strings.Replace(string(bs), "{2000}000001234567", "{2000}00000123456X", 1)
+	_, err = NewReader(strings.NewReader(contents)).ReadContext(ctx, nil)
+	require.Error(t, err)
+
+	read := findSpan(t, ctx, exporter, "wire.Reader.Read")
+	require.Equal(t, codes.Error, read.Status.Code)
+	require.Equal(t, int64(1), spanAttribute(read, "wire.errors").AsInt64())
+	require.Len(t, read.Events, 1)
+	require.Contains(t, read.Events[0].Attributes, attribute.String("wire.tag", "Amount"))
+	require.Contains(t, read.Events[0].Attributes, attribute.String("wire.field", "Amount"))
+	require.Contains(t, read.Events[0].Attributes, attribute.String("error.type", "*wire.FieldError"))
+
+	// the values which failed aren't exported
+	for _, span := range exporter.GetSpans() {
+		require.NotContains(t, span.Status.Description, "00000123456X")
+		for _, event := range span.Events {
+			for _, attr := range event.Attributes {
+				require.NotContains(t, attr.Value.Emit(), "00000123456X")
+			}
+		}
+	}
+
+	// invalid messages are recorded on the validation span
+	fwm := mockCustomerTransferData()
+	fwm.Amount = nil
+	file := File{FEDWireMessage: fwm}
+	require.Error(t, file.ValidateContext(ctx))
+	verify := findSpan(t, ctx, exporter, "wire.FEDWireMessage.verify")
+	require.Equal(t, codes.Error, verify.Status.Code)
+	require.Equal(t, "CTR", spanAttribute(verify, "wire.business_function_code").AsString())
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package wirepb is the gRPC API of the Wire server, generated from wire.proto, along with conversions between
+// its messages and the types of the wire package.
+//
+//	conn, err := grpc.NewClient("localhost:9088", grpc.WithTransportCredentials(insecure.NewCredentials()))
+//	client := wirepb.NewWireServiceClient(conn)
+//	file, err := client.CreateFile(ctx, &wirepb.CreateFileRequest{
+//		Source: &wirepb.CreateFileRequest_Contents{Contents: contents},
+//	})
+package wirepb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/moov-io/wire"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Messages are converted through their JSON, which the wire package and wire.proto share. Unmarshaling the JSON
+// also sets the tag of each record of the wire package, which its types keep unexported.
+
+// FromFile returns the File message of f
+func FromFile(f *wire.File) (*File, error) {
+	if f == nil {
+		return nil, nil
+	}
+	bs, err := json.Marshal(f)
+	if err != nil {
+		return nil, err
+	}
+	var out File
+	if err := protojson.Unmarshal(bs, &out); err != nil {
+		return nil, fmt.Errorf("problem converting file: %v", err)
+	}
+	return &out, nil
+}
+
+// ToFile returns the wire.File of f
+func ToFile(f *File) (*wire.File, error) {
+	if f == nil {
+		return nil, nil
+	}
+	bs, err := protojson.Marshal(f)
+	if err != nil {
+		return nil, err
+	}
+	out := wire.NewFile()
+	if err := json.Unmarshal(bs, out); err != nil {
+		return nil, fmt.Errorf("problem converting file: %v", err)
+	}
+	return out, nil
+}
+
+// FromFEDWireMessage returns the FEDWireMessage message of fwm
+func FromFEDWireMessage(fwm *wire.FEDWireMessage) (*FEDWireMessage, error) {
+	if fwm == nil {
+		return nil, nil
+	}
+	f, err := FromFile(&wire.File{FEDWireMessage: *fwm})
+	if err != nil {
+		return nil, err
+	}
+	return f.GetFedWireMessage(), nil
+}
+
+// ToFEDWireMessage returns the wire.FEDWireMessage of fwm
+func ToFEDWireMessage(fwm *FEDWireMessage) (*wire.FEDWireMessage, error) {
+	if fwm == nil {
+		return nil, nil
+	}
+	f, err := ToFile(&File{FedWireMessage: fwm})
+	if err != nil {
+		return nil, err
+	}
+	return &f.FEDWireMessage, nil
+}
+
+// FromValidateOpts returns the ValidateOpts message of opts
+func FromValidateOpts(opts *wire.ValidateOpts) *ValidateOpts {
+	if opts == nil {
+		return nil
+	}
+	return &ValidateOpts{
+		SkipMandatoryImad:          opts.SkipMandatoryIMAD,
+		AllowMissingSenderSupplied: opts.AllowMissingSenderSupplied,
+	}
+}
+
+// ToValidateOpts returns the wire.ValidateOpts of opts
+func ToValidateOpts(opts *ValidateOpts) *wire.ValidateOpts {
+	if opts == nil {
+		return nil
+	}
+	return &wire.ValidateOpts{
+		SkipMandatoryIMAD:          opts.GetSkipMandatoryImad(),
+		AllowMissingSenderSupplied: opts.GetAllowMissingSenderSupplied(),
+	}
+}
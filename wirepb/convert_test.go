@@ -0,0 +1,104 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wirepb
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestFile_roundTrip converts each test file to a message and back, which fails once a field of the wire package
+// is missing from wire.proto
+func TestFile_roundTrip(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("..", "test", "testdata", "*.txt"))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			bs, err := os.ReadFile(path)
+			require.NoError(t, err)
+			f, err := wire.NewReader(bytes.NewReader(bs)).Read()
+			if err != nil {
+				t.Skip("invalid test file")
+			}
+			f.ID = "file"
+
+			msg, err := FromFile(&f)
+			require.NoError(t, err)
+			require.Equal(t, "file", msg.GetId())
+
+			// messages survive the wire format
+			encoded, err := proto.Marshal(msg)
+			require.NoError(t, err)
+			var decoded File
+			require.NoError(t, proto.Unmarshal(encoded, &decoded))
+
+			out, err := ToFile(&decoded)
+			require.NoError(t, err)
+			require.NoError(t, out.Validate())
+
+			expected, err := json.Marshal(f)
+			require.NoError(t, err)
+			actual, err := json.Marshal(out)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expected), string(actual))
+
+			var buf bytes.Buffer
+			require.NoError(t, wire.NewWriter(&buf).Write(out))
+			var original bytes.Buffer
+			require.NoError(t, wire.NewWriter(&original).Write(&f))
+			require.Equal(t, original.String(), buf.String())
+		})
+	}
+}
+
+func TestFEDWireMessage(t *testing.T) {
+	f, err := wire.FileFromJSON(mustReadFile(t, "fedWireMessage-CustomerTransfer.json"))
+	require.NoError(t, err)
+
+	msg, err := FromFEDWireMessage(&f.FEDWireMessage)
+	require.NoError(t, err)
+	require.Equal(t, "CTR", msg.GetBusinessFunctionCode().GetBusinessFunctionCode())
+
+	fwm, err := ToFEDWireMessage(msg)
+	require.NoError(t, err)
+	require.Equal(t, f.FEDWireMessage.Amount.Amount, fwm.Amount.Amount)
+	require.NoError(t, (&wire.File{FEDWireMessage: *fwm}).Validate())
+
+	for _, fn := range []func() (interface{}, error){
+		func() (interface{}, error) { return FromFile(nil) },
+		func() (interface{}, error) { return ToFile(nil) },
+		func() (interface{}, error) { return FromFEDWireMessage(nil) },
+		func() (interface{}, error) { return ToFEDWireMessage(nil) },
+	} {
+		v, err := fn()
+		require.NoError(t, err)
+		require.Nil(t, v)
+	}
+}
+
+func TestValidateOpts(t *testing.T) {
+	require.Nil(t, FromValidateOpts(nil))
+	require.Nil(t, ToValidateOpts(nil))
+
+	opts := &wire.ValidateOpts{SkipMandatoryIMAD: true, AllowMissingSenderSupplied: true}
+	require.Equal(t, opts, ToValidateOpts(FromValidateOpts(opts)))
+}
+
+func mustReadFile(t *testing.T, name string) []byte {
+	t.Helper()
+
+	bs, err := os.ReadFile(filepath.Join("..", "test", "testdata", name))
+	require.NoError(t, err)
+	return bs
+}
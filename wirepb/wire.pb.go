@@ -0,0 +1,7465 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// The gRPC API of the Wire server. Messages mirror the JSON of the REST API: each field's JSON name is the one
+// used by the REST API, so files convert between the two without loss.
+//
+// Generate the Go code with `make grpc` after changing this file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: wire.proto
+
+package wirepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Format is the format a file is converted to
+type Format int32
+
+const (
+	Format_FORMAT_UNSPECIFIED Format = 0
+	// FORMAT_JSON returns the file as a File message
+	Format_FORMAT_JSON Format = 1
+	Format_FORMAT_FED  Format = 2
+	// FORMAT_VARIABLE is the FED format with variable length fields
+	Format_FORMAT_VARIABLE Format = 3
+	Format_FORMAT_ISO20022 Format = 4
+)
+
+// Enum value maps for Format.
+var (
+	Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "FORMAT_JSON",
+		2: "FORMAT_FED",
+		3: "FORMAT_VARIABLE",
+		4: "FORMAT_ISO20022",
+	}
+	Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"FORMAT_JSON":        1,
+		"FORMAT_FED":         2,
+		"FORMAT_VARIABLE":    3,
+		"FORMAT_ISO20022":    4,
+	}
+)
+
+func (x Format) Enum() *Format {
+	p := new(Format)
+	*p = x
+	return p
+}
+
+func (x Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_wire_proto_enumTypes[0].Descriptor()
+}
+
+func (Format) Type() protoreflect.EnumType {
+	return &file_wire_proto_enumTypes[0]
+}
+
+func (x Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Format.Descriptor instead.
+func (Format) EnumDescriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{0}
+}
+
+type ListFilesRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BusinessFunctionCode string                 `protobuf:"bytes,1,opt,name=business_function_code,json=businessFunctionCode,proto3" json:"business_function_code,omitempty"`
+	// type_sub_type is the TypeCode and SubTypeCode, such as 1000
+	TypeSubType string `protobuf:"bytes,2,opt,name=type_sub_type,json=typeSubType,proto3" json:"type_sub_type,omitempty"`
+	// min_amount and max_amount are in cents, as in the Amount tag
+	MinAmount   *int64 `protobuf:"varint,3,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"`
+	MaxAmount   *int64 `protobuf:"varint,4,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	SenderAba   string `protobuf:"bytes,5,opt,name=sender_aba,json=senderABA,proto3" json:"sender_aba,omitempty"`
+	ReceiverAba string `protobuf:"bytes,6,opt,name=receiver_aba,json=receiverABA,proto3" json:"receiver_aba,omitempty"`
+	// cycle_date is the IMAD InputCycleDate, as CCYYMMDD
+	CycleDate string `protobuf:"bytes,7,opt,name=cycle_date,json=cycleDate,proto3" json:"cycle_date,omitempty"`
+	// beneficiary_name matches beneficiary names containing it, ignoring case
+	BeneficiaryName string `protobuf:"bytes,8,opt,name=beneficiary_name,json=beneficiaryName,proto3" json:"beneficiary_name,omitempty"`
+	Valid           *bool  `protobuf:"varint,9,opt,name=valid,proto3,oneof" json:"valid,omitempty"`
+	// sort is createdAt, amount or cycleDate, prefixed with - to sort in descending order
+	Sort string `protobuf:"bytes,10,opt,name=sort,proto3" json:"sort,omitempty"`
+	// cursor is the next_cursor of the previous page
+	Cursor string `protobuf:"bytes,11,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  int32  `protobuf:"varint,12,opt,name=limit,proto3" json:"limit,omitempty"`
+	// masked masks the account numbers, identifiers and names of the files, as ?masked=true does
+	Masked        bool `protobuf:"varint,13,opt,name=masked,proto3" json:"masked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFilesRequest) Reset() {
+	*x = ListFilesRequest{}
+	mi := &file_wire_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesRequest) ProtoMessage() {}
+
+func (x *ListFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListFilesRequest) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListFilesRequest) GetBusinessFunctionCode() string {
+	if x != nil {
+		return x.BusinessFunctionCode
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetTypeSubType() string {
+	if x != nil {
+		return x.TypeSubType
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetMinAmount() int64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *ListFilesRequest) GetMaxAmount() int64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *ListFilesRequest) GetSenderAba() string {
+	if x != nil {
+		return x.SenderAba
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetReceiverAba() string {
+	if x != nil {
+		return x.ReceiverAba
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetCycleDate() string {
+	if x != nil {
+		return x.CycleDate
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetBeneficiaryName() string {
+	if x != nil {
+		return x.BeneficiaryName
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetValid() bool {
+	if x != nil && x.Valid != nil {
+		return *x.Valid
+	}
+	return false
+}
+
+func (x *ListFilesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFilesRequest) GetMasked() bool {
+	if x != nil {
+		return x.Masked
+	}
+	return false
+}
+
+type ListFilesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Files []*File                `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	// total is the number of files matching the request across all pages
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// next_cursor is empty on the last page
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFilesResponse) Reset() {
+	*x = ListFilesResponse{}
+	mi := &file_wire_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesResponse) ProtoMessage() {}
+
+func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListFilesResponse) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListFilesResponse) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ListFilesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListFilesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CreateFileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*CreateFileRequest_File
+	//	*CreateFileRequest_Contents
+	Source isCreateFileRequest_Source `protobuf_oneof:"source"`
+	// validate_options override the default validation of contents
+	ValidateOptions *ValidateOpts `protobuf:"bytes,3,opt,name=validate_options,json=validateOptions,proto3" json:"validate_options,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateFileRequest) Reset() {
+	*x = CreateFileRequest{}
+	mi := &file_wire_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFileRequest) ProtoMessage() {}
+
+func (x *CreateFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFileRequest.ProtoReflect.Descriptor instead.
+func (*CreateFileRequest) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateFileRequest) GetSource() isCreateFileRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *CreateFileRequest) GetFile() *File {
+	if x != nil {
+		if x, ok := x.Source.(*CreateFileRequest_File); ok {
+			return x.File
+		}
+	}
+	return nil
+}
+
+func (x *CreateFileRequest) GetContents() string {
+	if x != nil {
+		if x, ok := x.Source.(*CreateFileRequest_Contents); ok {
+			return x.Contents
+		}
+	}
+	return ""
+}
+
+func (x *CreateFileRequest) GetValidateOptions() *ValidateOpts {
+	if x != nil {
+		return x.ValidateOptions
+	}
+	return nil
+}
+
+type isCreateFileRequest_Source interface {
+	isCreateFileRequest_Source()
+}
+
+type CreateFileRequest_File struct {
+	File *File `protobuf:"bytes,1,opt,name=file,proto3,oneof"`
+}
+
+type CreateFileRequest_Contents struct {
+	// contents is a file in the FED format
+	Contents string `protobuf:"bytes,2,opt,name=contents,proto3,oneof"`
+}
+
+func (*CreateFileRequest_File) isCreateFileRequest_Source() {}
+
+func (*CreateFileRequest_Contents) isCreateFileRequest_Source() {}
+
+type GetFileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// masked masks the account numbers, identifiers and names of the file, as ?masked=true does
+	Masked        bool `protobuf:"varint,2,opt,name=masked,proto3" json:"masked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	mi := &file_wire_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetFileRequest) GetMasked() bool {
+	if x != nil {
+		return x.Masked
+	}
+	return false
+}
+
+type DeleteFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFileRequest) Reset() {
+	*x = DeleteFileRequest{}
+	mi := &file_wire_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFileRequest) ProtoMessage() {}
+
+func (x *DeleteFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFileRequest) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFileResponse) Reset() {
+	*x = DeleteFileResponse{}
+	mi := &file_wire_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFileResponse) ProtoMessage() {}
+
+func (x *DeleteFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFileResponse) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{5}
+}
+
+type GetFileContentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// variable_length_fields writes the variable length format
+	VariableLengthFields bool `protobuf:"varint,2,opt,name=variable_length_fields,json=variableLengthFields,proto3" json:"variable_length_fields,omitempty"`
+	// no_newline writes the tags without newlines between them
+	NoNewline     bool `protobuf:"varint,3,opt,name=no_newline,json=noNewline,proto3" json:"no_newline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileContentsRequest) Reset() {
+	*x = GetFileContentsRequest{}
+	mi := &file_wire_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileContentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileContentsRequest) ProtoMessage() {}
+
+func (x *GetFileContentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileContentsRequest.ProtoReflect.Descriptor instead.
+func (*GetFileContentsRequest) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetFileContentsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetFileContentsRequest) GetVariableLengthFields() bool {
+	if x != nil {
+		return x.VariableLengthFields
+	}
+	return false
+}
+
+func (x *GetFileContentsRequest) GetNoNewline() bool {
+	if x != nil {
+		return x.NoNewline
+	}
+	return false
+}
+
+type GetFileContentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contents      string                 `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileContentsResponse) Reset() {
+	*x = GetFileContentsResponse{}
+	mi := &file_wire_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileContentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileContentsResponse) ProtoMessage() {}
+
+func (x *GetFileContentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileContentsResponse.ProtoReflect.Descriptor instead.
+func (*GetFileContentsResponse) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetFileContentsResponse) GetContents() string {
+	if x != nil {
+		return x.Contents
+	}
+	return ""
+}
+
+type ValidateFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateFileRequest) Reset() {
+	*x = ValidateFileRequest{}
+	mi := &file_wire_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateFileRequest) ProtoMessage() {}
+
+func (x *ValidateFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateFileRequest.ProtoReflect.Descriptor instead.
+func (*ValidateFileRequest) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ValidateFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ValidateFileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// error is the first validation error of the file, empty when it is valid
+	Error         string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateFileResponse) Reset() {
+	*x = ValidateFileResponse{}
+	mi := &file_wire_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateFileResponse) ProtoMessage() {}
+
+func (x *ValidateFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateFileResponse.ProtoReflect.Descriptor instead.
+func (*ValidateFileResponse) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidateFileResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AddFEDWireMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FedWireMessage *FEDWireMessage        `protobuf:"bytes,2,opt,name=fed_wire_message,json=fedWireMessage,proto3" json:"fed_wire_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddFEDWireMessageRequest) Reset() {
+	*x = AddFEDWireMessageRequest{}
+	mi := &file_wire_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFEDWireMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFEDWireMessageRequest) ProtoMessage() {}
+
+func (x *AddFEDWireMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFEDWireMessageRequest.ProtoReflect.Descriptor instead.
+func (*AddFEDWireMessageRequest) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddFEDWireMessageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddFEDWireMessageRequest) GetFedWireMessage() *FEDWireMessage {
+	if x != nil {
+		return x.FedWireMessage
+	}
+	return nil
+}
+
+type ValidateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*ValidateRequest_File
+	//	*ValidateRequest_Contents
+	Source          isValidateRequest_Source `protobuf_oneof:"source"`
+	ValidateOptions *ValidateOpts            `protobuf:"bytes,3,opt,name=validate_options,json=validateOptions,proto3" json:"validate_options,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_wire_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValidateRequest) GetSource() isValidateRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ValidateRequest) GetFile() *File {
+	if x != nil {
+		if x, ok := x.Source.(*ValidateRequest_File); ok {
+			return x.File
+		}
+	}
+	return nil
+}
+
+func (x *ValidateRequest) GetContents() string {
+	if x != nil {
+		if x, ok := x.Source.(*ValidateRequest_Contents); ok {
+			return x.Contents
+		}
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetValidateOptions() *ValidateOpts {
+	if x != nil {
+		return x.ValidateOptions
+	}
+	return nil
+}
+
+type isValidateRequest_Source interface {
+	isValidateRequest_Source()
+}
+
+type ValidateRequest_File struct {
+	File *File `protobuf:"bytes,1,opt,name=file,proto3,oneof"`
+}
+
+type ValidateRequest_Contents struct {
+	// contents is a file in the FED format
+	Contents string `protobuf:"bytes,2,opt,name=contents,proto3,oneof"`
+}
+
+func (*ValidateRequest_File) isValidateRequest_Source() {}
+
+func (*ValidateRequest_Contents) isValidateRequest_Source() {}
+
+type ValidateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Errors        []*ValidationError     `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_wire_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ValidateResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetErrors() []*ValidationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// ValidationError is an error of a file, with the field and value it was found on when they are known
+type ValidationError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationError) Reset() {
+	*x = ValidationError{}
+	mi := &file_wire_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationError) ProtoMessage() {}
+
+func (x *ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationError.ProtoReflect.Descriptor instead.
+func (*ValidationError) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ValidationError) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ValidationError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ValidationError) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ValidationError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ConvertRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*ConvertRequest_File
+	//	*ConvertRequest_Contents
+	Source          isConvertRequest_Source `protobuf_oneof:"source"`
+	ValidateOptions *ValidateOpts           `protobuf:"bytes,3,opt,name=validate_options,json=validateOptions,proto3" json:"validate_options,omitempty"`
+	// to defaults to FORMAT_JSON
+	To Format `protobuf:"varint,4,opt,name=to,proto3,enum=moov.wire.v1.Format" json:"to,omitempty"`
+	// no_newline writes the FED formats without newlines between tags
+	NoNewline     bool `protobuf:"varint,5,opt,name=no_newline,json=noNewline,proto3" json:"no_newline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertRequest) Reset() {
+	*x = ConvertRequest{}
+	mi := &file_wire_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertRequest) ProtoMessage() {}
+
+func (x *ConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertRequest.ProtoReflect.Descriptor instead.
+func (*ConvertRequest) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ConvertRequest) GetSource() isConvertRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ConvertRequest) GetFile() *File {
+	if x != nil {
+		if x, ok := x.Source.(*ConvertRequest_File); ok {
+			return x.File
+		}
+	}
+	return nil
+}
+
+func (x *ConvertRequest) GetContents() string {
+	if x != nil {
+		if x, ok := x.Source.(*ConvertRequest_Contents); ok {
+			return x.Contents
+		}
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetValidateOptions() *ValidateOpts {
+	if x != nil {
+		return x.ValidateOptions
+	}
+	return nil
+}
+
+func (x *ConvertRequest) GetTo() Format {
+	if x != nil {
+		return x.To
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ConvertRequest) GetNoNewline() bool {
+	if x != nil {
+		return x.NoNewline
+	}
+	return false
+}
+
+type isConvertRequest_Source interface {
+	isConvertRequest_Source()
+}
+
+type ConvertRequest_File struct {
+	File *File `protobuf:"bytes,1,opt,name=file,proto3,oneof"`
+}
+
+type ConvertRequest_Contents struct {
+	// contents is a file in the FED format
+	Contents string `protobuf:"bytes,2,opt,name=contents,proto3,oneof"`
+}
+
+func (*ConvertRequest_File) isConvertRequest_Source() {}
+
+func (*ConvertRequest_Contents) isConvertRequest_Source() {}
+
+type ConvertResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*ConvertResponse_File
+	//	*ConvertResponse_Contents
+	Result        isConvertResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertResponse) Reset() {
+	*x = ConvertResponse{}
+	mi := &file_wire_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertResponse) ProtoMessage() {}
+
+func (x *ConvertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertResponse.ProtoReflect.Descriptor instead.
+func (*ConvertResponse) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ConvertResponse) GetResult() isConvertResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ConvertResponse) GetFile() *File {
+	if x != nil {
+		if x, ok := x.Result.(*ConvertResponse_File); ok {
+			return x.File
+		}
+	}
+	return nil
+}
+
+func (x *ConvertResponse) GetContents() string {
+	if x != nil {
+		if x, ok := x.Result.(*ConvertResponse_Contents); ok {
+			return x.Contents
+		}
+	}
+	return ""
+}
+
+type isConvertResponse_Result interface {
+	isConvertResponse_Result()
+}
+
+type ConvertResponse_File struct {
+	// file is set when converting to FORMAT_JSON
+	File *File `protobuf:"bytes,1,opt,name=file,proto3,oneof"`
+}
+
+type ConvertResponse_Contents struct {
+	// contents is set when converting to the other formats
+	Contents string `protobuf:"bytes,2,opt,name=contents,proto3,oneof"`
+}
+
+func (*ConvertResponse_File) isConvertResponse_Result() {}
+
+func (*ConvertResponse_Contents) isConvertResponse_Result() {}
+
+type GetFileHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileHistoryRequest) Reset() {
+	*x = GetFileHistoryRequest{}
+	mi := &file_wire_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileHistoryRequest) ProtoMessage() {}
+
+func (x *GetFileHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetFileHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetFileHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetFileHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileHistoryResponse) Reset() {
+	*x = GetFileHistoryResponse{}
+	mi := &file_wire_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileHistoryResponse) ProtoMessage() {}
+
+func (x *GetFileHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetFileHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetFileHistoryResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// AuditEntry records a change to a stored file
+type AuditEntry struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileId   string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	TenantId string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Action   string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// actor is the authenticated caller, anonymous when authentication is disabled and system outside requests
+	Actor      string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	AuthMethod string `protobuf:"bytes,6,opt,name=auth_method,json=authMethod,proto3" json:"auth_method,omitempty"`
+	RequestId  string `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// timestamp is in RFC 3339 format
+	Timestamp     string         `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Changes       []*AuditChange `protobuf:"bytes,9,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_wire_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAuthMethod() string {
+	if x != nil {
+		return x.AuthMethod
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetChanges() []*AuditChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// AuditChange is the JSON of a FEDWireMessage tag before and after a change. before is empty for added tags and
+// after for removed tags.
+type AuditChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditChange) Reset() {
+	*x = AuditChange{}
+	mi := &file_wire_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditChange) ProtoMessage() {}
+
+func (x *AuditChange) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditChange.ProtoReflect.Descriptor instead.
+func (*AuditChange) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AuditChange) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *AuditChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type GetFileApprovalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileApprovalRequest) Reset() {
+	*x = GetFileApprovalRequest{}
+	mi := &file_wire_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileApprovalRequest) ProtoMessage() {}
+
+func (x *GetFileApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileApprovalRequest.ProtoReflect.Descriptor instead.
+func (*GetFileApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetFileApprovalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DecideFileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// reason is recorded along with the decision
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideFileRequest) Reset() {
+	*x = DecideFileRequest{}
+	mi := &file_wire_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideFileRequest) ProtoMessage() {}
+
+func (x *DecideFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideFileRequest.ProtoReflect.Descriptor instead.
+func (*DecideFileRequest) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DecideFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DecideFileRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// FileApproval is the approval state of a file which requires a second caller's approval
+type FileApproval struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	FileId   string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	TenantId string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// status is pending, approved or rejected
+	Status               string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	BusinessFunctionCode string `protobuf:"bytes,4,opt,name=business_function_code,json=businessFunctionCode,proto3" json:"business_function_code,omitempty"`
+	// amount and threshold are in cents, as in the Amount tag
+	Amount    int64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Threshold int64 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// digest is the hex SHA-256 of the JSON of the submitted message
+	Digest      string `protobuf:"bytes,7,opt,name=digest,proto3" json:"digest,omitempty"`
+	SubmittedBy string `protobuf:"bytes,8,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	// submitted_at and decided_at are in RFC 3339 format
+	SubmittedAt   string `protobuf:"bytes,9,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	DecidedBy     string `protobuf:"bytes,10,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt     string `protobuf:"bytes,11,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	Reason        string `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileApproval) Reset() {
+	*x = FileApproval{}
+	mi := &file_wire_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileApproval) ProtoMessage() {}
+
+func (x *FileApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileApproval.ProtoReflect.Descriptor instead.
+func (*FileApproval) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FileApproval) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *FileApproval) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *FileApproval) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FileApproval) GetBusinessFunctionCode() string {
+	if x != nil {
+		return x.BusinessFunctionCode
+	}
+	return ""
+}
+
+func (x *FileApproval) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FileApproval) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *FileApproval) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *FileApproval) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+func (x *FileApproval) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *FileApproval) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *FileApproval) GetDecidedAt() string {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return ""
+}
+
+func (x *FileApproval) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// File contains the structures of a parsed WIRE File.
+type File struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FedWireMessage *FEDWireMessage        `protobuf:"bytes,2,opt,name=fed_wire_message,json=fedWireMessage,proto3" json:"fed_wire_message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	mi := &file_wire_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *File) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *File) GetFedWireMessage() *FEDWireMessage {
+	if x != nil {
+		return x.FedWireMessage
+	}
+	return nil
+}
+
+// FEDWireMessage is a FedWire Message
+type FEDWireMessage struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MessageDisposition *MessageDisposition    `protobuf:"bytes,2,opt,name=message_disposition,json=messageDisposition,proto3" json:"message_disposition,omitempty"`
+	ReceiptTimeStamp   *ReceiptTimeStamp      `protobuf:"bytes,3,opt,name=receipt_time_stamp,json=receiptTimeStamp,proto3" json:"receipt_time_stamp,omitempty"`
+	// OutputMessageAccountabilityData (OMAD)
+	OutputMessageAccountabilityData *OutputMessageAccountabilityData `protobuf:"bytes,4,opt,name=output_message_accountability_data,json=outputMessageAccountabilityData,proto3" json:"output_message_accountability_data,omitempty"`
+	ErrorWire                       *ErrorWire                       `protobuf:"bytes,5,opt,name=error_wire,json=errorWire,proto3" json:"error_wire,omitempty"`
+	SenderSupplied                  *SenderSupplied                  `protobuf:"bytes,6,opt,name=sender_supplied,json=senderSupplied,proto3" json:"sender_supplied,omitempty"`
+	TypeSubType                     *TypeSubType                     `protobuf:"bytes,7,opt,name=type_sub_type,json=typeSubType,proto3" json:"type_sub_type,omitempty"`
+	// InputMessageAccountabilityData (IMAD)
+	InputMessageAccountabilityData *InputMessageAccountabilityData `protobuf:"bytes,8,opt,name=input_message_accountability_data,json=inputMessageAccountabilityData,proto3" json:"input_message_accountability_data,omitempty"`
+	// Amount (up to a penny less than $10 billion)
+	Amount                        *Amount                        `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	SenderDepositoryInstitution   *SenderDepositoryInstitution   `protobuf:"bytes,10,opt,name=sender_depository_institution,json=senderDepositoryInstitution,proto3" json:"sender_depository_institution,omitempty"`
+	ReceiverDepositoryInstitution *ReceiverDepositoryInstitution `protobuf:"bytes,11,opt,name=receiver_depository_institution,json=receiverDepositoryInstitution,proto3" json:"receiver_depository_institution,omitempty"`
+	BusinessFunctionCode          *BusinessFunctionCode          `protobuf:"bytes,12,opt,name=business_function_code,json=businessFunctionCode,proto3" json:"business_function_code,omitempty"`
+	SenderReference               *SenderReference               `protobuf:"bytes,13,opt,name=sender_reference,json=senderReference,proto3" json:"sender_reference,omitempty"`
+	PreviousMessageIdentifier     *PreviousMessageIdentifier     `protobuf:"bytes,14,opt,name=previous_message_identifier,json=previousMessageIdentifier,proto3" json:"previous_message_identifier,omitempty"`
+	LocalInstrument               *LocalInstrument               `protobuf:"bytes,15,opt,name=local_instrument,json=localInstrument,proto3" json:"local_instrument,omitempty"`
+	PaymentNotification           *PaymentNotification           `protobuf:"bytes,16,opt,name=payment_notification,json=paymentNotification,proto3" json:"payment_notification,omitempty"`
+	Charges                       *Charges                       `protobuf:"bytes,17,opt,name=charges,proto3" json:"charges,omitempty"`
+	InstructedAmount              *InstructedAmount              `protobuf:"bytes,18,opt,name=instructed_amount,json=instructedAmount,proto3" json:"instructed_amount,omitempty"`
+	ExchangeRate                  *ExchangeRate                  `protobuf:"bytes,19,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	BeneficiaryIntermediaryFi     *BeneficiaryIntermediaryFI     `protobuf:"bytes,20,opt,name=beneficiary_intermediary_fi,json=beneficiaryIntermediaryFI,proto3" json:"beneficiary_intermediary_fi,omitempty"`
+	BeneficiaryFi                 *BeneficiaryFI                 `protobuf:"bytes,21,opt,name=beneficiary_fi,json=beneficiaryFI,proto3" json:"beneficiary_fi,omitempty"`
+	Beneficiary                   *Beneficiary                   `protobuf:"bytes,22,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	BeneficiaryReference          *BeneficiaryReference          `protobuf:"bytes,23,opt,name=beneficiary_reference,json=beneficiaryReference,proto3" json:"beneficiary_reference,omitempty"`
+	AccountDebitedDrawdown        *AccountDebitedDrawdown        `protobuf:"bytes,24,opt,name=account_debited_drawdown,json=accountDebitedDrawdown,proto3" json:"account_debited_drawdown,omitempty"`
+	Originator                    *Originator                    `protobuf:"bytes,25,opt,name=originator,proto3" json:"originator,omitempty"`
+	OriginatorOptionF             *OriginatorOptionF             `protobuf:"bytes,26,opt,name=originator_option_f,json=originatorOptionF,proto3" json:"originator_option_f,omitempty"`
+	OriginatorFi                  *OriginatorFI                  `protobuf:"bytes,27,opt,name=originator_fi,json=originatorFI,proto3" json:"originator_fi,omitempty"`
+	InstructingFi                 *InstructingFI                 `protobuf:"bytes,28,opt,name=instructing_fi,json=instructingFI,proto3" json:"instructing_fi,omitempty"`
+	AccountCreditedDrawdown       *AccountCreditedDrawdown       `protobuf:"bytes,29,opt,name=account_credited_drawdown,json=accountCreditedDrawdown,proto3" json:"account_credited_drawdown,omitempty"`
+	OriginatorToBeneficiary       *OriginatorToBeneficiary       `protobuf:"bytes,30,opt,name=originator_to_beneficiary,json=originatorToBeneficiary,proto3" json:"originator_to_beneficiary,omitempty"`
+	FiReceiverFi                  *FIReceiverFI                  `protobuf:"bytes,31,opt,name=fi_receiver_fi,json=fiReceiverFI,proto3" json:"fi_receiver_fi,omitempty"`
+	FiDrawdownDebitAccountAdvice  *FIDrawdownDebitAccountAdvice  `protobuf:"bytes,32,opt,name=fi_drawdown_debit_account_advice,json=fiDrawdownDebitAccountAdvice,proto3" json:"fi_drawdown_debit_account_advice,omitempty"`
+	FiIntermediaryFi              *FIIntermediaryFI              `protobuf:"bytes,33,opt,name=fi_intermediary_fi,json=fiIntermediaryFI,proto3" json:"fi_intermediary_fi,omitempty"`
+	FiIntermediaryFiAdvice        *FIIntermediaryFIAdvice        `protobuf:"bytes,34,opt,name=fi_intermediary_fi_advice,json=fiIntermediaryFIAdvice,proto3" json:"fi_intermediary_fi_advice,omitempty"`
+	FiBeneficiaryFi               *FIBeneficiaryFI               `protobuf:"bytes,35,opt,name=fi_beneficiary_fi,json=fiBeneficiaryFI,proto3" json:"fi_beneficiary_fi,omitempty"`
+	FiBeneficiaryFiAdvice         *FIBeneficiaryFIAdvice         `protobuf:"bytes,36,opt,name=fi_beneficiary_fi_advice,json=fiBeneficiaryFIAdvice,proto3" json:"fi_beneficiary_fi_advice,omitempty"`
+	FiBeneficiary                 *FIBeneficiary                 `protobuf:"bytes,37,opt,name=fi_beneficiary,json=fiBeneficiary,proto3" json:"fi_beneficiary,omitempty"`
+	FiBeneficiaryAdvice           *FIBeneficiaryAdvice           `protobuf:"bytes,38,opt,name=fi_beneficiary_advice,json=fiBeneficiaryAdvice,proto3" json:"fi_beneficiary_advice,omitempty"`
+	FiPaymentMethodToBeneficiary  *FIPaymentMethodToBeneficiary  `protobuf:"bytes,39,opt,name=fi_payment_method_to_beneficiary,json=fiPaymentMethodToBeneficiary,proto3" json:"fi_payment_method_to_beneficiary,omitempty"`
+	FiAdditionalFiToFi            *FIAdditionalFIToFI            `protobuf:"bytes,40,opt,name=fi_additional_fi_to_fi,json=fiAdditionalFiToFi,proto3" json:"fi_additional_fi_to_fi,omitempty"`
+	CurrencyInstructedAmount      *CurrencyInstructedAmount      `protobuf:"bytes,41,opt,name=currency_instructed_amount,json=currencyInstructedAmount,proto3" json:"currency_instructed_amount,omitempty"`
+	OrderingCustomer              *OrderingCustomer              `protobuf:"bytes,42,opt,name=ordering_customer,json=orderingCustomer,proto3" json:"ordering_customer,omitempty"`
+	OrderingInstitution           *OrderingInstitution           `protobuf:"bytes,43,opt,name=ordering_institution,json=orderingInstitution,proto3" json:"ordering_institution,omitempty"`
+	IntermediaryInstitution       *IntermediaryInstitution       `protobuf:"bytes,44,opt,name=intermediary_institution,json=intermediaryInstitution,proto3" json:"intermediary_institution,omitempty"`
+	InstitutionAccount            *InstitutionAccount            `protobuf:"bytes,45,opt,name=institution_account,json=institutionAccount,proto3" json:"institution_account,omitempty"`
+	BeneficiaryCustomer           *BeneficiaryCustomer           `protobuf:"bytes,46,opt,name=beneficiary_customer,json=beneficiaryCustomer,proto3" json:"beneficiary_customer,omitempty"`
+	Remittance                    *Remittance                    `protobuf:"bytes,47,opt,name=remittance,proto3" json:"remittance,omitempty"`
+	SenderToReceiver              *SenderToReceiver              `protobuf:"bytes,48,opt,name=sender_to_receiver,json=senderToReceiver,proto3" json:"sender_to_receiver,omitempty"`
+	UnstructuredAddenda           *UnstructuredAddenda           `protobuf:"bytes,49,opt,name=unstructured_addenda,json=unstructuredAddenda,proto3" json:"unstructured_addenda,omitempty"`
+	RelatedRemittance             *RelatedRemittance             `protobuf:"bytes,50,opt,name=related_remittance,json=relatedRemittance,proto3" json:"related_remittance,omitempty"`
+	RemittanceOriginator          *RemittanceOriginator          `protobuf:"bytes,51,opt,name=remittance_originator,json=remittanceOriginator,proto3" json:"remittance_originator,omitempty"`
+	RemittanceBeneficiary         *RemittanceBeneficiary         `protobuf:"bytes,52,opt,name=remittance_beneficiary,json=remittanceBeneficiary,proto3" json:"remittance_beneficiary,omitempty"`
+	PrimaryRemittanceDocument     *PrimaryRemittanceDocument     `protobuf:"bytes,53,opt,name=primary_remittance_document,json=primaryRemittanceDocument,proto3" json:"primary_remittance_document,omitempty"`
+	ActualAmountPaid              *ActualAmountPaid              `protobuf:"bytes,54,opt,name=actual_amount_paid,json=actualAmountPaid,proto3" json:"actual_amount_paid,omitempty"`
+	GrossAmountRemittanceDocument *GrossAmountRemittanceDocument `protobuf:"bytes,55,opt,name=gross_amount_remittance_document,json=grossAmountRemittanceDocument,proto3" json:"gross_amount_remittance_document,omitempty"`
+	AmountNegotiatedDiscount      *AmountNegotiatedDiscount      `protobuf:"bytes,56,opt,name=amount_negotiated_discount,json=amountNegotiatedDiscount,proto3" json:"amount_negotiated_discount,omitempty"`
+	Adjustment                    *Adjustment                    `protobuf:"bytes,57,opt,name=adjustment,proto3" json:"adjustment,omitempty"`
+	DateRemittanceDocument        *DateRemittanceDocument        `protobuf:"bytes,58,opt,name=date_remittance_document,json=dateRemittanceDocument,proto3" json:"date_remittance_document,omitempty"`
+	SecondaryRemittanceDocument   *SecondaryRemittanceDocument   `protobuf:"bytes,59,opt,name=secondary_remittance_document,json=secondaryRemittanceDocument,proto3" json:"secondary_remittance_document,omitempty"`
+	RemittanceFreeText            *RemittanceFreeText            `protobuf:"bytes,60,opt,name=remittance_free_text,json=remittanceFreeText,proto3" json:"remittance_free_text,omitempty"`
+	ServiceMessage                *ServiceMessage                `protobuf:"bytes,61,opt,name=service_message,json=serviceMessage,proto3" json:"service_message,omitempty"`
+	ValidateOptions               *ValidateOpts                  `protobuf:"bytes,62,opt,name=validate_options,json=validateOptions,proto3" json:"validate_options,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *FEDWireMessage) Reset() {
+	*x = FEDWireMessage{}
+	mi := &file_wire_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FEDWireMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FEDWireMessage) ProtoMessage() {}
+
+func (x *FEDWireMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FEDWireMessage.ProtoReflect.Descriptor instead.
+func (*FEDWireMessage) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FEDWireMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FEDWireMessage) GetMessageDisposition() *MessageDisposition {
+	if x != nil {
+		return x.MessageDisposition
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetReceiptTimeStamp() *ReceiptTimeStamp {
+	if x != nil {
+		return x.ReceiptTimeStamp
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetOutputMessageAccountabilityData() *OutputMessageAccountabilityData {
+	if x != nil {
+		return x.OutputMessageAccountabilityData
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetErrorWire() *ErrorWire {
+	if x != nil {
+		return x.ErrorWire
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetSenderSupplied() *SenderSupplied {
+	if x != nil {
+		return x.SenderSupplied
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetTypeSubType() *TypeSubType {
+	if x != nil {
+		return x.TypeSubType
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetInputMessageAccountabilityData() *InputMessageAccountabilityData {
+	if x != nil {
+		return x.InputMessageAccountabilityData
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetSenderDepositoryInstitution() *SenderDepositoryInstitution {
+	if x != nil {
+		return x.SenderDepositoryInstitution
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetReceiverDepositoryInstitution() *ReceiverDepositoryInstitution {
+	if x != nil {
+		return x.ReceiverDepositoryInstitution
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetBusinessFunctionCode() *BusinessFunctionCode {
+	if x != nil {
+		return x.BusinessFunctionCode
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetSenderReference() *SenderReference {
+	if x != nil {
+		return x.SenderReference
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetPreviousMessageIdentifier() *PreviousMessageIdentifier {
+	if x != nil {
+		return x.PreviousMessageIdentifier
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetLocalInstrument() *LocalInstrument {
+	if x != nil {
+		return x.LocalInstrument
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetPaymentNotification() *PaymentNotification {
+	if x != nil {
+		return x.PaymentNotification
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetCharges() *Charges {
+	if x != nil {
+		return x.Charges
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetInstructedAmount() *InstructedAmount {
+	if x != nil {
+		return x.InstructedAmount
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetExchangeRate() *ExchangeRate {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetBeneficiaryIntermediaryFi() *BeneficiaryIntermediaryFI {
+	if x != nil {
+		return x.BeneficiaryIntermediaryFi
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetBeneficiaryFi() *BeneficiaryFI {
+	if x != nil {
+		return x.BeneficiaryFi
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetBeneficiary() *Beneficiary {
+	if x != nil {
+		return x.Beneficiary
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetBeneficiaryReference() *BeneficiaryReference {
+	if x != nil {
+		return x.BeneficiaryReference
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetAccountDebitedDrawdown() *AccountDebitedDrawdown {
+	if x != nil {
+		return x.AccountDebitedDrawdown
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetOriginator() *Originator {
+	if x != nil {
+		return x.Originator
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetOriginatorOptionF() *OriginatorOptionF {
+	if x != nil {
+		return x.OriginatorOptionF
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetOriginatorFi() *OriginatorFI {
+	if x != nil {
+		return x.OriginatorFi
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetInstructingFi() *InstructingFI {
+	if x != nil {
+		return x.InstructingFi
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetAccountCreditedDrawdown() *AccountCreditedDrawdown {
+	if x != nil {
+		return x.AccountCreditedDrawdown
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetOriginatorToBeneficiary() *OriginatorToBeneficiary {
+	if x != nil {
+		return x.OriginatorToBeneficiary
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetFiReceiverFi() *FIReceiverFI {
+	if x != nil {
+		return x.FiReceiverFi
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetFiDrawdownDebitAccountAdvice() *FIDrawdownDebitAccountAdvice {
+	if x != nil {
+		return x.FiDrawdownDebitAccountAdvice
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetFiIntermediaryFi() *FIIntermediaryFI {
+	if x != nil {
+		return x.FiIntermediaryFi
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetFiIntermediaryFiAdvice() *FIIntermediaryFIAdvice {
+	if x != nil {
+		return x.FiIntermediaryFiAdvice
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetFiBeneficiaryFi() *FIBeneficiaryFI {
+	if x != nil {
+		return x.FiBeneficiaryFi
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetFiBeneficiaryFiAdvice() *FIBeneficiaryFIAdvice {
+	if x != nil {
+		return x.FiBeneficiaryFiAdvice
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetFiBeneficiary() *FIBeneficiary {
+	if x != nil {
+		return x.FiBeneficiary
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetFiBeneficiaryAdvice() *FIBeneficiaryAdvice {
+	if x != nil {
+		return x.FiBeneficiaryAdvice
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetFiPaymentMethodToBeneficiary() *FIPaymentMethodToBeneficiary {
+	if x != nil {
+		return x.FiPaymentMethodToBeneficiary
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetFiAdditionalFiToFi() *FIAdditionalFIToFI {
+	if x != nil {
+		return x.FiAdditionalFiToFi
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetCurrencyInstructedAmount() *CurrencyInstructedAmount {
+	if x != nil {
+		return x.CurrencyInstructedAmount
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetOrderingCustomer() *OrderingCustomer {
+	if x != nil {
+		return x.OrderingCustomer
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetOrderingInstitution() *OrderingInstitution {
+	if x != nil {
+		return x.OrderingInstitution
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetIntermediaryInstitution() *IntermediaryInstitution {
+	if x != nil {
+		return x.IntermediaryInstitution
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetInstitutionAccount() *InstitutionAccount {
+	if x != nil {
+		return x.InstitutionAccount
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetBeneficiaryCustomer() *BeneficiaryCustomer {
+	if x != nil {
+		return x.BeneficiaryCustomer
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetRemittance() *Remittance {
+	if x != nil {
+		return x.Remittance
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetSenderToReceiver() *SenderToReceiver {
+	if x != nil {
+		return x.SenderToReceiver
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetUnstructuredAddenda() *UnstructuredAddenda {
+	if x != nil {
+		return x.UnstructuredAddenda
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetRelatedRemittance() *RelatedRemittance {
+	if x != nil {
+		return x.RelatedRemittance
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetRemittanceOriginator() *RemittanceOriginator {
+	if x != nil {
+		return x.RemittanceOriginator
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetRemittanceBeneficiary() *RemittanceBeneficiary {
+	if x != nil {
+		return x.RemittanceBeneficiary
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetPrimaryRemittanceDocument() *PrimaryRemittanceDocument {
+	if x != nil {
+		return x.PrimaryRemittanceDocument
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetActualAmountPaid() *ActualAmountPaid {
+	if x != nil {
+		return x.ActualAmountPaid
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetGrossAmountRemittanceDocument() *GrossAmountRemittanceDocument {
+	if x != nil {
+		return x.GrossAmountRemittanceDocument
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetAmountNegotiatedDiscount() *AmountNegotiatedDiscount {
+	if x != nil {
+		return x.AmountNegotiatedDiscount
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetAdjustment() *Adjustment {
+	if x != nil {
+		return x.Adjustment
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetDateRemittanceDocument() *DateRemittanceDocument {
+	if x != nil {
+		return x.DateRemittanceDocument
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetSecondaryRemittanceDocument() *SecondaryRemittanceDocument {
+	if x != nil {
+		return x.SecondaryRemittanceDocument
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetRemittanceFreeText() *RemittanceFreeText {
+	if x != nil {
+		return x.RemittanceFreeText
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetServiceMessage() *ServiceMessage {
+	if x != nil {
+		return x.ServiceMessage
+	}
+	return nil
+}
+
+func (x *FEDWireMessage) GetValidateOptions() *ValidateOpts {
+	if x != nil {
+		return x.ValidateOptions
+	}
+	return nil
+}
+
+// MessageDisposition is the message disposition of the wire
+type MessageDisposition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// FormatVersion 30
+	FormatVersion string `protobuf:"bytes,1,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	// TestTestProductionCode identifies if test or production
+	TestProductionCode string `protobuf:"bytes,2,opt,name=test_production_code,json=testProductionCode,proto3" json:"test_production_code,omitempty"`
+	// MessageDuplicationCode  * ` ` - Original Message * `R` - Retrieval of an original message * `P` - Resend
+	MessageDuplicationCode string `protobuf:"bytes,3,opt,name=message_duplication_code,json=messageDuplicationCode,proto3" json:"message_duplication_code,omitempty"`
+	MessageStatusIndicator string `protobuf:"bytes,4,opt,name=message_status_indicator,json=messageStatusIndicator,proto3" json:"message_status_indicator,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MessageDisposition) Reset() {
+	*x = MessageDisposition{}
+	mi := &file_wire_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageDisposition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageDisposition) ProtoMessage() {}
+
+func (x *MessageDisposition) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageDisposition.ProtoReflect.Descriptor instead.
+func (*MessageDisposition) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MessageDisposition) GetFormatVersion() string {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return ""
+}
+
+func (x *MessageDisposition) GetTestProductionCode() string {
+	if x != nil {
+		return x.TestProductionCode
+	}
+	return ""
+}
+
+func (x *MessageDisposition) GetMessageDuplicationCode() string {
+	if x != nil {
+		return x.MessageDuplicationCode
+	}
+	return ""
+}
+
+func (x *MessageDisposition) GetMessageStatusIndicator() string {
+	if x != nil {
+		return x.MessageStatusIndicator
+	}
+	return ""
+}
+
+// ReceiptTimeStamp is the receipt time stamp of the wire
+type ReceiptTimeStamp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ReceiptDate is the receipt date
+	ReceiptDate string `protobuf:"bytes,1,opt,name=receipt_date,json=receiptDate,proto3" json:"receipt_date,omitempty"`
+	// ReceiptTime is the receipt time
+	ReceiptTime                      string `protobuf:"bytes,2,opt,name=receipt_time,json=receiptTime,proto3" json:"receipt_time,omitempty"`
+	ReceiptApplicationIdentification string `protobuf:"bytes,3,opt,name=receipt_application_identification,json=receiptApplicationIdentification,proto3" json:"receipt_application_identification,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
+}
+
+func (x *ReceiptTimeStamp) Reset() {
+	*x = ReceiptTimeStamp{}
+	mi := &file_wire_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptTimeStamp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptTimeStamp) ProtoMessage() {}
+
+func (x *ReceiptTimeStamp) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptTimeStamp.ProtoReflect.Descriptor instead.
+func (*ReceiptTimeStamp) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReceiptTimeStamp) GetReceiptDate() string {
+	if x != nil {
+		return x.ReceiptDate
+	}
+	return ""
+}
+
+func (x *ReceiptTimeStamp) GetReceiptTime() string {
+	if x != nil {
+		return x.ReceiptTime
+	}
+	return ""
+}
+
+func (x *ReceiptTimeStamp) GetReceiptApplicationIdentification() string {
+	if x != nil {
+		return x.ReceiptApplicationIdentification
+	}
+	return ""
+}
+
+// OutputMessageAccountabilityData is the Output Message Accountability Data (OMAD) of the wire
+type OutputMessageAccountabilityData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// OutputCycleDate (CCYYMMDD)
+	OutputCycleDate      string `protobuf:"bytes,1,opt,name=output_cycle_date,json=outputCycleDate,proto3" json:"output_cycle_date,omitempty"`
+	OutputDestinationId  string `protobuf:"bytes,2,opt,name=output_destination_id,json=outputDestinationID,proto3" json:"output_destination_id,omitempty"`
+	OutputSequenceNumber string `protobuf:"bytes,3,opt,name=output_sequence_number,json=outputSequenceNumber,proto3" json:"output_sequence_number,omitempty"`
+	// OutputDate is the output date
+	OutputDate string `protobuf:"bytes,4,opt,name=output_date,json=outputDate,proto3" json:"output_date,omitempty"`
+	// OutputTime is OutputTime
+	OutputTime                         string `protobuf:"bytes,5,opt,name=output_time,json=outputTime,proto3" json:"output_time,omitempty"`
+	OutputFrbApplicationIdentification string `protobuf:"bytes,6,opt,name=output_frb_application_identification,json=outputFRBApplicationIdentification,proto3" json:"output_frb_application_identification,omitempty"`
+	unknownFields                      protoimpl.UnknownFields
+	sizeCache                          protoimpl.SizeCache
+}
+
+func (x *OutputMessageAccountabilityData) Reset() {
+	*x = OutputMessageAccountabilityData{}
+	mi := &file_wire_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputMessageAccountabilityData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputMessageAccountabilityData) ProtoMessage() {}
+
+func (x *OutputMessageAccountabilityData) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputMessageAccountabilityData.ProtoReflect.Descriptor instead.
+func (*OutputMessageAccountabilityData) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OutputMessageAccountabilityData) GetOutputCycleDate() string {
+	if x != nil {
+		return x.OutputCycleDate
+	}
+	return ""
+}
+
+func (x *OutputMessageAccountabilityData) GetOutputDestinationId() string {
+	if x != nil {
+		return x.OutputDestinationId
+	}
+	return ""
+}
+
+func (x *OutputMessageAccountabilityData) GetOutputSequenceNumber() string {
+	if x != nil {
+		return x.OutputSequenceNumber
+	}
+	return ""
+}
+
+func (x *OutputMessageAccountabilityData) GetOutputDate() string {
+	if x != nil {
+		return x.OutputDate
+	}
+	return ""
+}
+
+func (x *OutputMessageAccountabilityData) GetOutputTime() string {
+	if x != nil {
+		return x.OutputTime
+	}
+	return ""
+}
+
+func (x *OutputMessageAccountabilityData) GetOutputFrbApplicationIdentification() string {
+	if x != nil {
+		return x.OutputFrbApplicationIdentification
+	}
+	return ""
+}
+
+// ErrorWire is a wire error with the fedwire message
+type ErrorWire struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * `E` - Data Error * `F` - Insufficient Balance * `H` - Accountability Error * `I` - In Process or Intercepted * `W` - Cutoff Hour Error * `X` - Duplicate IMAD
+	ErrorCategory    string `protobuf:"bytes,1,opt,name=error_category,json=errorCategory,proto3" json:"error_category,omitempty"`
+	ErrorCode        string `protobuf:"bytes,2,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorDescription string `protobuf:"bytes,3,opt,name=error_description,json=errorDescription,proto3" json:"error_description,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ErrorWire) Reset() {
+	*x = ErrorWire{}
+	mi := &file_wire_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorWire) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorWire) ProtoMessage() {}
+
+func (x *ErrorWire) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorWire.ProtoReflect.Descriptor instead.
+func (*ErrorWire) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ErrorWire) GetErrorCategory() string {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return ""
+}
+
+func (x *ErrorWire) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *ErrorWire) GetErrorDescription() string {
+	if x != nil {
+		return x.ErrorDescription
+	}
+	return ""
+}
+
+// SenderSupplied {1500}
+type SenderSupplied struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// FormatVersion 30
+	FormatVersion          string `protobuf:"bytes,1,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	UserRequestCorrelation string `protobuf:"bytes,2,opt,name=user_request_correlation,json=userRequestCorrelation,proto3" json:"user_request_correlation,omitempty"`
+	// TestProductionCode T: Test P: Production
+	TestProductionCode string `protobuf:"bytes,3,opt,name=test_production_code,json=testProductionCode,proto3" json:"test_production_code,omitempty"`
+	// MessageDuplicationCode '': Original Message P: Resend
+	MessageDuplicationCode string `protobuf:"bytes,4,opt,name=message_duplication_code,json=messageDuplicationCode,proto3" json:"message_duplication_code,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SenderSupplied) Reset() {
+	*x = SenderSupplied{}
+	mi := &file_wire_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SenderSupplied) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SenderSupplied) ProtoMessage() {}
+
+func (x *SenderSupplied) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SenderSupplied.ProtoReflect.Descriptor instead.
+func (*SenderSupplied) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SenderSupplied) GetFormatVersion() string {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return ""
+}
+
+func (x *SenderSupplied) GetUserRequestCorrelation() string {
+	if x != nil {
+		return x.UserRequestCorrelation
+	}
+	return ""
+}
+
+func (x *SenderSupplied) GetTestProductionCode() string {
+	if x != nil {
+		return x.TestProductionCode
+	}
+	return ""
+}
+
+func (x *SenderSupplied) GetMessageDuplicationCode() string {
+	if x != nil {
+		return x.MessageDuplicationCode
+	}
+	return ""
+}
+
+// TypeSubType {1510}
+type TypeSubType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TypeCode      string                 `protobuf:"bytes,1,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	SubTypeCode   string                 `protobuf:"bytes,2,opt,name=sub_type_code,json=subTypeCode,proto3" json:"sub_type_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypeSubType) Reset() {
+	*x = TypeSubType{}
+	mi := &file_wire_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypeSubType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeSubType) ProtoMessage() {}
+
+func (x *TypeSubType) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeSubType.ProtoReflect.Descriptor instead.
+func (*TypeSubType) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TypeSubType) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *TypeSubType) GetSubTypeCode() string {
+	if x != nil {
+		return x.SubTypeCode
+	}
+	return ""
+}
+
+// InputMessageAccountabilityData (IMAD) {1520}
+type InputMessageAccountabilityData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// InputCycleDate CCYYMMDD
+	InputCycleDate      string `protobuf:"bytes,1,opt,name=input_cycle_date,json=inputCycleDate,proto3" json:"input_cycle_date,omitempty"`
+	InputSource         string `protobuf:"bytes,2,opt,name=input_source,json=inputSource,proto3" json:"input_source,omitempty"`
+	InputSequenceNumber string `protobuf:"bytes,3,opt,name=input_sequence_number,json=inputSequenceNumber,proto3" json:"input_sequence_number,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *InputMessageAccountabilityData) Reset() {
+	*x = InputMessageAccountabilityData{}
+	mi := &file_wire_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputMessageAccountabilityData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputMessageAccountabilityData) ProtoMessage() {}
+
+func (x *InputMessageAccountabilityData) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputMessageAccountabilityData.ProtoReflect.Descriptor instead.
+func (*InputMessageAccountabilityData) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InputMessageAccountabilityData) GetInputCycleDate() string {
+	if x != nil {
+		return x.InputCycleDate
+	}
+	return ""
+}
+
+func (x *InputMessageAccountabilityData) GetInputSource() string {
+	if x != nil {
+		return x.InputSource
+	}
+	return ""
+}
+
+func (x *InputMessageAccountabilityData) GetInputSequenceNumber() string {
+	if x != nil {
+		return x.InputSequenceNumber
+	}
+	return ""
+}
+
+// Amount (up to a penny less than $10 billion) {2000}
+type Amount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Amount must be right justified with leading zeroes, an implied decimal point and
+	// no commas (e.g., $12,345.67 becomes 000001234567). Amount can be all zeroes for
+	// only SUBTYPE CODE 90 messages.
+	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Amount) Reset() {
+	*x = Amount{}
+	mi := &file_wire_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Amount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Amount) ProtoMessage() {}
+
+func (x *Amount) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Amount.ProtoReflect.Descriptor instead.
+func (*Amount) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Amount) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// SenderDepositoryInstitution {3100}
+type SenderDepositoryInstitution struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SenderAbaNumber string                 `protobuf:"bytes,1,opt,name=sender_aba_number,json=senderABANumber,proto3" json:"sender_aba_number,omitempty"`
+	SenderShortName string                 `protobuf:"bytes,2,opt,name=sender_short_name,json=senderShortName,proto3" json:"sender_short_name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SenderDepositoryInstitution) Reset() {
+	*x = SenderDepositoryInstitution{}
+	mi := &file_wire_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SenderDepositoryInstitution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SenderDepositoryInstitution) ProtoMessage() {}
+
+func (x *SenderDepositoryInstitution) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SenderDepositoryInstitution.ProtoReflect.Descriptor instead.
+func (*SenderDepositoryInstitution) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SenderDepositoryInstitution) GetSenderAbaNumber() string {
+	if x != nil {
+		return x.SenderAbaNumber
+	}
+	return ""
+}
+
+func (x *SenderDepositoryInstitution) GetSenderShortName() string {
+	if x != nil {
+		return x.SenderShortName
+	}
+	return ""
+}
+
+// ReceiverDepositoryInstitution {3400}
+type ReceiverDepositoryInstitution struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ReceiverAbaNumber string                 `protobuf:"bytes,1,opt,name=receiver_aba_number,json=receiverABANumber,proto3" json:"receiver_aba_number,omitempty"`
+	ReceiverShortName string                 `protobuf:"bytes,2,opt,name=receiver_short_name,json=receiverShortName,proto3" json:"receiver_short_name,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReceiverDepositoryInstitution) Reset() {
+	*x = ReceiverDepositoryInstitution{}
+	mi := &file_wire_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiverDepositoryInstitution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiverDepositoryInstitution) ProtoMessage() {}
+
+func (x *ReceiverDepositoryInstitution) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiverDepositoryInstitution.ProtoReflect.Descriptor instead.
+func (*ReceiverDepositoryInstitution) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReceiverDepositoryInstitution) GetReceiverAbaNumber() string {
+	if x != nil {
+		return x.ReceiverAbaNumber
+	}
+	return ""
+}
+
+func (x *ReceiverDepositoryInstitution) GetReceiverShortName() string {
+	if x != nil {
+		return x.ReceiverShortName
+	}
+	return ""
+}
+
+// BusinessFunctionCode {3600}
+type BusinessFunctionCode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// BusinessFunctionCode BTR: Bank Transfer (Beneficiary is a bank) DRC: Customer or Corporate Drawdown Request CKS: Check Same Day Settlement DRW: Drawdown Payment CTP: Customer Transfer Plus FFR: Fed Funds Returned CTR: Customer Transfer (Beneficiary is a not a bank) FFS: Fed Funds Sold DEP: Deposit to Sender’s Account SVC: Service Message DRB: Bank-to-Bank Drawdown Request
+	BusinessFunctionCode string `protobuf:"bytes,1,opt,name=business_function_code,json=businessFunctionCode,proto3" json:"business_function_code,omitempty"`
+	// TransactionTypeCode If {3600} is CTR, an optional Transaction Type Code element is permitted; however, the Transaction Type Code 'COV' is not permitted.
+	TransactionTypeCode string `protobuf:"bytes,2,opt,name=transaction_type_code,json=transactionTypeCode,proto3" json:"transaction_type_code,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *BusinessFunctionCode) Reset() {
+	*x = BusinessFunctionCode{}
+	mi := &file_wire_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusinessFunctionCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusinessFunctionCode) ProtoMessage() {}
+
+func (x *BusinessFunctionCode) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusinessFunctionCode.ProtoReflect.Descriptor instead.
+func (*BusinessFunctionCode) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BusinessFunctionCode) GetBusinessFunctionCode() string {
+	if x != nil {
+		return x.BusinessFunctionCode
+	}
+	return ""
+}
+
+func (x *BusinessFunctionCode) GetTransactionTypeCode() string {
+	if x != nil {
+		return x.TransactionTypeCode
+	}
+	return ""
+}
+
+// SenderReference is the SenderReference of the wire
+type SenderReference struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SenderReference string                 `protobuf:"bytes,1,opt,name=sender_reference,json=senderReference,proto3" json:"sender_reference,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SenderReference) Reset() {
+	*x = SenderReference{}
+	mi := &file_wire_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SenderReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SenderReference) ProtoMessage() {}
+
+func (x *SenderReference) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SenderReference.ProtoReflect.Descriptor instead.
+func (*SenderReference) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SenderReference) GetSenderReference() string {
+	if x != nil {
+		return x.SenderReference
+	}
+	return ""
+}
+
+// PreviousMessageIdentifier is the PreviousMessageIdentifier of the wire
+type PreviousMessageIdentifier struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	PreviousMessageIdentifier string                 `protobuf:"bytes,1,opt,name=previous_message_identifier,json=PreviousMessageIdentifier,proto3" json:"previous_message_identifier,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *PreviousMessageIdentifier) Reset() {
+	*x = PreviousMessageIdentifier{}
+	mi := &file_wire_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviousMessageIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviousMessageIdentifier) ProtoMessage() {}
+
+func (x *PreviousMessageIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviousMessageIdentifier.ProtoReflect.Descriptor instead.
+func (*PreviousMessageIdentifier) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PreviousMessageIdentifier) GetPreviousMessageIdentifier() string {
+	if x != nil {
+		return x.PreviousMessageIdentifier
+	}
+	return ""
+}
+
+// LocalInstrument is the LocalInstrument of the wire
+type LocalInstrument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// LocalInstrumentCode is local instrument code
+	LocalInstrument string `protobuf:"bytes,1,opt,name=local_instrument,json=LocalInstrument,proto3" json:"local_instrument,omitempty"`
+	// ProprietaryCode is proprietary code
+	ProprietaryCode string `protobuf:"bytes,2,opt,name=proprietary_code,json=proprietaryCode,proto3" json:"proprietary_code,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LocalInstrument) Reset() {
+	*x = LocalInstrument{}
+	mi := &file_wire_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalInstrument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalInstrument) ProtoMessage() {}
+
+func (x *LocalInstrument) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalInstrument.ProtoReflect.Descriptor instead.
+func (*LocalInstrument) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LocalInstrument) GetLocalInstrument() string {
+	if x != nil {
+		return x.LocalInstrument
+	}
+	return ""
+}
+
+func (x *LocalInstrument) GetProprietaryCode() string {
+	if x != nil {
+		return x.ProprietaryCode
+	}
+	return ""
+}
+
+// PaymentNotification is the PaymentNotification of the wire
+type PaymentNotification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PaymentNotificationIndicator
+	// * `0 - 6` - Reserved for market practice conventions.
+	// * `7 - 9` - Reserved for bilateral agreements between Fedwire senders and receivers.
+	PaymentNotificationIndicator         string `protobuf:"bytes,1,opt,name=payment_notification_indicator,json=paymentNotificationIndicator,proto3" json:"payment_notification_indicator,omitempty"`
+	ContactNotificationElectronicAddress string `protobuf:"bytes,2,opt,name=contact_notification_electronic_address,json=contactNotificationElectronicAddress,proto3" json:"contact_notification_electronic_address,omitempty"`
+	ContactName                          string `protobuf:"bytes,3,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	ContactPhoneNumber                   string `protobuf:"bytes,4,opt,name=contact_phone_number,json=contactPhoneNumber,proto3" json:"contact_phone_number,omitempty"`
+	ContactMobileNumber                  string `protobuf:"bytes,5,opt,name=contact_mobile_number,json=contactMobileNumber,proto3" json:"contact_mobile_number,omitempty"`
+	FaxNumber                            string `protobuf:"bytes,6,opt,name=fax_number,json=faxNumber,proto3" json:"fax_number,omitempty"`
+	EndToEndIdentification               string `protobuf:"bytes,7,opt,name=end_to_end_identification,json=endToEndIdentification,proto3" json:"end_to_end_identification,omitempty"`
+	unknownFields                        protoimpl.UnknownFields
+	sizeCache                            protoimpl.SizeCache
+}
+
+func (x *PaymentNotification) Reset() {
+	*x = PaymentNotification{}
+	mi := &file_wire_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentNotification) ProtoMessage() {}
+
+func (x *PaymentNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentNotification.ProtoReflect.Descriptor instead.
+func (*PaymentNotification) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PaymentNotification) GetPaymentNotificationIndicator() string {
+	if x != nil {
+		return x.PaymentNotificationIndicator
+	}
+	return ""
+}
+
+func (x *PaymentNotification) GetContactNotificationElectronicAddress() string {
+	if x != nil {
+		return x.ContactNotificationElectronicAddress
+	}
+	return ""
+}
+
+func (x *PaymentNotification) GetContactName() string {
+	if x != nil {
+		return x.ContactName
+	}
+	return ""
+}
+
+func (x *PaymentNotification) GetContactPhoneNumber() string {
+	if x != nil {
+		return x.ContactPhoneNumber
+	}
+	return ""
+}
+
+func (x *PaymentNotification) GetContactMobileNumber() string {
+	if x != nil {
+		return x.ContactMobileNumber
+	}
+	return ""
+}
+
+func (x *PaymentNotification) GetFaxNumber() string {
+	if x != nil {
+		return x.FaxNumber
+	}
+	return ""
+}
+
+func (x *PaymentNotification) GetEndToEndIdentification() string {
+	if x != nil {
+		return x.EndToEndIdentification
+	}
+	return ""
+}
+
+// Charges is the Charges of the wire
+type Charges struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ChargeDetails * `B` - Beneficiary * `S` - Shared
+	ChargeDetails string `protobuf:"bytes,1,opt,name=charge_details,json=chargeDetails,proto3" json:"charge_details,omitempty"`
+	// SendersChargesOne  The first three characters must contain an alpha currency code (e.g., USD).  The remaining
+	// characters for the amount must begin with at least one numeric character (0-9) and only one decimal comma
+	// marker.  $1,234.56 should be entered as USD1234,56 and $0.99 should be entered as USD0,99.
+	SendersChargesOne string `protobuf:"bytes,2,opt,name=senders_charges_one,json=sendersChargesOne,proto3" json:"senders_charges_one,omitempty"`
+	// SendersChargesTwo  The first three characters must contain an alpha currency code (e.g., USD).  The remaining
+	// characters for the amount must begin with at least one numeric character (0-9) and only one decimal comma
+	// marker.  $1,234.56 should be entered as USD1234,56 and $0.99 should be entered as USD0,99.
+	SendersChargesTwo string `protobuf:"bytes,3,opt,name=senders_charges_two,json=sendersChargesTwo,proto3" json:"senders_charges_two,omitempty"`
+	// SendersChargesThree  The first three characters must contain an alpha currency code (e.g., USD).  The remaining
+	// characters for the amount must begin with at least one numeric character (0-9) and only one decimal comma
+	// marker.  $1,234.56 should be entered as USD1234,56 and $0.99 should be entered as USD0,99.
+	SendersChargesThree string `protobuf:"bytes,4,opt,name=senders_charges_three,json=sendersChargesThree,proto3" json:"senders_charges_three,omitempty"`
+	// SendersChargesFour  The first three characters must contain an alpha currency code (e.g., USD).  The remaining
+	// characters for the amount must begin with at least one numeric character (0-9) and only one decimal comma
+	// marker.  $1,234.56 should be entered as USD1234,56 and $0.99 should be entered as USD0,99.
+	SendersChargesFour string `protobuf:"bytes,5,opt,name=senders_charges_four,json=sendersChargesFour,proto3" json:"senders_charges_four,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Charges) Reset() {
+	*x = Charges{}
+	mi := &file_wire_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Charges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Charges) ProtoMessage() {}
+
+func (x *Charges) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Charges.ProtoReflect.Descriptor instead.
+func (*Charges) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Charges) GetChargeDetails() string {
+	if x != nil {
+		return x.ChargeDetails
+	}
+	return ""
+}
+
+func (x *Charges) GetSendersChargesOne() string {
+	if x != nil {
+		return x.SendersChargesOne
+	}
+	return ""
+}
+
+func (x *Charges) GetSendersChargesTwo() string {
+	if x != nil {
+		return x.SendersChargesTwo
+	}
+	return ""
+}
+
+func (x *Charges) GetSendersChargesThree() string {
+	if x != nil {
+		return x.SendersChargesThree
+	}
+	return ""
+}
+
+func (x *Charges) GetSendersChargesFour() string {
+	if x != nil {
+		return x.SendersChargesFour
+	}
+	return ""
+}
+
+// InstructedAmount is the InstructedAmount of the wire
+type InstructedAmount struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Amount  Must begin with at least one numeric character (0-9) and contain only one decimal comma marker
+	// (e.g., $1,234.56 should be entered as 1234,56 and $0.99 should be entered as
+	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstructedAmount) Reset() {
+	*x = InstructedAmount{}
+	mi := &file_wire_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstructedAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstructedAmount) ProtoMessage() {}
+
+func (x *InstructedAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstructedAmount.ProtoReflect.Descriptor instead.
+func (*InstructedAmount) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *InstructedAmount) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *InstructedAmount) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// ExchangeRate is the ExchangeRate of the wire
+type ExchangeRate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ExchangeRate is the exchange rate
+	// Must contain at least one numeric character and only one decimal comma marker (e.g., an exchange rate of 1.2345 should be entered as 1,2345).
+	ExchangeRate  string `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_wire_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExchangeRate) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+// BeneficiaryIntermediaryFI {4000}
+type BeneficiaryIntermediaryFI struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Financial Institution
+	FinancialInstitution *FinancialInstitution `protobuf:"bytes,1,opt,name=financial_institution,json=financialInstitution,proto3" json:"financial_institution,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BeneficiaryIntermediaryFI) Reset() {
+	*x = BeneficiaryIntermediaryFI{}
+	mi := &file_wire_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeneficiaryIntermediaryFI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeneficiaryIntermediaryFI) ProtoMessage() {}
+
+func (x *BeneficiaryIntermediaryFI) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeneficiaryIntermediaryFI.ProtoReflect.Descriptor instead.
+func (*BeneficiaryIntermediaryFI) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BeneficiaryIntermediaryFI) GetFinancialInstitution() *FinancialInstitution {
+	if x != nil {
+		return x.FinancialInstitution
+	}
+	return nil
+}
+
+// BeneficiaryFI is the financial institution of the beneficiary
+type BeneficiaryFI struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Financial Institution
+	FinancialInstitution *FinancialInstitution `protobuf:"bytes,1,opt,name=financial_institution,json=financialInstitution,proto3" json:"financial_institution,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BeneficiaryFI) Reset() {
+	*x = BeneficiaryFI{}
+	mi := &file_wire_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeneficiaryFI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeneficiaryFI) ProtoMessage() {}
+
+func (x *BeneficiaryFI) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeneficiaryFI.ProtoReflect.Descriptor instead.
+func (*BeneficiaryFI) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BeneficiaryFI) GetFinancialInstitution() *FinancialInstitution {
+	if x != nil {
+		return x.FinancialInstitution
+	}
+	return nil
+}
+
+// Beneficiary is the beneficiary of the wire
+type Beneficiary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Personal      *Personal              `protobuf:"bytes,1,opt,name=personal,proto3" json:"personal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Beneficiary) Reset() {
+	*x = Beneficiary{}
+	mi := &file_wire_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Beneficiary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Beneficiary) ProtoMessage() {}
+
+func (x *Beneficiary) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Beneficiary.ProtoReflect.Descriptor instead.
+func (*Beneficiary) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Beneficiary) GetPersonal() *Personal {
+	if x != nil {
+		return x.Personal
+	}
+	return nil
+}
+
+// BeneficiaryReference is a reference for the beneficiary
+type BeneficiaryReference struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BeneficiaryReference string                 `protobuf:"bytes,1,opt,name=beneficiary_reference,json=beneficiaryReference,proto3" json:"beneficiary_reference,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BeneficiaryReference) Reset() {
+	*x = BeneficiaryReference{}
+	mi := &file_wire_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeneficiaryReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeneficiaryReference) ProtoMessage() {}
+
+func (x *BeneficiaryReference) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeneficiaryReference.ProtoReflect.Descriptor instead.
+func (*BeneficiaryReference) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BeneficiaryReference) GetBeneficiaryReference() string {
+	if x != nil {
+		return x.BeneficiaryReference
+	}
+	return ""
+}
+
+// AccountDebitedDrawdown is the account which is debited in a drawdown
+type AccountDebitedDrawdown struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identification Code * `D` - Debit
+	IdentificationCode string   `protobuf:"bytes,1,opt,name=identification_code,json=identificationCode,proto3" json:"identification_code,omitempty"`
+	Identifier         string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Name               string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Address            *Address `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AccountDebitedDrawdown) Reset() {
+	*x = AccountDebitedDrawdown{}
+	mi := &file_wire_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDebitedDrawdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDebitedDrawdown) ProtoMessage() {}
+
+func (x *AccountDebitedDrawdown) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDebitedDrawdown.ProtoReflect.Descriptor instead.
+func (*AccountDebitedDrawdown) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AccountDebitedDrawdown) GetIdentificationCode() string {
+	if x != nil {
+		return x.IdentificationCode
+	}
+	return ""
+}
+
+func (x *AccountDebitedDrawdown) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *AccountDebitedDrawdown) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccountDebitedDrawdown) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// Originator is the originator of the wire
+type Originator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Personal      *Personal              `protobuf:"bytes,1,opt,name=personal,proto3" json:"personal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Originator) Reset() {
+	*x = Originator{}
+	mi := &file_wire_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Originator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Originator) ProtoMessage() {}
+
+func (x *Originator) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Originator.ProtoReflect.Descriptor instead.
+func (*Originator) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Originator) GetPersonal() *Personal {
+	if x != nil {
+		return x.Personal
+	}
+	return nil
+}
+
+// OriginatorOptionF is originator option F information
+type OriginatorOptionF struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PartyIdentifier must be one of the following two formats:
+	// 1. /Account Number (slash followed by at least one
+	// valid non-space character:  e.g., /123456)
+	// 2. Unique Identifier/ (4 character code followed by a slash and at least one valid non-space character:
+	// e.g., SOSE/123-456-789)
+	//
+	// ARNU: Alien Registration Number
+	// CCPT: Passport Number
+	// CUST: Customer Identification Number
+	// DRLC: Driver’s License Number
+	// EMPL: Employer Number
+	// NIDN: National Identify Number
+	// SOSE: Social Security Number
+	// TXID: Tax Identification Number
+	PartyIdentifier string `protobuf:"bytes,1,opt,name=party_identifier,json=partyIdentifier,proto3" json:"party_identifier,omitempty"`
+	// Name  Format:  Must begin with Line Code 1 followed by a slash and at least one valid non-space character:
+	// e.g., 1/SMITH JOHN.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// LineOne
+	// Format: Must begin with one of the following Line Codes followed by a slash and at least one
+	// valid non-space character.
+	// 1 Name
+	// 2 Address
+	// 3 Country and Town
+	// 4 Date of Birth
+	// 5 Place of Birth
+	// 6 Customer Identification Number
+	// 7 National Identity Number
+	// 8 Additional Information
+	// For example:
+	// 2/123 MAIN STREET
+	// 3/US/NEW YORK, NY 10000
+	// 7/111-22-3456
+	LineOne string `protobuf:"bytes,3,opt,name=line_one,json=lineOne,proto3" json:"line_one,omitempty"`
+	// LineTwo
+	// Format: Must begin with one of the following Line Codes followed by a slash and at least one
+	// valid non-space character.
+	// 1 Name
+	// 2 Address
+	// 3 Country and Town
+	// 4 Date of Birth
+	// 5 Place of Birth
+	// 6 Customer Identification Number
+	// 7 National Identity Number
+	// 8 Additional Information
+	// For example:
+	// 2/123 MAIN STREET
+	// 3/US/NEW YORK, NY 10000
+	// 7/111-22-3456
+	LineTwo string `protobuf:"bytes,4,opt,name=line_two,json=lineTwo,proto3" json:"line_two,omitempty"`
+	// LineThree
+	// Format: Must begin with one of the following Line Codes followed by a slash and at least one
+	// valid non-space character.
+	// 1 Name
+	// 2 Address
+	// 3 Country and Town
+	// 4 Date of Birth
+	// 5 Place of Birth
+	// 6 Customer Identification Number
+	// 7 National Identity Number
+	// 8 Additional Information
+	// For example:
+	// 2/123 MAIN STREET
+	// 3/US/NEW YORK, NY 10000
+	// 7/111-22-3456
+	LineThree     string `protobuf:"bytes,5,opt,name=line_three,json=lineThree,proto3" json:"line_three,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OriginatorOptionF) Reset() {
+	*x = OriginatorOptionF{}
+	mi := &file_wire_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OriginatorOptionF) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OriginatorOptionF) ProtoMessage() {}
+
+func (x *OriginatorOptionF) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OriginatorOptionF.ProtoReflect.Descriptor instead.
+func (*OriginatorOptionF) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *OriginatorOptionF) GetPartyIdentifier() string {
+	if x != nil {
+		return x.PartyIdentifier
+	}
+	return ""
+}
+
+func (x *OriginatorOptionF) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OriginatorOptionF) GetLineOne() string {
+	if x != nil {
+		return x.LineOne
+	}
+	return ""
+}
+
+func (x *OriginatorOptionF) GetLineTwo() string {
+	if x != nil {
+		return x.LineTwo
+	}
+	return ""
+}
+
+func (x *OriginatorOptionF) GetLineThree() string {
+	if x != nil {
+		return x.LineThree
+	}
+	return ""
+}
+
+// OriginatorFI is the originator Financial Institution
+type OriginatorFI struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Financial Institution
+	FinancialInstitution *FinancialInstitution `protobuf:"bytes,1,opt,name=financial_institution,json=financialInstitution,proto3" json:"financial_institution,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *OriginatorFI) Reset() {
+	*x = OriginatorFI{}
+	mi := &file_wire_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OriginatorFI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OriginatorFI) ProtoMessage() {}
+
+func (x *OriginatorFI) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OriginatorFI.ProtoReflect.Descriptor instead.
+func (*OriginatorFI) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *OriginatorFI) GetFinancialInstitution() *FinancialInstitution {
+	if x != nil {
+		return x.FinancialInstitution
+	}
+	return nil
+}
+
+// InstructingFI is the instructing financial institution
+type InstructingFI struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Financial Institution
+	FinancialInstitution *FinancialInstitution `protobuf:"bytes,1,opt,name=financial_institution,json=financialInstitution,proto3" json:"financial_institution,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *InstructingFI) Reset() {
+	*x = InstructingFI{}
+	mi := &file_wire_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstructingFI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstructingFI) ProtoMessage() {}
+
+func (x *InstructingFI) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstructingFI.ProtoReflect.Descriptor instead.
+func (*InstructingFI) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *InstructingFI) GetFinancialInstitution() *FinancialInstitution {
+	if x != nil {
+		return x.FinancialInstitution
+	}
+	return nil
+}
+
+// AccountCreditedDrawdown is the account which is credited in a drawdown
+type AccountCreditedDrawdown struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DrawdownCreditAccountNumber  9 character ABA
+	DrawdownCreditAccountNumber string `protobuf:"bytes,1,opt,name=drawdown_credit_account_number,json=drawdownCreditAccountNumber,proto3" json:"drawdown_credit_account_number,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *AccountCreditedDrawdown) Reset() {
+	*x = AccountCreditedDrawdown{}
+	mi := &file_wire_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountCreditedDrawdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountCreditedDrawdown) ProtoMessage() {}
+
+func (x *AccountCreditedDrawdown) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountCreditedDrawdown.ProtoReflect.Descriptor instead.
+func (*AccountCreditedDrawdown) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AccountCreditedDrawdown) GetDrawdownCreditAccountNumber() string {
+	if x != nil {
+		return x.DrawdownCreditAccountNumber
+	}
+	return ""
+}
+
+// OriginatorToBeneficiary is the OriginatorToBeneficiary of the wire
+type OriginatorToBeneficiary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineOne       string                 `protobuf:"bytes,1,opt,name=line_one,json=lineOne,proto3" json:"line_one,omitempty"`
+	LineTwo       string                 `protobuf:"bytes,2,opt,name=line_two,json=lineTwo,proto3" json:"line_two,omitempty"`
+	LineThree     string                 `protobuf:"bytes,3,opt,name=line_three,json=lineThree,proto3" json:"line_three,omitempty"`
+	LineFour      string                 `protobuf:"bytes,4,opt,name=line_four,json=lineFour,proto3" json:"line_four,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OriginatorToBeneficiary) Reset() {
+	*x = OriginatorToBeneficiary{}
+	mi := &file_wire_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OriginatorToBeneficiary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OriginatorToBeneficiary) ProtoMessage() {}
+
+func (x *OriginatorToBeneficiary) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OriginatorToBeneficiary.ProtoReflect.Descriptor instead.
+func (*OriginatorToBeneficiary) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *OriginatorToBeneficiary) GetLineOne() string {
+	if x != nil {
+		return x.LineOne
+	}
+	return ""
+}
+
+func (x *OriginatorToBeneficiary) GetLineTwo() string {
+	if x != nil {
+		return x.LineTwo
+	}
+	return ""
+}
+
+func (x *OriginatorToBeneficiary) GetLineThree() string {
+	if x != nil {
+		return x.LineThree
+	}
+	return ""
+}
+
+func (x *OriginatorToBeneficiary) GetLineFour() string {
+	if x != nil {
+		return x.LineFour
+	}
+	return ""
+}
+
+// FIReceiverFI is the financial institution receiver financial institution
+type FIReceiverFI struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// FIToFI is financial institution to financial institution
+	FiToFi        *FIToFI `protobuf:"bytes,1,opt,name=fi_to_fi,json=fiToFI,proto3" json:"fi_to_fi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FIReceiverFI) Reset() {
+	*x = FIReceiverFI{}
+	mi := &file_wire_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FIReceiverFI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIReceiverFI) ProtoMessage() {}
+
+func (x *FIReceiverFI) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIReceiverFI.ProtoReflect.Descriptor instead.
+func (*FIReceiverFI) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FIReceiverFI) GetFiToFi() *FIToFI {
+	if x != nil {
+		return x.FiToFi
+	}
+	return nil
+}
+
+// FIDrawdownDebitAccountAdvice is the financial institution drawdown debit account advice
+type FIDrawdownDebitAccountAdvice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Advice        *Advice                `protobuf:"bytes,1,opt,name=advice,proto3" json:"advice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FIDrawdownDebitAccountAdvice) Reset() {
+	*x = FIDrawdownDebitAccountAdvice{}
+	mi := &file_wire_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FIDrawdownDebitAccountAdvice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIDrawdownDebitAccountAdvice) ProtoMessage() {}
+
+func (x *FIDrawdownDebitAccountAdvice) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIDrawdownDebitAccountAdvice.ProtoReflect.Descriptor instead.
+func (*FIDrawdownDebitAccountAdvice) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FIDrawdownDebitAccountAdvice) GetAdvice() *Advice {
+	if x != nil {
+		return x.Advice
+	}
+	return nil
+}
+
+// FIIntermediaryFI is the financial institution intermediary financial institution
+type FIIntermediaryFI struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Financial Institution
+	FiToFi        *FIToFI `protobuf:"bytes,1,opt,name=fi_to_fi,json=fiToFI,proto3" json:"fi_to_fi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FIIntermediaryFI) Reset() {
+	*x = FIIntermediaryFI{}
+	mi := &file_wire_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FIIntermediaryFI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIIntermediaryFI) ProtoMessage() {}
+
+func (x *FIIntermediaryFI) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIIntermediaryFI.ProtoReflect.Descriptor instead.
+func (*FIIntermediaryFI) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FIIntermediaryFI) GetFiToFi() *FIToFI {
+	if x != nil {
+		return x.FiToFi
+	}
+	return nil
+}
+
+// FIIntermediaryFIAdvice is the financial institution intermediary financial institution
+type FIIntermediaryFIAdvice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Advice        *Advice                `protobuf:"bytes,1,opt,name=advice,proto3" json:"advice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FIIntermediaryFIAdvice) Reset() {
+	*x = FIIntermediaryFIAdvice{}
+	mi := &file_wire_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FIIntermediaryFIAdvice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIIntermediaryFIAdvice) ProtoMessage() {}
+
+func (x *FIIntermediaryFIAdvice) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIIntermediaryFIAdvice.ProtoReflect.Descriptor instead.
+func (*FIIntermediaryFIAdvice) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *FIIntermediaryFIAdvice) GetAdvice() *Advice {
+	if x != nil {
+		return x.Advice
+	}
+	return nil
+}
+
+// FIBeneficiaryFI is the financial institution beneficiary financial institution
+type FIBeneficiaryFI struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Financial Institution
+	FiToFi        *FIToFI `protobuf:"bytes,1,opt,name=fi_to_fi,json=fiToFI,proto3" json:"fi_to_fi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FIBeneficiaryFI) Reset() {
+	*x = FIBeneficiaryFI{}
+	mi := &file_wire_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FIBeneficiaryFI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIBeneficiaryFI) ProtoMessage() {}
+
+func (x *FIBeneficiaryFI) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIBeneficiaryFI.ProtoReflect.Descriptor instead.
+func (*FIBeneficiaryFI) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FIBeneficiaryFI) GetFiToFi() *FIToFI {
+	if x != nil {
+		return x.FiToFi
+	}
+	return nil
+}
+
+// FIBeneficiaryFIAdvice is the financial institution beneficiary financial institution
+type FIBeneficiaryFIAdvice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Advice        *Advice                `protobuf:"bytes,1,opt,name=advice,proto3" json:"advice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FIBeneficiaryFIAdvice) Reset() {
+	*x = FIBeneficiaryFIAdvice{}
+	mi := &file_wire_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FIBeneficiaryFIAdvice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIBeneficiaryFIAdvice) ProtoMessage() {}
+
+func (x *FIBeneficiaryFIAdvice) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIBeneficiaryFIAdvice.ProtoReflect.Descriptor instead.
+func (*FIBeneficiaryFIAdvice) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FIBeneficiaryFIAdvice) GetAdvice() *Advice {
+	if x != nil {
+		return x.Advice
+	}
+	return nil
+}
+
+// FIBeneficiary is the financial institution beneficiary
+type FIBeneficiary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Financial Institution
+	FiToFi        *FIToFI `protobuf:"bytes,1,opt,name=fi_to_fi,json=fiToFI,proto3" json:"fi_to_fi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FIBeneficiary) Reset() {
+	*x = FIBeneficiary{}
+	mi := &file_wire_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FIBeneficiary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIBeneficiary) ProtoMessage() {}
+
+func (x *FIBeneficiary) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIBeneficiary.ProtoReflect.Descriptor instead.
+func (*FIBeneficiary) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *FIBeneficiary) GetFiToFi() *FIToFI {
+	if x != nil {
+		return x.FiToFi
+	}
+	return nil
+}
+
+// FIBeneficiaryAdvice is the financial institution beneficiary advice
+type FIBeneficiaryAdvice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Advice        *Advice                `protobuf:"bytes,1,opt,name=advice,proto3" json:"advice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FIBeneficiaryAdvice) Reset() {
+	*x = FIBeneficiaryAdvice{}
+	mi := &file_wire_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FIBeneficiaryAdvice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIBeneficiaryAdvice) ProtoMessage() {}
+
+func (x *FIBeneficiaryAdvice) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIBeneficiaryAdvice.ProtoReflect.Descriptor instead.
+func (*FIBeneficiaryAdvice) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FIBeneficiaryAdvice) GetAdvice() *Advice {
+	if x != nil {
+		return x.Advice
+	}
+	return nil
+}
+
+// FIPaymentMethodToBeneficiary is the financial institution payment method to beneficiary
+type FIPaymentMethodToBeneficiary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PaymentMethod is payment method
+	PaymentMethod string `protobuf:"bytes,1,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	// Additional is additional information
+	Additional    string `protobuf:"bytes,2,opt,name=additional,json=Additional,proto3" json:"additional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FIPaymentMethodToBeneficiary) Reset() {
+	*x = FIPaymentMethodToBeneficiary{}
+	mi := &file_wire_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FIPaymentMethodToBeneficiary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIPaymentMethodToBeneficiary) ProtoMessage() {}
+
+func (x *FIPaymentMethodToBeneficiary) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIPaymentMethodToBeneficiary.ProtoReflect.Descriptor instead.
+func (*FIPaymentMethodToBeneficiary) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *FIPaymentMethodToBeneficiary) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *FIPaymentMethodToBeneficiary) GetAdditional() string {
+	if x != nil {
+		return x.Additional
+	}
+	return ""
+}
+
+// FIAdditionalFIToFI is the financial institution beneficiary financial institution
+type FIAdditionalFIToFI struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// AdditionalFiToFi is additional financial institution to financial institution information
+	AdditionalFiToFi *AdditionalFIToFI `protobuf:"bytes,1,opt,name=additional_fi_to_fi,json=additionalFiToFi,proto3" json:"additional_fi_to_fi,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FIAdditionalFIToFI) Reset() {
+	*x = FIAdditionalFIToFI{}
+	mi := &file_wire_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FIAdditionalFIToFI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIAdditionalFIToFI) ProtoMessage() {}
+
+func (x *FIAdditionalFIToFI) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIAdditionalFIToFI.ProtoReflect.Descriptor instead.
+func (*FIAdditionalFIToFI) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FIAdditionalFIToFI) GetAdditionalFiToFi() *AdditionalFIToFI {
+	if x != nil {
+		return x.AdditionalFiToFi
+	}
+	return nil
+}
+
+// CurrencyInstructedAmount is the currency instructed amount
+type CurrencyInstructedAmount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SwiftFieldTag string                 `protobuf:"bytes,1,opt,name=swift_field_tag,json=swiftFieldTag,proto3" json:"swift_field_tag,omitempty"`
+	// Amount is the instructed amount
+	// Amount Must begin with at least one numeric character (0-9) and contain only one decimal comma marker
+	// (e.g., $1,234.56 should be entered as 1234,56 and $0.99 should be entered as
+	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyInstructedAmount) Reset() {
+	*x = CurrencyInstructedAmount{}
+	mi := &file_wire_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyInstructedAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyInstructedAmount) ProtoMessage() {}
+
+func (x *CurrencyInstructedAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyInstructedAmount.ProtoReflect.Descriptor instead.
+func (*CurrencyInstructedAmount) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CurrencyInstructedAmount) GetSwiftFieldTag() string {
+	if x != nil {
+		return x.SwiftFieldTag
+	}
+	return ""
+}
+
+func (x *CurrencyInstructedAmount) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// OrderingCustomer is the ordering customer
+type OrderingCustomer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CoverPayment is CoverPayment
+	CoverPayment  *CoverPayment `protobuf:"bytes,1,opt,name=cover_payment,json=coverPayment,proto3" json:"cover_payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderingCustomer) Reset() {
+	*x = OrderingCustomer{}
+	mi := &file_wire_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderingCustomer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderingCustomer) ProtoMessage() {}
+
+func (x *OrderingCustomer) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderingCustomer.ProtoReflect.Descriptor instead.
+func (*OrderingCustomer) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *OrderingCustomer) GetCoverPayment() *CoverPayment {
+	if x != nil {
+		return x.CoverPayment
+	}
+	return nil
+}
+
+// OrderingInstitution is the ordering institution
+type OrderingInstitution struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CoverPayment is CoverPayment
+	CoverPayment  *CoverPayment `protobuf:"bytes,1,opt,name=cover_payment,json=coverPayment,proto3" json:"cover_payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderingInstitution) Reset() {
+	*x = OrderingInstitution{}
+	mi := &file_wire_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderingInstitution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderingInstitution) ProtoMessage() {}
+
+func (x *OrderingInstitution) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderingInstitution.ProtoReflect.Descriptor instead.
+func (*OrderingInstitution) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *OrderingInstitution) GetCoverPayment() *CoverPayment {
+	if x != nil {
+		return x.CoverPayment
+	}
+	return nil
+}
+
+// IntermediaryInstitution is the intermediary institution
+type IntermediaryInstitution struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CoverPayment is CoverPayment
+	CoverPayment  *CoverPayment `protobuf:"bytes,1,opt,name=cover_payment,json=coverPayment,proto3" json:"cover_payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntermediaryInstitution) Reset() {
+	*x = IntermediaryInstitution{}
+	mi := &file_wire_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntermediaryInstitution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntermediaryInstitution) ProtoMessage() {}
+
+func (x *IntermediaryInstitution) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntermediaryInstitution.ProtoReflect.Descriptor instead.
+func (*IntermediaryInstitution) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *IntermediaryInstitution) GetCoverPayment() *CoverPayment {
+	if x != nil {
+		return x.CoverPayment
+	}
+	return nil
+}
+
+// InstitutionAccount is the institution account
+type InstitutionAccount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CoverPayment is CoverPayment
+	CoverPayment  *CoverPayment `protobuf:"bytes,1,opt,name=cover_payment,json=coverPayment,proto3" json:"cover_payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstitutionAccount) Reset() {
+	*x = InstitutionAccount{}
+	mi := &file_wire_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstitutionAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstitutionAccount) ProtoMessage() {}
+
+func (x *InstitutionAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstitutionAccount.ProtoReflect.Descriptor instead.
+func (*InstitutionAccount) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *InstitutionAccount) GetCoverPayment() *CoverPayment {
+	if x != nil {
+		return x.CoverPayment
+	}
+	return nil
+}
+
+// BeneficiaryCustomer is the beneficiary customer
+type BeneficiaryCustomer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CoverPayment is CoverPayment
+	CoverPayment  *CoverPayment `protobuf:"bytes,1,opt,name=cover_payment,json=coverPayment,proto3" json:"cover_payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeneficiaryCustomer) Reset() {
+	*x = BeneficiaryCustomer{}
+	mi := &file_wire_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeneficiaryCustomer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeneficiaryCustomer) ProtoMessage() {}
+
+func (x *BeneficiaryCustomer) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeneficiaryCustomer.ProtoReflect.Descriptor instead.
+func (*BeneficiaryCustomer) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *BeneficiaryCustomer) GetCoverPayment() *CoverPayment {
+	if x != nil {
+		return x.CoverPayment
+	}
+	return nil
+}
+
+// Remittance is the remittance information
+type Remittance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CoverPayment is CoverPayment
+	CoverPayment  *CoverPayment `protobuf:"bytes,1,opt,name=cover_payment,json=coverPayment,proto3" json:"cover_payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Remittance) Reset() {
+	*x = Remittance{}
+	mi := &file_wire_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Remittance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Remittance) ProtoMessage() {}
+
+func (x *Remittance) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Remittance.ProtoReflect.Descriptor instead.
+func (*Remittance) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Remittance) GetCoverPayment() *CoverPayment {
+	if x != nil {
+		return x.CoverPayment
+	}
+	return nil
+}
+
+// SenderToReceiver is the remittance information
+type SenderToReceiver struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CoverPayment is CoverPayment
+	CoverPayment  *CoverPayment `protobuf:"bytes,1,opt,name=cover_payment,json=coverPayment,proto3" json:"cover_payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SenderToReceiver) Reset() {
+	*x = SenderToReceiver{}
+	mi := &file_wire_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SenderToReceiver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SenderToReceiver) ProtoMessage() {}
+
+func (x *SenderToReceiver) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SenderToReceiver.ProtoReflect.Descriptor instead.
+func (*SenderToReceiver) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SenderToReceiver) GetCoverPayment() *CoverPayment {
+	if x != nil {
+		return x.CoverPayment
+	}
+	return nil
+}
+
+// UnstructuredAddenda is the unstructured addenda information
+type UnstructuredAddenda struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// AddendaLength  Addenda Length must be numeric, padded with leading zeros if less than four characters and must equal length of content in Addenda Information (e.g., if content of Addenda Information is 987 characters, Addenda Length must be 0987).
+	AddendaLength string `protobuf:"bytes,1,opt,name=addenda_length,json=addendaLength,proto3" json:"addenda_length,omitempty"`
+	Addenda       string `protobuf:"bytes,2,opt,name=addenda,proto3" json:"addenda,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnstructuredAddenda) Reset() {
+	*x = UnstructuredAddenda{}
+	mi := &file_wire_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnstructuredAddenda) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnstructuredAddenda) ProtoMessage() {}
+
+func (x *UnstructuredAddenda) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnstructuredAddenda.ProtoReflect.Descriptor instead.
+func (*UnstructuredAddenda) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UnstructuredAddenda) GetAddendaLength() string {
+	if x != nil {
+		return x.AddendaLength
+	}
+	return ""
+}
+
+func (x *UnstructuredAddenda) GetAddenda() string {
+	if x != nil {
+		return x.Addenda
+	}
+	return ""
+}
+
+// RelatedRemittance is related remittance
+type RelatedRemittance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RemittanceIdentification is remittance identification
+	RemittanceIdentification string `protobuf:"bytes,1,opt,name=remittance_identification,json=remittanceIdentification,proto3" json:"remittance_identification,omitempty"`
+	// RemittanceLocationMethod is  remittance location method
+	RemittanceLocationMethod string `protobuf:"bytes,2,opt,name=remittance_location_method,json=remittanceLocationMethod,proto3" json:"remittance_location_method,omitempty"`
+	// RemittanceLocationElectronicAddress (E-mail or URL address)
+	RemittanceLocationElctronicAddress string `protobuf:"bytes,3,opt,name=remittance_location_elctronic_address,json=remittanceLocationElctronicAddress,proto3" json:"remittance_location_elctronic_address,omitempty"`
+	// RemittanceData is RemittanceData
+	RemittanceData *RemittanceData `protobuf:"bytes,4,opt,name=remittance_data,json=remittanceData,proto3" json:"remittance_data,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RelatedRemittance) Reset() {
+	*x = RelatedRemittance{}
+	mi := &file_wire_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedRemittance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedRemittance) ProtoMessage() {}
+
+func (x *RelatedRemittance) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedRemittance.ProtoReflect.Descriptor instead.
+func (*RelatedRemittance) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RelatedRemittance) GetRemittanceIdentification() string {
+	if x != nil {
+		return x.RemittanceIdentification
+	}
+	return ""
+}
+
+func (x *RelatedRemittance) GetRemittanceLocationMethod() string {
+	if x != nil {
+		return x.RemittanceLocationMethod
+	}
+	return ""
+}
+
+func (x *RelatedRemittance) GetRemittanceLocationElctronicAddress() string {
+	if x != nil {
+		return x.RemittanceLocationElctronicAddress
+	}
+	return ""
+}
+
+func (x *RelatedRemittance) GetRemittanceData() *RemittanceData {
+	if x != nil {
+		return x.RemittanceData
+	}
+	return nil
+}
+
+// RemittanceOriginator is remittance originator
+type RemittanceOriginator struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IdentificationType is identification type
+	IdentificationType string `protobuf:"bytes,1,opt,name=identification_type,json=identificationType,proto3" json:"identification_type,omitempty"`
+	// IdentificationCode  Organization Identification Codes  * `BANK` - Bank Party Identification * `CUST` - Customer Number * `DUNS` - Data Universal Number System (Dun & Bradstreet) * `EMPL` - Employer Identification Number * `GS1G` - Global Location Number * `PROP` - Proprietary Identification Number * `SWBB` - SWIFT BIC or BEI * `TXID` - Tax Identification Number  Private Identification Codes  * `ARNU` - Alien Registration Number * `CCPT` - Passport Number * `CUST` - Customer Number * `DPOB` - Date & Place of Birth * `DRLC` - Driver’s License Number * `EMPL` - Employee Identification Number * `NIDN` - National Identity Number * `PROP` - Proprietary Identification Number * `SOSE` - Social Security Number * `TXID` - Tax Identification Number
+	IdentificationCode         string          `protobuf:"bytes,2,opt,name=identification_code,json=identificationCode,proto3" json:"identification_code,omitempty"`
+	IdentificationNumber       string          `protobuf:"bytes,3,opt,name=identification_number,json=identificationNumber,proto3" json:"identification_number,omitempty"`
+	IdentificationNumberIssuer string          `protobuf:"bytes,4,opt,name=identification_number_issuer,json=identificationNumberIssuer,proto3" json:"identification_number_issuer,omitempty"`
+	RemittanceData             *RemittanceData `protobuf:"bytes,5,opt,name=remittance_data,json=remittanceData,proto3" json:"remittance_data,omitempty"`
+	ContactName                string          `protobuf:"bytes,6,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	ContactPhoneNumber         string          `protobuf:"bytes,7,opt,name=contact_phone_number,json=contactPhoneNumber,proto3" json:"contact_phone_number,omitempty"`
+	ContactMobileNumber        string          `protobuf:"bytes,8,opt,name=contact_mobile_number,json=contactMobileNumber,proto3" json:"contact_mobile_number,omitempty"`
+	ContactFaxNumber           string          `protobuf:"bytes,9,opt,name=contact_fax_number,json=contactFaxNumber,proto3" json:"contact_fax_number,omitempty"`
+	// ContactElectronicAddress ( i.e., E-mail or URL address)
+	ContactElectronicAddress string `protobuf:"bytes,10,opt,name=contact_electronic_address,json=contactElectronicAddress,proto3" json:"contact_electronic_address,omitempty"`
+	ContactOther             string `protobuf:"bytes,11,opt,name=contact_other,json=contactOther,proto3" json:"contact_other,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *RemittanceOriginator) Reset() {
+	*x = RemittanceOriginator{}
+	mi := &file_wire_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemittanceOriginator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemittanceOriginator) ProtoMessage() {}
+
+func (x *RemittanceOriginator) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemittanceOriginator.ProtoReflect.Descriptor instead.
+func (*RemittanceOriginator) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RemittanceOriginator) GetIdentificationType() string {
+	if x != nil {
+		return x.IdentificationType
+	}
+	return ""
+}
+
+func (x *RemittanceOriginator) GetIdentificationCode() string {
+	if x != nil {
+		return x.IdentificationCode
+	}
+	return ""
+}
+
+func (x *RemittanceOriginator) GetIdentificationNumber() string {
+	if x != nil {
+		return x.IdentificationNumber
+	}
+	return ""
+}
+
+func (x *RemittanceOriginator) GetIdentificationNumberIssuer() string {
+	if x != nil {
+		return x.IdentificationNumberIssuer
+	}
+	return ""
+}
+
+func (x *RemittanceOriginator) GetRemittanceData() *RemittanceData {
+	if x != nil {
+		return x.RemittanceData
+	}
+	return nil
+}
+
+func (x *RemittanceOriginator) GetContactName() string {
+	if x != nil {
+		return x.ContactName
+	}
+	return ""
+}
+
+func (x *RemittanceOriginator) GetContactPhoneNumber() string {
+	if x != nil {
+		return x.ContactPhoneNumber
+	}
+	return ""
+}
+
+func (x *RemittanceOriginator) GetContactMobileNumber() string {
+	if x != nil {
+		return x.ContactMobileNumber
+	}
+	return ""
+}
+
+func (x *RemittanceOriginator) GetContactFaxNumber() string {
+	if x != nil {
+		return x.ContactFaxNumber
+	}
+	return ""
+}
+
+func (x *RemittanceOriginator) GetContactElectronicAddress() string {
+	if x != nil {
+		return x.ContactElectronicAddress
+	}
+	return ""
+}
+
+func (x *RemittanceOriginator) GetContactOther() string {
+	if x != nil {
+		return x.ContactOther
+	}
+	return ""
+}
+
+// RemittanceBeneficiary is remittance beneficiary
+type RemittanceBeneficiary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IdentificationType is identification type
+	IdentificationType string `protobuf:"bytes,1,opt,name=identification_type,json=identificationType,proto3" json:"identification_type,omitempty"`
+	// IdentificationCode  Organization Identification Codes  * `BANK` - Bank Party Identification * `CUST` - Customer Number * `DUNS` - Data Universal Number System (Dun & Bradstreet) * `EMPL` - Employer Identification Number * `GS1G` - Global Location Number * `PROP` - Proprietary Identification Number * `SWBB` - SWIFT BIC or BEI * `TXID` - Tax Identification Number  Private Identification Codes  * `ARNU` - Alien Registration Number * `CCPT` - Passport Number * `CUST` - Customer Number * `DPOB` - Date & Place of Birth * `DRLC` - Driver’s License Number * `EMPL` - Employee Identification Number * `NIDN` - National Identity Number * `PROP` - Proprietary Identification Number * `SOSE` - Social Security Number * `TXID` - Tax Identification Number
+	IdentificationCode         string          `protobuf:"bytes,2,opt,name=identification_code,json=identificationCode,proto3" json:"identification_code,omitempty"`
+	IdentificationNumber       string          `protobuf:"bytes,3,opt,name=identification_number,json=identificationNumber,proto3" json:"identification_number,omitempty"`
+	IdentificationNumberIssuer string          `protobuf:"bytes,4,opt,name=identification_number_issuer,json=identificationNumberIssuer,proto3" json:"identification_number_issuer,omitempty"`
+	RemittanceData             *RemittanceData `protobuf:"bytes,5,opt,name=remittance_data,json=remittanceData,proto3" json:"remittance_data,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *RemittanceBeneficiary) Reset() {
+	*x = RemittanceBeneficiary{}
+	mi := &file_wire_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemittanceBeneficiary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemittanceBeneficiary) ProtoMessage() {}
+
+func (x *RemittanceBeneficiary) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemittanceBeneficiary.ProtoReflect.Descriptor instead.
+func (*RemittanceBeneficiary) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RemittanceBeneficiary) GetIdentificationType() string {
+	if x != nil {
+		return x.IdentificationType
+	}
+	return ""
+}
+
+func (x *RemittanceBeneficiary) GetIdentificationCode() string {
+	if x != nil {
+		return x.IdentificationCode
+	}
+	return ""
+}
+
+func (x *RemittanceBeneficiary) GetIdentificationNumber() string {
+	if x != nil {
+		return x.IdentificationNumber
+	}
+	return ""
+}
+
+func (x *RemittanceBeneficiary) GetIdentificationNumberIssuer() string {
+	if x != nil {
+		return x.IdentificationNumberIssuer
+	}
+	return ""
+}
+
+func (x *RemittanceBeneficiary) GetRemittanceData() *RemittanceData {
+	if x != nil {
+		return x.RemittanceData
+	}
+	return nil
+}
+
+// PrimaryRemittanceDocument is primary remittance document
+type PrimaryRemittanceDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DocumentTypeCode  * `AROI` - Accounts Receivable Open Item * `BOLD` - Bill of Lading Shipping Notice * `CINV` - Commercial Invoice * `CMCN` - Commercial Contract * `CNFA` - Credit Note Related to Financial Adjustment * `CREN` - Credit Note * `DEBN` - Debit Note * `DISP` - Dispatch Advice * `DNFA` - Debit Note Related to Financial Adjustment HIRI Hire Invoice * `MSIN` - Metered Service Invoice * `PROP` - Proprietary Document Type * `PUOR` - Purchase Order * `SBIN` - Self Billed Invoice * `SOAC` - Statement of Account * `TSUT` - Trade Services Utility Transaction VCHR Voucher
+	DocumentTypeCode             string `protobuf:"bytes,1,opt,name=document_type_code,json=documentTypeCode,proto3" json:"document_type_code,omitempty"`
+	ProprietaryDocumentTypeCode  string `protobuf:"bytes,2,opt,name=proprietary_document_type_code,json=proprietaryDocumentTypeCode,proto3" json:"proprietary_document_type_code,omitempty"`
+	DocumentIdentificationNumber string `protobuf:"bytes,3,opt,name=document_identification_number,json=documentIdentificationNumber,proto3" json:"document_identification_number,omitempty"`
+	Issuer                       string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *PrimaryRemittanceDocument) Reset() {
+	*x = PrimaryRemittanceDocument{}
+	mi := &file_wire_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrimaryRemittanceDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrimaryRemittanceDocument) ProtoMessage() {}
+
+func (x *PrimaryRemittanceDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrimaryRemittanceDocument.ProtoReflect.Descriptor instead.
+func (*PrimaryRemittanceDocument) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PrimaryRemittanceDocument) GetDocumentTypeCode() string {
+	if x != nil {
+		return x.DocumentTypeCode
+	}
+	return ""
+}
+
+func (x *PrimaryRemittanceDocument) GetProprietaryDocumentTypeCode() string {
+	if x != nil {
+		return x.ProprietaryDocumentTypeCode
+	}
+	return ""
+}
+
+func (x *PrimaryRemittanceDocument) GetDocumentIdentificationNumber() string {
+	if x != nil {
+		return x.DocumentIdentificationNumber
+	}
+	return ""
+}
+
+func (x *PrimaryRemittanceDocument) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+// ActualAmountPaid is the actual amount paid
+type ActualAmountPaid struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RemittanceAmount is remittance amounts
+	RemittanceAmount *RemittanceAmount `protobuf:"bytes,1,opt,name=remittance_amount,json=remittanceAmount,proto3" json:"remittance_amount,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ActualAmountPaid) Reset() {
+	*x = ActualAmountPaid{}
+	mi := &file_wire_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActualAmountPaid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActualAmountPaid) ProtoMessage() {}
+
+func (x *ActualAmountPaid) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActualAmountPaid.ProtoReflect.Descriptor instead.
+func (*ActualAmountPaid) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ActualAmountPaid) GetRemittanceAmount() *RemittanceAmount {
+	if x != nil {
+		return x.RemittanceAmount
+	}
+	return nil
+}
+
+// GrossAmountRemittanceDocument is the gross amount remittance document
+type GrossAmountRemittanceDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RemittanceAmount is remittance amounts
+	RemittanceAmount *RemittanceAmount `protobuf:"bytes,1,opt,name=remittance_amount,json=remittanceAmount,proto3" json:"remittance_amount,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GrossAmountRemittanceDocument) Reset() {
+	*x = GrossAmountRemittanceDocument{}
+	mi := &file_wire_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrossAmountRemittanceDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrossAmountRemittanceDocument) ProtoMessage() {}
+
+func (x *GrossAmountRemittanceDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrossAmountRemittanceDocument.ProtoReflect.Descriptor instead.
+func (*GrossAmountRemittanceDocument) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GrossAmountRemittanceDocument) GetRemittanceAmount() *RemittanceAmount {
+	if x != nil {
+		return x.RemittanceAmount
+	}
+	return nil
+}
+
+// AmountNegotiatedDiscount is the amount negotiated discount
+type AmountNegotiatedDiscount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RemittanceAmount is remittance amounts
+	RemittanceAmount *RemittanceAmount `protobuf:"bytes,1,opt,name=remittance_amount,json=remittanceAmount,proto3" json:"remittance_amount,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AmountNegotiatedDiscount) Reset() {
+	*x = AmountNegotiatedDiscount{}
+	mi := &file_wire_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmountNegotiatedDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmountNegotiatedDiscount) ProtoMessage() {}
+
+func (x *AmountNegotiatedDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmountNegotiatedDiscount.ProtoReflect.Descriptor instead.
+func (*AmountNegotiatedDiscount) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AmountNegotiatedDiscount) GetRemittanceAmount() *RemittanceAmount {
+	if x != nil {
+		return x.RemittanceAmount
+	}
+	return nil
+}
+
+// Adjustment is adjustment
+type Adjustment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Adjustment  * `01` - Pricing Error * `03` - Extension Error * `04` - Item Not Accepted (Damaged) * `05` - Item Not Accepted (Quality) * `06` - Quantity Contested 07   Incorrect Product * `11` - Returns (Damaged) * `12` - Returns (Quality) * `59` - Item Not Received * `75` - Total Order Not Received * `81` - Credit as Agreed * `CM` - Covered by Credit Memo
+	AdjustmentReasonCode string `protobuf:"bytes,1,opt,name=adjustment_reason_code,json=adjustmentReasonCode,proto3" json:"adjustment_reason_code,omitempty"`
+	// CreditDebitIndicator  * `CRDT` - Credit * `DBIT` - Debit
+	CreditDebitIndicator string `protobuf:"bytes,2,opt,name=credit_debit_indicator,json=creditDebitIndicator,proto3" json:"credit_debit_indicator,omitempty"`
+	// RemittanceAmount is remittance amounts
+	RemittanceAmount *RemittanceAmount `protobuf:"bytes,3,opt,name=remittance_amount,json=remittanceAmount,proto3" json:"remittance_amount,omitempty"`
+	// AdditionalInfo is additional information
+	AdditionalInfo string `protobuf:"bytes,4,opt,name=additional_info,json=additionalInfo,proto3" json:"additional_info,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Adjustment) Reset() {
+	*x = Adjustment{}
+	mi := &file_wire_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Adjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Adjustment) ProtoMessage() {}
+
+func (x *Adjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Adjustment.ProtoReflect.Descriptor instead.
+func (*Adjustment) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Adjustment) GetAdjustmentReasonCode() string {
+	if x != nil {
+		return x.AdjustmentReasonCode
+	}
+	return ""
+}
+
+func (x *Adjustment) GetCreditDebitIndicator() string {
+	if x != nil {
+		return x.CreditDebitIndicator
+	}
+	return ""
+}
+
+func (x *Adjustment) GetRemittanceAmount() *RemittanceAmount {
+	if x != nil {
+		return x.RemittanceAmount
+	}
+	return nil
+}
+
+func (x *Adjustment) GetAdditionalInfo() string {
+	if x != nil {
+		return x.AdditionalInfo
+	}
+	return ""
+}
+
+// DateRemittanceDocument is the date of remittance document
+type DateRemittanceDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DateRemittanceDocument CCYYMMDD
+	DateRemittanceDocument string `protobuf:"bytes,1,opt,name=date_remittance_document,json=dateRemittanceDocument,proto3" json:"date_remittance_document,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DateRemittanceDocument) Reset() {
+	*x = DateRemittanceDocument{}
+	mi := &file_wire_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRemittanceDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRemittanceDocument) ProtoMessage() {}
+
+func (x *DateRemittanceDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRemittanceDocument.ProtoReflect.Descriptor instead.
+func (*DateRemittanceDocument) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DateRemittanceDocument) GetDateRemittanceDocument() string {
+	if x != nil {
+		return x.DateRemittanceDocument
+	}
+	return ""
+}
+
+// SecondaryRemittanceDocument is the date of remittance document
+type SecondaryRemittanceDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DocumentTypeCode  * `AROI` - Accounts Receivable Open Item * `DISP` - Dispatch Advice * `FXDR` - Foreign Exchange Deal Reference * `PROP` - Proprietary Document Type PUOR Purchase Order * `RADM` - Remittance Advice Message * `RPIN` - Related Payment Instruction * `SCOR1` - Structured Communication Reference VCHR Voucher
+	DocumentTypeCode             string `protobuf:"bytes,1,opt,name=document_type_code,json=documentTypeCode,proto3" json:"document_type_code,omitempty"`
+	ProprietaryDocumentTypeCode  string `protobuf:"bytes,2,opt,name=proprietary_document_type_code,json=proprietaryDocumentTypeCode,proto3" json:"proprietary_document_type_code,omitempty"`
+	DocumentIdentificationNumber string `protobuf:"bytes,3,opt,name=document_identification_number,json=documentIdentificationNumber,proto3" json:"document_identification_number,omitempty"`
+	Issuer                       string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *SecondaryRemittanceDocument) Reset() {
+	*x = SecondaryRemittanceDocument{}
+	mi := &file_wire_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecondaryRemittanceDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecondaryRemittanceDocument) ProtoMessage() {}
+
+func (x *SecondaryRemittanceDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecondaryRemittanceDocument.ProtoReflect.Descriptor instead.
+func (*SecondaryRemittanceDocument) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SecondaryRemittanceDocument) GetDocumentTypeCode() string {
+	if x != nil {
+		return x.DocumentTypeCode
+	}
+	return ""
+}
+
+func (x *SecondaryRemittanceDocument) GetProprietaryDocumentTypeCode() string {
+	if x != nil {
+		return x.ProprietaryDocumentTypeCode
+	}
+	return ""
+}
+
+func (x *SecondaryRemittanceDocument) GetDocumentIdentificationNumber() string {
+	if x != nil {
+		return x.DocumentIdentificationNumber
+	}
+	return ""
+}
+
+func (x *SecondaryRemittanceDocument) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+// RemittanceFreeText is the remittance free text
+type RemittanceFreeText struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineOne       string                 `protobuf:"bytes,1,opt,name=line_one,json=lineOne,proto3" json:"line_one,omitempty"`
+	LineTwo       string                 `protobuf:"bytes,2,opt,name=line_two,json=lineTwo,proto3" json:"line_two,omitempty"`
+	LineThree     string                 `protobuf:"bytes,3,opt,name=line_three,json=lineThree,proto3" json:"line_three,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemittanceFreeText) Reset() {
+	*x = RemittanceFreeText{}
+	mi := &file_wire_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemittanceFreeText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemittanceFreeText) ProtoMessage() {}
+
+func (x *RemittanceFreeText) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemittanceFreeText.ProtoReflect.Descriptor instead.
+func (*RemittanceFreeText) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RemittanceFreeText) GetLineOne() string {
+	if x != nil {
+		return x.LineOne
+	}
+	return ""
+}
+
+func (x *RemittanceFreeText) GetLineTwo() string {
+	if x != nil {
+		return x.LineTwo
+	}
+	return ""
+}
+
+func (x *RemittanceFreeText) GetLineThree() string {
+	if x != nil {
+		return x.LineThree
+	}
+	return ""
+}
+
+// ServiceMessage is the ServiceMessage of the wire
+type ServiceMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineOne       string                 `protobuf:"bytes,1,opt,name=line_one,json=lineOne,proto3" json:"line_one,omitempty"`
+	LineTwo       string                 `protobuf:"bytes,2,opt,name=line_two,json=lineTwo,proto3" json:"line_two,omitempty"`
+	LineThree     string                 `protobuf:"bytes,3,opt,name=line_three,json=lineThree,proto3" json:"line_three,omitempty"`
+	LineFour      string                 `protobuf:"bytes,4,opt,name=line_four,json=lineFour,proto3" json:"line_four,omitempty"`
+	LineFive      string                 `protobuf:"bytes,5,opt,name=line_five,json=lineFive,proto3" json:"line_five,omitempty"`
+	LineSix       string                 `protobuf:"bytes,6,opt,name=line_six,json=lineSix,proto3" json:"line_six,omitempty"`
+	LineSeven     string                 `protobuf:"bytes,7,opt,name=line_seven,json=lineSeven,proto3" json:"line_seven,omitempty"`
+	LineEight     string                 `protobuf:"bytes,8,opt,name=line_eight,json=lineEight,proto3" json:"line_eight,omitempty"`
+	LineNine      string                 `protobuf:"bytes,9,opt,name=line_nine,json=lineNine,proto3" json:"line_nine,omitempty"`
+	LineTen       string                 `protobuf:"bytes,10,opt,name=line_ten,json=lineTen,proto3" json:"line_ten,omitempty"`
+	LineEleven    string                 `protobuf:"bytes,11,opt,name=line_eleven,json=lineEleven,proto3" json:"line_eleven,omitempty"`
+	LineTwelve    string                 `protobuf:"bytes,12,opt,name=line_twelve,json=lineTwelve,proto3" json:"line_twelve,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceMessage) Reset() {
+	*x = ServiceMessage{}
+	mi := &file_wire_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceMessage) ProtoMessage() {}
+
+func (x *ServiceMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceMessage.ProtoReflect.Descriptor instead.
+func (*ServiceMessage) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ServiceMessage) GetLineOne() string {
+	if x != nil {
+		return x.LineOne
+	}
+	return ""
+}
+
+func (x *ServiceMessage) GetLineTwo() string {
+	if x != nil {
+		return x.LineTwo
+	}
+	return ""
+}
+
+func (x *ServiceMessage) GetLineThree() string {
+	if x != nil {
+		return x.LineThree
+	}
+	return ""
+}
+
+func (x *ServiceMessage) GetLineFour() string {
+	if x != nil {
+		return x.LineFour
+	}
+	return ""
+}
+
+func (x *ServiceMessage) GetLineFive() string {
+	if x != nil {
+		return x.LineFive
+	}
+	return ""
+}
+
+func (x *ServiceMessage) GetLineSix() string {
+	if x != nil {
+		return x.LineSix
+	}
+	return ""
+}
+
+func (x *ServiceMessage) GetLineSeven() string {
+	if x != nil {
+		return x.LineSeven
+	}
+	return ""
+}
+
+func (x *ServiceMessage) GetLineEight() string {
+	if x != nil {
+		return x.LineEight
+	}
+	return ""
+}
+
+func (x *ServiceMessage) GetLineNine() string {
+	if x != nil {
+		return x.LineNine
+	}
+	return ""
+}
+
+func (x *ServiceMessage) GetLineTen() string {
+	if x != nil {
+		return x.LineTen
+	}
+	return ""
+}
+
+func (x *ServiceMessage) GetLineEleven() string {
+	if x != nil {
+		return x.LineEleven
+	}
+	return ""
+}
+
+func (x *ServiceMessage) GetLineTwelve() string {
+	if x != nil {
+		return x.LineTwelve
+	}
+	return ""
+}
+
+// ValidateOpts contains specific overrides from the default set of validations
+type ValidateOpts struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SkipMandatoryIMAD skips checking that InputMessageAccountabilityData is mandatory tag.
+	SkipMandatoryImad bool `protobuf:"varint,1,opt,name=skip_mandatory_imad,json=skipMandatoryIMAD,proto3" json:"skip_mandatory_imad,omitempty"`
+	// AllowMissingSenderSupplied allows the senderSupplied field to be omitted.
+	AllowMissingSenderSupplied bool `protobuf:"varint,2,opt,name=allow_missing_sender_supplied,json=allowMissingSenderSupplied,proto3" json:"allow_missing_sender_supplied,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ValidateOpts) Reset() {
+	*x = ValidateOpts{}
+	mi := &file_wire_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateOpts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateOpts) ProtoMessage() {}
+
+func (x *ValidateOpts) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateOpts.ProtoReflect.Descriptor instead.
+func (*ValidateOpts) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ValidateOpts) GetSkipMandatoryImad() bool {
+	if x != nil {
+		return x.SkipMandatoryImad
+	}
+	return false
+}
+
+func (x *ValidateOpts) GetAllowMissingSenderSupplied() bool {
+	if x != nil {
+		return x.AllowMissingSenderSupplied
+	}
+	return false
+}
+
+// FinancialInstitution is demographic information for a financial institution
+type FinancialInstitution struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IdentificationCode:  * `B` - SWIFT Bank Identifier Code (BIC) * `C` - CHIPS Participant * `D` - Demand Deposit Account (DDA) Number * `F` - Fed Routing Number * `T` - SWIFT BIC or Bank Entity Identifier (BEI) and Account Number * `U` - CHIPS Identifier
+	IdentificationCode string   `protobuf:"bytes,1,opt,name=identification_code,json=identificationCode,proto3" json:"identification_code,omitempty"`
+	Identifier         string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Name               string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Address            *Address `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FinancialInstitution) Reset() {
+	*x = FinancialInstitution{}
+	mi := &file_wire_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinancialInstitution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinancialInstitution) ProtoMessage() {}
+
+func (x *FinancialInstitution) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinancialInstitution.ProtoReflect.Descriptor instead.
+func (*FinancialInstitution) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *FinancialInstitution) GetIdentificationCode() string {
+	if x != nil {
+		return x.IdentificationCode
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// Personal is personal demographic information
+type Personal struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IdentificationCode:  * `1` - Passport Number * `2` - Tax Identification Number * `3` - Driver’s License Number * `4` - Alien Registration Number * `5` - Corporate Identification * `9` - Other Identification
+	IdentificationCode string   `protobuf:"bytes,1,opt,name=identification_code,json=identificationCode,proto3" json:"identification_code,omitempty"`
+	Identifier         string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Name               string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Address            *Address `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Personal) Reset() {
+	*x = Personal{}
+	mi := &file_wire_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Personal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Personal) ProtoMessage() {}
+
+func (x *Personal) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Personal.ProtoReflect.Descriptor instead.
+func (*Personal) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *Personal) GetIdentificationCode() string {
+	if x != nil {
+		return x.IdentificationCode
+	}
+	return ""
+}
+
+func (x *Personal) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *Personal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Personal) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// Address is 3 lines of address information
+type Address struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AddressLineOne   string                 `protobuf:"bytes,1,opt,name=address_line_one,json=addressLineOne,proto3" json:"address_line_one,omitempty"`
+	AddressLineTwo   string                 `protobuf:"bytes,2,opt,name=address_line_two,json=addressLineTwo,proto3" json:"address_line_two,omitempty"`
+	AddressLineThree string                 `protobuf:"bytes,3,opt,name=address_line_three,json=addressLineThree,proto3" json:"address_line_three,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_wire_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Address) GetAddressLineOne() string {
+	if x != nil {
+		return x.AddressLineOne
+	}
+	return ""
+}
+
+func (x *Address) GetAddressLineTwo() string {
+	if x != nil {
+		return x.AddressLineTwo
+	}
+	return ""
+}
+
+func (x *Address) GetAddressLineThree() string {
+	if x != nil {
+		return x.AddressLineThree
+	}
+	return ""
+}
+
+// FIToFI is financial institution to financial institution
+type FIToFI struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineOne       string                 `protobuf:"bytes,1,opt,name=line_one,json=lineOne,proto3" json:"line_one,omitempty"`
+	LineTwo       string                 `protobuf:"bytes,2,opt,name=line_two,json=lineTwo,proto3" json:"line_two,omitempty"`
+	LineThree     string                 `protobuf:"bytes,3,opt,name=line_three,json=lineThree,proto3" json:"line_three,omitempty"`
+	LineFour      string                 `protobuf:"bytes,4,opt,name=line_four,json=lineFour,proto3" json:"line_four,omitempty"`
+	LineFive      string                 `protobuf:"bytes,5,opt,name=line_five,json=lineFive,proto3" json:"line_five,omitempty"`
+	LineSix       string                 `protobuf:"bytes,6,opt,name=line_six,json=lineSix,proto3" json:"line_six,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FIToFI) Reset() {
+	*x = FIToFI{}
+	mi := &file_wire_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FIToFI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FIToFI) ProtoMessage() {}
+
+func (x *FIToFI) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FIToFI.ProtoReflect.Descriptor instead.
+func (*FIToFI) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *FIToFI) GetLineOne() string {
+	if x != nil {
+		return x.LineOne
+	}
+	return ""
+}
+
+func (x *FIToFI) GetLineTwo() string {
+	if x != nil {
+		return x.LineTwo
+	}
+	return ""
+}
+
+func (x *FIToFI) GetLineThree() string {
+	if x != nil {
+		return x.LineThree
+	}
+	return ""
+}
+
+func (x *FIToFI) GetLineFour() string {
+	if x != nil {
+		return x.LineFour
+	}
+	return ""
+}
+
+func (x *FIToFI) GetLineFive() string {
+	if x != nil {
+		return x.LineFive
+	}
+	return ""
+}
+
+func (x *FIToFI) GetLineSix() string {
+	if x != nil {
+		return x.LineSix
+	}
+	return ""
+}
+
+// Advice is financial institution advice information
+type Advice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdviceCode    string                 `protobuf:"bytes,1,opt,name=advice_code,json=adviceCode,proto3" json:"advice_code,omitempty"`
+	LineOne       string                 `protobuf:"bytes,2,opt,name=line_one,json=lineOne,proto3" json:"line_one,omitempty"`
+	LineTwo       string                 `protobuf:"bytes,3,opt,name=line_two,json=lineTwo,proto3" json:"line_two,omitempty"`
+	LineThree     string                 `protobuf:"bytes,4,opt,name=line_three,json=lineThree,proto3" json:"line_three,omitempty"`
+	LineFour      string                 `protobuf:"bytes,5,opt,name=line_four,json=lineFour,proto3" json:"line_four,omitempty"`
+	LineFive      string                 `protobuf:"bytes,6,opt,name=line_five,json=lineFive,proto3" json:"line_five,omitempty"`
+	LineSix       string                 `protobuf:"bytes,7,opt,name=line_six,json=lineSix,proto3" json:"line_six,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Advice) Reset() {
+	*x = Advice{}
+	mi := &file_wire_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Advice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advice) ProtoMessage() {}
+
+func (x *Advice) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advice.ProtoReflect.Descriptor instead.
+func (*Advice) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Advice) GetAdviceCode() string {
+	if x != nil {
+		return x.AdviceCode
+	}
+	return ""
+}
+
+func (x *Advice) GetLineOne() string {
+	if x != nil {
+		return x.LineOne
+	}
+	return ""
+}
+
+func (x *Advice) GetLineTwo() string {
+	if x != nil {
+		return x.LineTwo
+	}
+	return ""
+}
+
+func (x *Advice) GetLineThree() string {
+	if x != nil {
+		return x.LineThree
+	}
+	return ""
+}
+
+func (x *Advice) GetLineFour() string {
+	if x != nil {
+		return x.LineFour
+	}
+	return ""
+}
+
+func (x *Advice) GetLineFive() string {
+	if x != nil {
+		return x.LineFive
+	}
+	return ""
+}
+
+func (x *Advice) GetLineSix() string {
+	if x != nil {
+		return x.LineSix
+	}
+	return ""
+}
+
+// AdditionalFIToFI is additional financial institution to financial institution information
+type AdditionalFIToFI struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineOne       string                 `protobuf:"bytes,1,opt,name=line_one,json=lineOne,proto3" json:"line_one,omitempty"`
+	LineTwo       string                 `protobuf:"bytes,2,opt,name=line_two,json=lineTwo,proto3" json:"line_two,omitempty"`
+	LineThree     string                 `protobuf:"bytes,3,opt,name=line_three,json=lineThree,proto3" json:"line_three,omitempty"`
+	LineFour      string                 `protobuf:"bytes,4,opt,name=line_four,json=lineFour,proto3" json:"line_four,omitempty"`
+	LineFive      string                 `protobuf:"bytes,5,opt,name=line_five,json=lineFive,proto3" json:"line_five,omitempty"`
+	LineSix       string                 `protobuf:"bytes,6,opt,name=line_six,json=lineSix,proto3" json:"line_six,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdditionalFIToFI) Reset() {
+	*x = AdditionalFIToFI{}
+	mi := &file_wire_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdditionalFIToFI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdditionalFIToFI) ProtoMessage() {}
+
+func (x *AdditionalFIToFI) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdditionalFIToFI.ProtoReflect.Descriptor instead.
+func (*AdditionalFIToFI) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AdditionalFIToFI) GetLineOne() string {
+	if x != nil {
+		return x.LineOne
+	}
+	return ""
+}
+
+func (x *AdditionalFIToFI) GetLineTwo() string {
+	if x != nil {
+		return x.LineTwo
+	}
+	return ""
+}
+
+func (x *AdditionalFIToFI) GetLineThree() string {
+	if x != nil {
+		return x.LineThree
+	}
+	return ""
+}
+
+func (x *AdditionalFIToFI) GetLineFour() string {
+	if x != nil {
+		return x.LineFour
+	}
+	return ""
+}
+
+func (x *AdditionalFIToFI) GetLineFive() string {
+	if x != nil {
+		return x.LineFive
+	}
+	return ""
+}
+
+func (x *AdditionalFIToFI) GetLineSix() string {
+	if x != nil {
+		return x.LineSix
+	}
+	return ""
+}
+
+// CoverPayment is cover payment data
+type CoverPayment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SwiftFieldTag  string                 `protobuf:"bytes,1,opt,name=swift_field_tag,json=swiftFieldTag,proto3" json:"swift_field_tag,omitempty"`
+	SwiftLineOne   string                 `protobuf:"bytes,2,opt,name=swift_line_one,json=swiftLineOne,proto3" json:"swift_line_one,omitempty"`
+	SwiftLineTwo   string                 `protobuf:"bytes,3,opt,name=swift_line_two,json=swiftLineTwo,proto3" json:"swift_line_two,omitempty"`
+	SwiftLineThree string                 `protobuf:"bytes,4,opt,name=swift_line_three,json=swiftLineThree,proto3" json:"swift_line_three,omitempty"`
+	SwiftLineFour  string                 `protobuf:"bytes,5,opt,name=swift_line_four,json=swiftLineFour,proto3" json:"swift_line_four,omitempty"`
+	SwiftLineFive  string                 `protobuf:"bytes,6,opt,name=swift_line_five,json=swiftLineFive,proto3" json:"swift_line_five,omitempty"`
+	SwiftLineSix   string                 `protobuf:"bytes,7,opt,name=swift_line_six,json=swiftLineSix,proto3" json:"swift_line_six,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CoverPayment) Reset() {
+	*x = CoverPayment{}
+	mi := &file_wire_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoverPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoverPayment) ProtoMessage() {}
+
+func (x *CoverPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoverPayment.ProtoReflect.Descriptor instead.
+func (*CoverPayment) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CoverPayment) GetSwiftFieldTag() string {
+	if x != nil {
+		return x.SwiftFieldTag
+	}
+	return ""
+}
+
+func (x *CoverPayment) GetSwiftLineOne() string {
+	if x != nil {
+		return x.SwiftLineOne
+	}
+	return ""
+}
+
+func (x *CoverPayment) GetSwiftLineTwo() string {
+	if x != nil {
+		return x.SwiftLineTwo
+	}
+	return ""
+}
+
+func (x *CoverPayment) GetSwiftLineThree() string {
+	if x != nil {
+		return x.SwiftLineThree
+	}
+	return ""
+}
+
+func (x *CoverPayment) GetSwiftLineFour() string {
+	if x != nil {
+		return x.SwiftLineFour
+	}
+	return ""
+}
+
+func (x *CoverPayment) GetSwiftLineFive() string {
+	if x != nil {
+		return x.SwiftLineFive
+	}
+	return ""
+}
+
+func (x *CoverPayment) GetSwiftLineSix() string {
+	if x != nil {
+		return x.SwiftLineSix
+	}
+	return ""
+}
+
+// RemittanceData is remittance data
+type RemittanceData struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DateBirthPlace          string                 `protobuf:"bytes,2,opt,name=date_birth_place,json=dateBirthPlace,proto3" json:"date_birth_place,omitempty"`
+	AddressType             string                 `protobuf:"bytes,3,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	Department              string                 `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
+	SubDepartment           string                 `protobuf:"bytes,5,opt,name=sub_department,json=subDepartment,proto3" json:"sub_department,omitempty"`
+	StreetName              string                 `protobuf:"bytes,6,opt,name=street_name,json=streetName,proto3" json:"street_name,omitempty"`
+	BuildingNumber          string                 `protobuf:"bytes,7,opt,name=building_number,json=buildingNumber,proto3" json:"building_number,omitempty"`
+	PostCode                string                 `protobuf:"bytes,8,opt,name=post_code,json=postCode,proto3" json:"post_code,omitempty"`
+	TownName                string                 `protobuf:"bytes,9,opt,name=town_name,json=townName,proto3" json:"town_name,omitempty"`
+	CountrySubDivisionState string                 `protobuf:"bytes,10,opt,name=country_sub_division_state,json=countrySubDivisionState,proto3" json:"country_sub_division_state,omitempty"`
+	Country                 string                 `protobuf:"bytes,11,opt,name=country,proto3" json:"country,omitempty"`
+	AddressLineOne          string                 `protobuf:"bytes,12,opt,name=address_line_one,json=addressLineOne,proto3" json:"address_line_one,omitempty"`
+	AddressLineTwo          string                 `protobuf:"bytes,13,opt,name=address_line_two,json=addressLineTwo,proto3" json:"address_line_two,omitempty"`
+	AddressLineThree        string                 `protobuf:"bytes,14,opt,name=address_line_three,json=addressLineThree,proto3" json:"address_line_three,omitempty"`
+	AddressLineFour         string                 `protobuf:"bytes,15,opt,name=address_line_four,json=addressLineFour,proto3" json:"address_line_four,omitempty"`
+	AddressLineFive         string                 `protobuf:"bytes,16,opt,name=address_line_five,json=addressLineFive,proto3" json:"address_line_five,omitempty"`
+	AddressLineSix          string                 `protobuf:"bytes,17,opt,name=address_line_six,json=addressLineSix,proto3" json:"address_line_six,omitempty"`
+	AddressLineSeven        string                 `protobuf:"bytes,18,opt,name=address_line_seven,json=addressLineSeven,proto3" json:"address_line_seven,omitempty"`
+	CountryOfResidence      string                 `protobuf:"bytes,19,opt,name=country_of_residence,json=countryOfResidence,proto3" json:"country_of_residence,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RemittanceData) Reset() {
+	*x = RemittanceData{}
+	mi := &file_wire_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemittanceData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemittanceData) ProtoMessage() {}
+
+func (x *RemittanceData) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemittanceData.ProtoReflect.Descriptor instead.
+func (*RemittanceData) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RemittanceData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetDateBirthPlace() string {
+	if x != nil {
+		return x.DateBirthPlace
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetAddressType() string {
+	if x != nil {
+		return x.AddressType
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetSubDepartment() string {
+	if x != nil {
+		return x.SubDepartment
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetStreetName() string {
+	if x != nil {
+		return x.StreetName
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetBuildingNumber() string {
+	if x != nil {
+		return x.BuildingNumber
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetPostCode() string {
+	if x != nil {
+		return x.PostCode
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetTownName() string {
+	if x != nil {
+		return x.TownName
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetCountrySubDivisionState() string {
+	if x != nil {
+		return x.CountrySubDivisionState
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetAddressLineOne() string {
+	if x != nil {
+		return x.AddressLineOne
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetAddressLineTwo() string {
+	if x != nil {
+		return x.AddressLineTwo
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetAddressLineThree() string {
+	if x != nil {
+		return x.AddressLineThree
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetAddressLineFour() string {
+	if x != nil {
+		return x.AddressLineFour
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetAddressLineFive() string {
+	if x != nil {
+		return x.AddressLineFive
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetAddressLineSix() string {
+	if x != nil {
+		return x.AddressLineSix
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetAddressLineSeven() string {
+	if x != nil {
+		return x.AddressLineSeven
+	}
+	return ""
+}
+
+func (x *RemittanceData) GetCountryOfResidence() string {
+	if x != nil {
+		return x.CountryOfResidence
+	}
+	return ""
+}
+
+// RemittanceAmount is remittance amount
+type RemittanceAmount struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Amount Must contain at least one numeric character and only one decimal period marker (e.g., $1,234.56 should be entered as 1234.56). Can have up to 5 numeric characters following the decimal period marker (e.g., 1234.56789). Amount must be greater than zero (i.e., at least .01).
+	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemittanceAmount) Reset() {
+	*x = RemittanceAmount{}
+	mi := &file_wire_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemittanceAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemittanceAmount) ProtoMessage() {}
+
+func (x *RemittanceAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_wire_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemittanceAmount.ProtoReflect.Descriptor instead.
+func (*RemittanceAmount) Descriptor() ([]byte, []int) {
+	return file_wire_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RemittanceAmount) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *RemittanceAmount) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+var File_wire_proto protoreflect.FileDescriptor
+
+const file_wire_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"wire.proto\x12\fmoov.wire.v1\"\xdd\x03\n" +
+	"\x10ListFilesRequest\x124\n" +
+	"\x16business_function_code\x18\x01 \x01(\tR\x14businessFunctionCode\x12\"\n" +
+	"\rtype_sub_type\x18\x02 \x01(\tR\vtypeSubType\x12\"\n" +
+	"\n" +
+	"min_amount\x18\x03 \x01(\x03H\x00R\tminAmount\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_amount\x18\x04 \x01(\x03H\x01R\tmaxAmount\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"sender_aba\x18\x05 \x01(\tR\tsenderABA\x12!\n" +
+	"\freceiver_aba\x18\x06 \x01(\tR\vreceiverABA\x12\x1d\n" +
+	"\n" +
+	"cycle_date\x18\a \x01(\tR\tcycleDate\x12)\n" +
+	"\x10beneficiary_name\x18\b \x01(\tR\x0fbeneficiaryName\x12\x19\n" +
+	"\x05valid\x18\t \x01(\bH\x02R\x05valid\x88\x01\x01\x12\x12\n" +
+	"\x04sort\x18\n" +
+	" \x01(\tR\x04sort\x12\x16\n" +
+	"\x06cursor\x18\v \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\f \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06masked\x18\r \x01(\bR\x06maskedB\r\n" +
+	"\v_min_amountB\r\n" +
+	"\v_max_amountB\b\n" +
+	"\x06_valid\"t\n" +
+	"\x11ListFilesResponse\x12(\n" +
+	"\x05files\x18\x01 \x03(\v2\x12.moov.wire.v1.FileR\x05files\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xac\x01\n" +
+	"\x11CreateFileRequest\x12(\n" +
+	"\x04file\x18\x01 \x01(\v2\x12.moov.wire.v1.FileH\x00R\x04file\x12\x1c\n" +
+	"\bcontents\x18\x02 \x01(\tH\x00R\bcontents\x12E\n" +
+	"\x10validate_options\x18\x03 \x01(\v2\x1a.moov.wire.v1.ValidateOptsR\x0fvalidateOptionsB\b\n" +
+	"\x06source\"8\n" +
+	"\x0eGetFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06masked\x18\x02 \x01(\bR\x06masked\"#\n" +
+	"\x11DeleteFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteFileResponse\"}\n" +
+	"\x16GetFileContentsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x16variable_length_fields\x18\x02 \x01(\bR\x14variableLengthFields\x12\x1d\n" +
+	"\n" +
+	"no_newline\x18\x03 \x01(\bR\tnoNewline\"5\n" +
+	"\x17GetFileContentsResponse\x12\x1a\n" +
+	"\bcontents\x18\x01 \x01(\tR\bcontents\"%\n" +
+	"\x13ValidateFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\",\n" +
+	"\x14ValidateFileResponse\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\"r\n" +
+	"\x18AddFEDWireMessageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12F\n" +
+	"\x10fed_wire_message\x18\x02 \x01(\v2\x1c.moov.wire.v1.FEDWireMessageR\x0efedWireMessage\"\xaa\x01\n" +
+	"\x0fValidateRequest\x12(\n" +
+	"\x04file\x18\x01 \x01(\v2\x12.moov.wire.v1.FileH\x00R\x04file\x12\x1c\n" +
+	"\bcontents\x18\x02 \x01(\tH\x00R\bcontents\x12E\n" +
+	"\x10validate_options\x18\x03 \x01(\v2\x1a.moov.wire.v1.ValidateOptsR\x0fvalidateOptionsB\b\n" +
+	"\x06source\"_\n" +
+	"\x10ValidateResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x125\n" +
+	"\x06errors\x18\x02 \x03(\v2\x1d.moov.wire.v1.ValidationErrorR\x06errors\"m\n" +
+	"\x0fValidationError\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xee\x01\n" +
+	"\x0eConvertRequest\x12(\n" +
+	"\x04file\x18\x01 \x01(\v2\x12.moov.wire.v1.FileH\x00R\x04file\x12\x1c\n" +
+	"\bcontents\x18\x02 \x01(\tH\x00R\bcontents\x12E\n" +
+	"\x10validate_options\x18\x03 \x01(\v2\x1a.moov.wire.v1.ValidateOptsR\x0fvalidateOptions\x12$\n" +
+	"\x02to\x18\x04 \x01(\x0e2\x14.moov.wire.v1.FormatR\x02to\x12\x1d\n" +
+	"\n" +
+	"no_newline\x18\x05 \x01(\bR\tnoNewlineB\b\n" +
+	"\x06source\"c\n" +
+	"\x0fConvertResponse\x12(\n" +
+	"\x04file\x18\x01 \x01(\v2\x12.moov.wire.v1.FileH\x00R\x04file\x12\x1c\n" +
+	"\bcontents\x18\x02 \x01(\tH\x00R\bcontentsB\b\n" +
+	"\x06result\"'\n" +
+	"\x15GetFileHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
+	"\x16GetFileHistoryResponse\x122\n" +
+	"\aentries\x18\x01 \x03(\v2\x18.moov.wire.v1.AuditEntryR\aentries\"\x93\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x1f\n" +
+	"\vauth_method\x18\x06 \x01(\tR\n" +
+	"authMethod\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\tR\ttimestamp\x123\n" +
+	"\achanges\x18\t \x03(\v2\x19.moov.wire.v1.AuditChangeR\achanges\"M\n" +
+	"\vAuditChange\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"(\n" +
+	"\x16GetFileApprovalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x11DecideFileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xfc\x02\n" +
+	"\fFileApproval\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x124\n" +
+	"\x16business_function_code\x18\x04 \x01(\tR\x14businessFunctionCode\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12\x1c\n" +
+	"\tthreshold\x18\x06 \x01(\x03R\tthreshold\x12\x16\n" +
+	"\x06digest\x18\a \x01(\tR\x06digest\x12!\n" +
+	"\fsubmitted_by\x18\b \x01(\tR\vsubmittedBy\x12!\n" +
+	"\fsubmitted_at\x18\t \x01(\tR\vsubmittedAt\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\n" +
+	" \x01(\tR\tdecidedBy\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\v \x01(\tR\tdecidedAt\x12\x16\n" +
+	"\x06reason\x18\f \x01(\tR\x06reason\"^\n" +
+	"\x04File\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12F\n" +
+	"\x10fed_wire_message\x18\x02 \x01(\v2\x1c.moov.wire.v1.FEDWireMessageR\x0efedWireMessage\"\xd3(\n" +
+	"\x0eFEDWireMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12Q\n" +
+	"\x13message_disposition\x18\x02 \x01(\v2 .moov.wire.v1.MessageDispositionR\x12messageDisposition\x12L\n" +
+	"\x12receipt_time_stamp\x18\x03 \x01(\v2\x1e.moov.wire.v1.ReceiptTimeStampR\x10receiptTimeStamp\x12z\n" +
+	"\"output_message_accountability_data\x18\x04 \x01(\v2-.moov.wire.v1.OutputMessageAccountabilityDataR\x1foutputMessageAccountabilityData\x126\n" +
+	"\n" +
+	"error_wire\x18\x05 \x01(\v2\x17.moov.wire.v1.ErrorWireR\terrorWire\x12E\n" +
+	"\x0fsender_supplied\x18\x06 \x01(\v2\x1c.moov.wire.v1.SenderSuppliedR\x0esenderSupplied\x12=\n" +
+	"\rtype_sub_type\x18\a \x01(\v2\x19.moov.wire.v1.TypeSubTypeR\vtypeSubType\x12w\n" +
+	"!input_message_accountability_data\x18\b \x01(\v2,.moov.wire.v1.InputMessageAccountabilityDataR\x1einputMessageAccountabilityData\x12,\n" +
+	"\x06amount\x18\t \x01(\v2\x14.moov.wire.v1.AmountR\x06amount\x12m\n" +
+	"\x1dsender_depository_institution\x18\n" +
+	" \x01(\v2).moov.wire.v1.SenderDepositoryInstitutionR\x1bsenderDepositoryInstitution\x12s\n" +
+	"\x1freceiver_depository_institution\x18\v \x01(\v2+.moov.wire.v1.ReceiverDepositoryInstitutionR\x1dreceiverDepositoryInstitution\x12X\n" +
+	"\x16business_function_code\x18\f \x01(\v2\".moov.wire.v1.BusinessFunctionCodeR\x14businessFunctionCode\x12H\n" +
+	"\x10sender_reference\x18\r \x01(\v2\x1d.moov.wire.v1.SenderReferenceR\x0fsenderReference\x12g\n" +
+	"\x1bprevious_message_identifier\x18\x0e \x01(\v2'.moov.wire.v1.PreviousMessageIdentifierR\x19previousMessageIdentifier\x12H\n" +
+	"\x10local_instrument\x18\x0f \x01(\v2\x1d.moov.wire.v1.LocalInstrumentR\x0flocalInstrument\x12T\n" +
+	"\x14payment_notification\x18\x10 \x01(\v2!.moov.wire.v1.PaymentNotificationR\x13paymentNotification\x12/\n" +
+	"\acharges\x18\x11 \x01(\v2\x15.moov.wire.v1.ChargesR\acharges\x12K\n" +
+	"\x11instructed_amount\x18\x12 \x01(\v2\x1e.moov.wire.v1.InstructedAmountR\x10instructedAmount\x12?\n" +
+	"\rexchange_rate\x18\x13 \x01(\v2\x1a.moov.wire.v1.ExchangeRateR\fexchangeRate\x12g\n" +
+	"\x1bbeneficiary_intermediary_fi\x18\x14 \x01(\v2'.moov.wire.v1.BeneficiaryIntermediaryFIR\x19beneficiaryIntermediaryFI\x12B\n" +
+	"\x0ebeneficiary_fi\x18\x15 \x01(\v2\x1b.moov.wire.v1.BeneficiaryFIR\rbeneficiaryFI\x12;\n" +
+	"\vbeneficiary\x18\x16 \x01(\v2\x19.moov.wire.v1.BeneficiaryR\vbeneficiary\x12W\n" +
+	"\x15beneficiary_reference\x18\x17 \x01(\v2\".moov.wire.v1.BeneficiaryReferenceR\x14beneficiaryReference\x12^\n" +
+	"\x18account_debited_drawdown\x18\x18 \x01(\v2$.moov.wire.v1.AccountDebitedDrawdownR\x16accountDebitedDrawdown\x128\n" +
+	"\n" +
+	"originator\x18\x19 \x01(\v2\x18.moov.wire.v1.OriginatorR\n" +
+	"originator\x12O\n" +
+	"\x13originator_option_f\x18\x1a \x01(\v2\x1f.moov.wire.v1.OriginatorOptionFR\x11originatorOptionF\x12?\n" +
+	"\roriginator_fi\x18\x1b \x01(\v2\x1a.moov.wire.v1.OriginatorFIR\foriginatorFI\x12B\n" +
+	"\x0einstructing_fi\x18\x1c \x01(\v2\x1b.moov.wire.v1.InstructingFIR\rinstructingFI\x12a\n" +
+	"\x19account_credited_drawdown\x18\x1d \x01(\v2%.moov.wire.v1.AccountCreditedDrawdownR\x17accountCreditedDrawdown\x12a\n" +
+	"\x19originator_to_beneficiary\x18\x1e \x01(\v2%.moov.wire.v1.OriginatorToBeneficiaryR\x17originatorToBeneficiary\x12@\n" +
+	"\x0efi_receiver_fi\x18\x1f \x01(\v2\x1a.moov.wire.v1.FIReceiverFIR\ffiReceiverFI\x12r\n" +
+	" fi_drawdown_debit_account_advice\x18  \x01(\v2*.moov.wire.v1.FIDrawdownDebitAccountAdviceR\x1cfiDrawdownDebitAccountAdvice\x12L\n" +
+	"\x12fi_intermediary_fi\x18! \x01(\v2\x1e.moov.wire.v1.FIIntermediaryFIR\x10fiIntermediaryFI\x12_\n" +
+	"\x19fi_intermediary_fi_advice\x18\" \x01(\v2$.moov.wire.v1.FIIntermediaryFIAdviceR\x16fiIntermediaryFIAdvice\x12I\n" +
+	"\x11fi_beneficiary_fi\x18# \x01(\v2\x1d.moov.wire.v1.FIBeneficiaryFIR\x0ffiBeneficiaryFI\x12\\\n" +
+	"\x18fi_beneficiary_fi_advice\x18$ \x01(\v2#.moov.wire.v1.FIBeneficiaryFIAdviceR\x15fiBeneficiaryFIAdvice\x12B\n" +
+	"\x0efi_beneficiary\x18% \x01(\v2\x1b.moov.wire.v1.FIBeneficiaryR\rfiBeneficiary\x12U\n" +
+	"\x15fi_beneficiary_advice\x18& \x01(\v2!.moov.wire.v1.FIBeneficiaryAdviceR\x13fiBeneficiaryAdvice\x12r\n" +
+	" fi_payment_method_to_beneficiary\x18' \x01(\v2*.moov.wire.v1.FIPaymentMethodToBeneficiaryR\x1cfiPaymentMethodToBeneficiary\x12T\n" +
+	"\x16fi_additional_fi_to_fi\x18( \x01(\v2 .moov.wire.v1.FIAdditionalFIToFIR\x12fiAdditionalFiToFi\x12d\n" +
+	"\x1acurrency_instructed_amount\x18) \x01(\v2&.moov.wire.v1.CurrencyInstructedAmountR\x18currencyInstructedAmount\x12K\n" +
+	"\x11ordering_customer\x18* \x01(\v2\x1e.moov.wire.v1.OrderingCustomerR\x10orderingCustomer\x12T\n" +
+	"\x14ordering_institution\x18+ \x01(\v2!.moov.wire.v1.OrderingInstitutionR\x13orderingInstitution\x12`\n" +
+	"\x18intermediary_institution\x18, \x01(\v2%.moov.wire.v1.IntermediaryInstitutionR\x17intermediaryInstitution\x12Q\n" +
+	"\x13institution_account\x18- \x01(\v2 .moov.wire.v1.InstitutionAccountR\x12institutionAccount\x12T\n" +
+	"\x14beneficiary_customer\x18. \x01(\v2!.moov.wire.v1.BeneficiaryCustomerR\x13beneficiaryCustomer\x128\n" +
+	"\n" +
+	"remittance\x18/ \x01(\v2\x18.moov.wire.v1.RemittanceR\n" +
+	"remittance\x12L\n" +
+	"\x12sender_to_receiver\x180 \x01(\v2\x1e.moov.wire.v1.SenderToReceiverR\x10senderToReceiver\x12T\n" +
+	"\x14unstructured_addenda\x181 \x01(\v2!.moov.wire.v1.UnstructuredAddendaR\x13unstructuredAddenda\x12N\n" +
+	"\x12related_remittance\x182 \x01(\v2\x1f.moov.wire.v1.RelatedRemittanceR\x11relatedRemittance\x12W\n" +
+	"\x15remittance_originator\x183 \x01(\v2\".moov.wire.v1.RemittanceOriginatorR\x14remittanceOriginator\x12Z\n" +
+	"\x16remittance_beneficiary\x184 \x01(\v2#.moov.wire.v1.RemittanceBeneficiaryR\x15remittanceBeneficiary\x12g\n" +
+	"\x1bprimary_remittance_document\x185 \x01(\v2'.moov.wire.v1.PrimaryRemittanceDocumentR\x19primaryRemittanceDocument\x12L\n" +
+	"\x12actual_amount_paid\x186 \x01(\v2\x1e.moov.wire.v1.ActualAmountPaidR\x10actualAmountPaid\x12t\n" +
+	" gross_amount_remittance_document\x187 \x01(\v2+.moov.wire.v1.GrossAmountRemittanceDocumentR\x1dgrossAmountRemittanceDocument\x12d\n" +
+	"\x1aamount_negotiated_discount\x188 \x01(\v2&.moov.wire.v1.AmountNegotiatedDiscountR\x18amountNegotiatedDiscount\x128\n" +
+	"\n" +
+	"adjustment\x189 \x01(\v2\x18.moov.wire.v1.AdjustmentR\n" +
+	"adjustment\x12^\n" +
+	"\x18date_remittance_document\x18: \x01(\v2$.moov.wire.v1.DateRemittanceDocumentR\x16dateRemittanceDocument\x12m\n" +
+	"\x1dsecondary_remittance_document\x18; \x01(\v2).moov.wire.v1.SecondaryRemittanceDocumentR\x1bsecondaryRemittanceDocument\x12R\n" +
+	"\x14remittance_free_text\x18< \x01(\v2 .moov.wire.v1.RemittanceFreeTextR\x12remittanceFreeText\x12E\n" +
+	"\x0fservice_message\x18= \x01(\v2\x1c.moov.wire.v1.ServiceMessageR\x0eserviceMessage\x12E\n" +
+	"\x10validate_options\x18> \x01(\v2\x1a.moov.wire.v1.ValidateOptsR\x0fvalidateOptions\"\xe1\x01\n" +
+	"\x12MessageDisposition\x12%\n" +
+	"\x0eformat_version\x18\x01 \x01(\tR\rformatVersion\x120\n" +
+	"\x14test_production_code\x18\x02 \x01(\tR\x12testProductionCode\x128\n" +
+	"\x18message_duplication_code\x18\x03 \x01(\tR\x16messageDuplicationCode\x128\n" +
+	"\x18message_status_indicator\x18\x04 \x01(\tR\x16messageStatusIndicator\"\xa6\x01\n" +
+	"\x10ReceiptTimeStamp\x12!\n" +
+	"\freceipt_date\x18\x01 \x01(\tR\vreceiptDate\x12!\n" +
+	"\freceipt_time\x18\x02 \x01(\tR\vreceiptTime\x12L\n" +
+	"\"receipt_application_identification\x18\x03 \x01(\tR receiptApplicationIdentification\"\xcc\x02\n" +
+	"\x1fOutputMessageAccountabilityData\x12*\n" +
+	"\x11output_cycle_date\x18\x01 \x01(\tR\x0foutputCycleDate\x122\n" +
+	"\x15output_destination_id\x18\x02 \x01(\tR\x13outputDestinationID\x124\n" +
+	"\x16output_sequence_number\x18\x03 \x01(\tR\x14outputSequenceNumber\x12\x1f\n" +
+	"\voutput_date\x18\x04 \x01(\tR\n" +
+	"outputDate\x12\x1f\n" +
+	"\voutput_time\x18\x05 \x01(\tR\n" +
+	"outputTime\x12Q\n" +
+	"%output_frb_application_identification\x18\x06 \x01(\tR\"outputFRBApplicationIdentification\"~\n" +
+	"\tErrorWire\x12%\n" +
+	"\x0eerror_category\x18\x01 \x01(\tR\rerrorCategory\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x02 \x01(\tR\terrorCode\x12+\n" +
+	"\x11error_description\x18\x03 \x01(\tR\x10errorDescription\"\xdd\x01\n" +
+	"\x0eSenderSupplied\x12%\n" +
+	"\x0eformat_version\x18\x01 \x01(\tR\rformatVersion\x128\n" +
+	"\x18user_request_correlation\x18\x02 \x01(\tR\x16userRequestCorrelation\x120\n" +
+	"\x14test_production_code\x18\x03 \x01(\tR\x12testProductionCode\x128\n" +
+	"\x18message_duplication_code\x18\x04 \x01(\tR\x16messageDuplicationCode\"N\n" +
+	"\vTypeSubType\x12\x1b\n" +
+	"\ttype_code\x18\x01 \x01(\tR\btypeCode\x12\"\n" +
+	"\rsub_type_code\x18\x02 \x01(\tR\vsubTypeCode\"\xa1\x01\n" +
+	"\x1eInputMessageAccountabilityData\x12(\n" +
+	"\x10input_cycle_date\x18\x01 \x01(\tR\x0einputCycleDate\x12!\n" +
+	"\finput_source\x18\x02 \x01(\tR\vinputSource\x122\n" +
+	"\x15input_sequence_number\x18\x03 \x01(\tR\x13inputSequenceNumber\" \n" +
+	"\x06Amount\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\tR\x06amount\"u\n" +
+	"\x1bSenderDepositoryInstitution\x12*\n" +
+	"\x11sender_aba_number\x18\x01 \x01(\tR\x0fsenderABANumber\x12*\n" +
+	"\x11sender_short_name\x18\x02 \x01(\tR\x0fsenderShortName\"\x7f\n" +
+	"\x1dReceiverDepositoryInstitution\x12.\n" +
+	"\x13receiver_aba_number\x18\x01 \x01(\tR\x11receiverABANumber\x12.\n" +
+	"\x13receiver_short_name\x18\x02 \x01(\tR\x11receiverShortName\"\x80\x01\n" +
+	"\x14BusinessFunctionCode\x124\n" +
+	"\x16business_function_code\x18\x01 \x01(\tR\x14businessFunctionCode\x122\n" +
+	"\x15transaction_type_code\x18\x02 \x01(\tR\x13transactionTypeCode\"<\n" +
+	"\x0fSenderReference\x12)\n" +
+	"\x10sender_reference\x18\x01 \x01(\tR\x0fsenderReference\"[\n" +
+	"\x19PreviousMessageIdentifier\x12>\n" +
+	"\x1bprevious_message_identifier\x18\x01 \x01(\tR\x19PreviousMessageIdentifier\"g\n" +
+	"\x0fLocalInstrument\x12)\n" +
+	"\x10local_instrument\x18\x01 \x01(\tR\x0fLocalInstrument\x12)\n" +
+	"\x10proprietary_code\x18\x02 \x01(\tR\x0fproprietaryCode\"\x95\x03\n" +
+	"\x13PaymentNotification\x12D\n" +
+	"\x1epayment_notification_indicator\x18\x01 \x01(\tR\x1cpaymentNotificationIndicator\x12U\n" +
+	"'contact_notification_electronic_address\x18\x02 \x01(\tR$contactNotificationElectronicAddress\x12!\n" +
+	"\fcontact_name\x18\x03 \x01(\tR\vcontactName\x120\n" +
+	"\x14contact_phone_number\x18\x04 \x01(\tR\x12contactPhoneNumber\x122\n" +
+	"\x15contact_mobile_number\x18\x05 \x01(\tR\x13contactMobileNumber\x12\x1d\n" +
+	"\n" +
+	"fax_number\x18\x06 \x01(\tR\tfaxNumber\x129\n" +
+	"\x19end_to_end_identification\x18\a \x01(\tR\x16endToEndIdentification\"\xf6\x01\n" +
+	"\aCharges\x12%\n" +
+	"\x0echarge_details\x18\x01 \x01(\tR\rchargeDetails\x12.\n" +
+	"\x13senders_charges_one\x18\x02 \x01(\tR\x11sendersChargesOne\x12.\n" +
+	"\x13senders_charges_two\x18\x03 \x01(\tR\x11sendersChargesTwo\x122\n" +
+	"\x15senders_charges_three\x18\x04 \x01(\tR\x13sendersChargesThree\x120\n" +
+	"\x14senders_charges_four\x18\x05 \x01(\tR\x12sendersChargesFour\"O\n" +
+	"\x10InstructedAmount\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"3\n" +
+	"\fExchangeRate\x12#\n" +
+	"\rexchange_rate\x18\x01 \x01(\tR\fexchangeRate\"t\n" +
+	"\x19BeneficiaryIntermediaryFI\x12W\n" +
+	"\x15financial_institution\x18\x01 \x01(\v2\".moov.wire.v1.FinancialInstitutionR\x14financialInstitution\"h\n" +
+	"\rBeneficiaryFI\x12W\n" +
+	"\x15financial_institution\x18\x01 \x01(\v2\".moov.wire.v1.FinancialInstitutionR\x14financialInstitution\"A\n" +
+	"\vBeneficiary\x122\n" +
+	"\bpersonal\x18\x01 \x01(\v2\x16.moov.wire.v1.PersonalR\bpersonal\"K\n" +
+	"\x14BeneficiaryReference\x123\n" +
+	"\x15beneficiary_reference\x18\x01 \x01(\tR\x14beneficiaryReference\"\xae\x01\n" +
+	"\x16AccountDebitedDrawdown\x12/\n" +
+	"\x13identification_code\x18\x01 \x01(\tR\x12identificationCode\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x02 \x01(\tR\n" +
+	"identifier\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12/\n" +
+	"\aaddress\x18\x04 \x01(\v2\x15.moov.wire.v1.AddressR\aaddress\"@\n" +
+	"\n" +
+	"Originator\x122\n" +
+	"\bpersonal\x18\x01 \x01(\v2\x16.moov.wire.v1.PersonalR\bpersonal\"\xa7\x01\n" +
+	"\x11OriginatorOptionF\x12)\n" +
+	"\x10party_identifier\x18\x01 \x01(\tR\x0fpartyIdentifier\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bline_one\x18\x03 \x01(\tR\alineOne\x12\x19\n" +
+	"\bline_two\x18\x04 \x01(\tR\alineTwo\x12\x1d\n" +
+	"\n" +
+	"line_three\x18\x05 \x01(\tR\tlineThree\"g\n" +
+	"\fOriginatorFI\x12W\n" +
+	"\x15financial_institution\x18\x01 \x01(\v2\".moov.wire.v1.FinancialInstitutionR\x14financialInstitution\"h\n" +
+	"\rInstructingFI\x12W\n" +
+	"\x15financial_institution\x18\x01 \x01(\v2\".moov.wire.v1.FinancialInstitutionR\x14financialInstitution\"^\n" +
+	"\x17AccountCreditedDrawdown\x12C\n" +
+	"\x1edrawdown_credit_account_number\x18\x01 \x01(\tR\x1bdrawdownCreditAccountNumber\"\x8b\x01\n" +
+	"\x17OriginatorToBeneficiary\x12\x19\n" +
+	"\bline_one\x18\x01 \x01(\tR\alineOne\x12\x19\n" +
+	"\bline_two\x18\x02 \x01(\tR\alineTwo\x12\x1d\n" +
+	"\n" +
+	"line_three\x18\x03 \x01(\tR\tlineThree\x12\x1b\n" +
+	"\tline_four\x18\x04 \x01(\tR\blineFour\">\n" +
+	"\fFIReceiverFI\x12.\n" +
+	"\bfi_to_fi\x18\x01 \x01(\v2\x14.moov.wire.v1.FIToFIR\x06fiToFI\"L\n" +
+	"\x1cFIDrawdownDebitAccountAdvice\x12,\n" +
+	"\x06advice\x18\x01 \x01(\v2\x14.moov.wire.v1.AdviceR\x06advice\"B\n" +
+	"\x10FIIntermediaryFI\x12.\n" +
+	"\bfi_to_fi\x18\x01 \x01(\v2\x14.moov.wire.v1.FIToFIR\x06fiToFI\"F\n" +
+	"\x16FIIntermediaryFIAdvice\x12,\n" +
+	"\x06advice\x18\x01 \x01(\v2\x14.moov.wire.v1.AdviceR\x06advice\"A\n" +
+	"\x0fFIBeneficiaryFI\x12.\n" +
+	"\bfi_to_fi\x18\x01 \x01(\v2\x14.moov.wire.v1.FIToFIR\x06fiToFI\"E\n" +
+	"\x15FIBeneficiaryFIAdvice\x12,\n" +
+	"\x06advice\x18\x01 \x01(\v2\x14.moov.wire.v1.AdviceR\x06advice\"?\n" +
+	"\rFIBeneficiary\x12.\n" +
+	"\bfi_to_fi\x18\x01 \x01(\v2\x14.moov.wire.v1.FIToFIR\x06fiToFI\"C\n" +
+	"\x13FIBeneficiaryAdvice\x12,\n" +
+	"\x06advice\x18\x01 \x01(\v2\x14.moov.wire.v1.AdviceR\x06advice\"e\n" +
+	"\x1cFIPaymentMethodToBeneficiary\x12%\n" +
+	"\x0epayment_method\x18\x01 \x01(\tR\rpaymentMethod\x12\x1e\n" +
+	"\n" +
+	"additional\x18\x02 \x01(\tR\n" +
+	"Additional\"c\n" +
+	"\x12FIAdditionalFIToFI\x12M\n" +
+	"\x13additional_fi_to_fi\x18\x01 \x01(\v2\x1e.moov.wire.v1.AdditionalFIToFIR\x10additionalFiToFi\"Z\n" +
+	"\x18CurrencyInstructedAmount\x12&\n" +
+	"\x0fswift_field_tag\x18\x01 \x01(\tR\rswiftFieldTag\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"S\n" +
+	"\x10OrderingCustomer\x12?\n" +
+	"\rcover_payment\x18\x01 \x01(\v2\x1a.moov.wire.v1.CoverPaymentR\fcoverPayment\"V\n" +
+	"\x13OrderingInstitution\x12?\n" +
+	"\rcover_payment\x18\x01 \x01(\v2\x1a.moov.wire.v1.CoverPaymentR\fcoverPayment\"Z\n" +
+	"\x17IntermediaryInstitution\x12?\n" +
+	"\rcover_payment\x18\x01 \x01(\v2\x1a.moov.wire.v1.CoverPaymentR\fcoverPayment\"U\n" +
+	"\x12InstitutionAccount\x12?\n" +
+	"\rcover_payment\x18\x01 \x01(\v2\x1a.moov.wire.v1.CoverPaymentR\fcoverPayment\"V\n" +
+	"\x13BeneficiaryCustomer\x12?\n" +
+	"\rcover_payment\x18\x01 \x01(\v2\x1a.moov.wire.v1.CoverPaymentR\fcoverPayment\"M\n" +
+	"\n" +
+	"Remittance\x12?\n" +
+	"\rcover_payment\x18\x01 \x01(\v2\x1a.moov.wire.v1.CoverPaymentR\fcoverPayment\"S\n" +
+	"\x10SenderToReceiver\x12?\n" +
+	"\rcover_payment\x18\x01 \x01(\v2\x1a.moov.wire.v1.CoverPaymentR\fcoverPayment\"V\n" +
+	"\x13UnstructuredAddenda\x12%\n" +
+	"\x0eaddenda_length\x18\x01 \x01(\tR\raddendaLength\x12\x18\n" +
+	"\aaddenda\x18\x02 \x01(\tR\aaddenda\"\xa8\x02\n" +
+	"\x11RelatedRemittance\x12;\n" +
+	"\x19remittance_identification\x18\x01 \x01(\tR\x18remittanceIdentification\x12<\n" +
+	"\x1aremittance_location_method\x18\x02 \x01(\tR\x18remittanceLocationMethod\x12Q\n" +
+	"%remittance_location_elctronic_address\x18\x03 \x01(\tR\"remittanceLocationElctronicAddress\x12E\n" +
+	"\x0fremittance_data\x18\x04 \x01(\v2\x1c.moov.wire.v1.RemittanceDataR\x0eremittanceData\"\xd0\x04\n" +
+	"\x14RemittanceOriginator\x12/\n" +
+	"\x13identification_type\x18\x01 \x01(\tR\x12identificationType\x12/\n" +
+	"\x13identification_code\x18\x02 \x01(\tR\x12identificationCode\x123\n" +
+	"\x15identification_number\x18\x03 \x01(\tR\x14identificationNumber\x12@\n" +
+	"\x1cidentification_number_issuer\x18\x04 \x01(\tR\x1aidentificationNumberIssuer\x12E\n" +
+	"\x0fremittance_data\x18\x05 \x01(\v2\x1c.moov.wire.v1.RemittanceDataR\x0eremittanceData\x12!\n" +
+	"\fcontact_name\x18\x06 \x01(\tR\vcontactName\x120\n" +
+	"\x14contact_phone_number\x18\a \x01(\tR\x12contactPhoneNumber\x122\n" +
+	"\x15contact_mobile_number\x18\b \x01(\tR\x13contactMobileNumber\x12,\n" +
+	"\x12contact_fax_number\x18\t \x01(\tR\x10contactFaxNumber\x12<\n" +
+	"\x1acontact_electronic_address\x18\n" +
+	" \x01(\tR\x18contactElectronicAddress\x12#\n" +
+	"\rcontact_other\x18\v \x01(\tR\fcontactOther\"\xb7\x02\n" +
+	"\x15RemittanceBeneficiary\x12/\n" +
+	"\x13identification_type\x18\x01 \x01(\tR\x12identificationType\x12/\n" +
+	"\x13identification_code\x18\x02 \x01(\tR\x12identificationCode\x123\n" +
+	"\x15identification_number\x18\x03 \x01(\tR\x14identificationNumber\x12@\n" +
+	"\x1cidentification_number_issuer\x18\x04 \x01(\tR\x1aidentificationNumberIssuer\x12E\n" +
+	"\x0fremittance_data\x18\x05 \x01(\v2\x1c.moov.wire.v1.RemittanceDataR\x0eremittanceData\"\xec\x01\n" +
+	"\x19PrimaryRemittanceDocument\x12,\n" +
+	"\x12document_type_code\x18\x01 \x01(\tR\x10documentTypeCode\x12C\n" +
+	"\x1eproprietary_document_type_code\x18\x02 \x01(\tR\x1bproprietaryDocumentTypeCode\x12D\n" +
+	"\x1edocument_identification_number\x18\x03 \x01(\tR\x1cdocumentIdentificationNumber\x12\x16\n" +
+	"\x06issuer\x18\x04 \x01(\tR\x06issuer\"_\n" +
+	"\x10ActualAmountPaid\x12K\n" +
+	"\x11remittance_amount\x18\x01 \x01(\v2\x1e.moov.wire.v1.RemittanceAmountR\x10remittanceAmount\"l\n" +
+	"\x1dGrossAmountRemittanceDocument\x12K\n" +
+	"\x11remittance_amount\x18\x01 \x01(\v2\x1e.moov.wire.v1.RemittanceAmountR\x10remittanceAmount\"g\n" +
+	"\x18AmountNegotiatedDiscount\x12K\n" +
+	"\x11remittance_amount\x18\x01 \x01(\v2\x1e.moov.wire.v1.RemittanceAmountR\x10remittanceAmount\"\xee\x01\n" +
+	"\n" +
+	"Adjustment\x124\n" +
+	"\x16adjustment_reason_code\x18\x01 \x01(\tR\x14adjustmentReasonCode\x124\n" +
+	"\x16credit_debit_indicator\x18\x02 \x01(\tR\x14creditDebitIndicator\x12K\n" +
+	"\x11remittance_amount\x18\x03 \x01(\v2\x1e.moov.wire.v1.RemittanceAmountR\x10remittanceAmount\x12'\n" +
+	"\x0fadditional_info\x18\x04 \x01(\tR\x0eadditionalInfo\"R\n" +
+	"\x16DateRemittanceDocument\x128\n" +
+	"\x18date_remittance_document\x18\x01 \x01(\tR\x16dateRemittanceDocument\"\xee\x01\n" +
+	"\x1bSecondaryRemittanceDocument\x12,\n" +
+	"\x12document_type_code\x18\x01 \x01(\tR\x10documentTypeCode\x12C\n" +
+	"\x1eproprietary_document_type_code\x18\x02 \x01(\tR\x1bproprietaryDocumentTypeCode\x12D\n" +
+	"\x1edocument_identification_number\x18\x03 \x01(\tR\x1cdocumentIdentificationNumber\x12\x16\n" +
+	"\x06issuer\x18\x04 \x01(\tR\x06issuer\"i\n" +
+	"\x12RemittanceFreeText\x12\x19\n" +
+	"\bline_one\x18\x01 \x01(\tR\alineOne\x12\x19\n" +
+	"\bline_two\x18\x02 \x01(\tR\alineTwo\x12\x1d\n" +
+	"\n" +
+	"line_three\x18\x03 \x01(\tR\tlineThree\"\xf2\x02\n" +
+	"\x0eServiceMessage\x12\x19\n" +
+	"\bline_one\x18\x01 \x01(\tR\alineOne\x12\x19\n" +
+	"\bline_two\x18\x02 \x01(\tR\alineTwo\x12\x1d\n" +
+	"\n" +
+	"line_three\x18\x03 \x01(\tR\tlineThree\x12\x1b\n" +
+	"\tline_four\x18\x04 \x01(\tR\blineFour\x12\x1b\n" +
+	"\tline_five\x18\x05 \x01(\tR\blineFive\x12\x19\n" +
+	"\bline_six\x18\x06 \x01(\tR\alineSix\x12\x1d\n" +
+	"\n" +
+	"line_seven\x18\a \x01(\tR\tlineSeven\x12\x1d\n" +
+	"\n" +
+	"line_eight\x18\b \x01(\tR\tlineEight\x12\x1b\n" +
+	"\tline_nine\x18\t \x01(\tR\blineNine\x12\x19\n" +
+	"\bline_ten\x18\n" +
+	" \x01(\tR\alineTen\x12\x1f\n" +
+	"\vline_eleven\x18\v \x01(\tR\n" +
+	"lineEleven\x12\x1f\n" +
+	"\vline_twelve\x18\f \x01(\tR\n" +
+	"lineTwelve\"\x81\x01\n" +
+	"\fValidateOpts\x12.\n" +
+	"\x13skip_mandatory_imad\x18\x01 \x01(\bR\x11skipMandatoryIMAD\x12A\n" +
+	"\x1dallow_missing_sender_supplied\x18\x02 \x01(\bR\x1aallowMissingSenderSupplied\"\xac\x01\n" +
+	"\x14FinancialInstitution\x12/\n" +
+	"\x13identification_code\x18\x01 \x01(\tR\x12identificationCode\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x02 \x01(\tR\n" +
+	"identifier\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12/\n" +
+	"\aaddress\x18\x04 \x01(\v2\x15.moov.wire.v1.AddressR\aaddress\"\xa0\x01\n" +
+	"\bPersonal\x12/\n" +
+	"\x13identification_code\x18\x01 \x01(\tR\x12identificationCode\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x02 \x01(\tR\n" +
+	"identifier\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12/\n" +
+	"\aaddress\x18\x04 \x01(\v2\x15.moov.wire.v1.AddressR\aaddress\"\x8b\x01\n" +
+	"\aAddress\x12(\n" +
+	"\x10address_line_one\x18\x01 \x01(\tR\x0eaddressLineOne\x12(\n" +
+	"\x10address_line_two\x18\x02 \x01(\tR\x0eaddressLineTwo\x12,\n" +
+	"\x12address_line_three\x18\x03 \x01(\tR\x10addressLineThree\"\xb2\x01\n" +
+	"\x06FIToFI\x12\x19\n" +
+	"\bline_one\x18\x01 \x01(\tR\alineOne\x12\x19\n" +
+	"\bline_two\x18\x02 \x01(\tR\alineTwo\x12\x1d\n" +
+	"\n" +
+	"line_three\x18\x03 \x01(\tR\tlineThree\x12\x1b\n" +
+	"\tline_four\x18\x04 \x01(\tR\blineFour\x12\x1b\n" +
+	"\tline_five\x18\x05 \x01(\tR\blineFive\x12\x19\n" +
+	"\bline_six\x18\x06 \x01(\tR\alineSix\"\xd3\x01\n" +
+	"\x06Advice\x12\x1f\n" +
+	"\vadvice_code\x18\x01 \x01(\tR\n" +
+	"adviceCode\x12\x19\n" +
+	"\bline_one\x18\x02 \x01(\tR\alineOne\x12\x19\n" +
+	"\bline_two\x18\x03 \x01(\tR\alineTwo\x12\x1d\n" +
+	"\n" +
+	"line_three\x18\x04 \x01(\tR\tlineThree\x12\x1b\n" +
+	"\tline_four\x18\x05 \x01(\tR\blineFour\x12\x1b\n" +
+	"\tline_five\x18\x06 \x01(\tR\blineFive\x12\x19\n" +
+	"\bline_six\x18\a \x01(\tR\alineSix\"\xbc\x01\n" +
+	"\x10AdditionalFIToFI\x12\x19\n" +
+	"\bline_one\x18\x01 \x01(\tR\alineOne\x12\x19\n" +
+	"\bline_two\x18\x02 \x01(\tR\alineTwo\x12\x1d\n" +
+	"\n" +
+	"line_three\x18\x03 \x01(\tR\tlineThree\x12\x1b\n" +
+	"\tline_four\x18\x04 \x01(\tR\blineFour\x12\x1b\n" +
+	"\tline_five\x18\x05 \x01(\tR\blineFive\x12\x19\n" +
+	"\bline_six\x18\x06 \x01(\tR\alineSix\"\xa2\x02\n" +
+	"\fCoverPayment\x12&\n" +
+	"\x0fswift_field_tag\x18\x01 \x01(\tR\rswiftFieldTag\x12$\n" +
+	"\x0eswift_line_one\x18\x02 \x01(\tR\fswiftLineOne\x12$\n" +
+	"\x0eswift_line_two\x18\x03 \x01(\tR\fswiftLineTwo\x12(\n" +
+	"\x10swift_line_three\x18\x04 \x01(\tR\x0eswiftLineThree\x12&\n" +
+	"\x0fswift_line_four\x18\x05 \x01(\tR\rswiftLineFour\x12&\n" +
+	"\x0fswift_line_five\x18\x06 \x01(\tR\rswiftLineFive\x12$\n" +
+	"\x0eswift_line_six\x18\a \x01(\tR\fswiftLineSix\"\xf7\x05\n" +
+	"\x0eRemittanceData\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12(\n" +
+	"\x10date_birth_place\x18\x02 \x01(\tR\x0edateBirthPlace\x12!\n" +
+	"\faddress_type\x18\x03 \x01(\tR\vaddressType\x12\x1e\n" +
+	"\n" +
+	"department\x18\x04 \x01(\tR\n" +
+	"department\x12%\n" +
+	"\x0esub_department\x18\x05 \x01(\tR\rsubDepartment\x12\x1f\n" +
+	"\vstreet_name\x18\x06 \x01(\tR\n" +
+	"streetName\x12'\n" +
+	"\x0fbuilding_number\x18\a \x01(\tR\x0ebuildingNumber\x12\x1b\n" +
+	"\tpost_code\x18\b \x01(\tR\bpostCode\x12\x1b\n" +
+	"\ttown_name\x18\t \x01(\tR\btownName\x12;\n" +
+	"\x1acountry_sub_division_state\x18\n" +
+	" \x01(\tR\x17countrySubDivisionState\x12\x18\n" +
+	"\acountry\x18\v \x01(\tR\acountry\x12(\n" +
+	"\x10address_line_one\x18\f \x01(\tR\x0eaddressLineOne\x12(\n" +
+	"\x10address_line_two\x18\r \x01(\tR\x0eaddressLineTwo\x12,\n" +
+	"\x12address_line_three\x18\x0e \x01(\tR\x10addressLineThree\x12*\n" +
+	"\x11address_line_four\x18\x0f \x01(\tR\x0faddressLineFour\x12*\n" +
+	"\x11address_line_five\x18\x10 \x01(\tR\x0faddressLineFive\x12(\n" +
+	"\x10address_line_six\x18\x11 \x01(\tR\x0eaddressLineSix\x12,\n" +
+	"\x12address_line_seven\x18\x12 \x01(\tR\x10addressLineSeven\x120\n" +
+	"\x14country_of_residence\x18\x13 \x01(\tR\x12countryOfResidence\"O\n" +
+	"\x10RemittanceAmount\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount*k\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vFORMAT_JSON\x10\x01\x12\x0e\n" +
+	"\n" +
+	"FORMAT_FED\x10\x02\x12\x13\n" +
+	"\x0fFORMAT_VARIABLE\x10\x03\x12\x13\n" +
+	"\x0fFORMAT_ISO20022\x10\x042\x90\b\n" +
+	"\vWireService\x12L\n" +
+	"\tListFiles\x12\x1e.moov.wire.v1.ListFilesRequest\x1a\x1f.moov.wire.v1.ListFilesResponse\x12A\n" +
+	"\n" +
+	"CreateFile\x12\x1f.moov.wire.v1.CreateFileRequest\x1a\x12.moov.wire.v1.File\x12;\n" +
+	"\aGetFile\x12\x1c.moov.wire.v1.GetFileRequest\x1a\x12.moov.wire.v1.File\x12O\n" +
+	"\n" +
+	"DeleteFile\x12\x1f.moov.wire.v1.DeleteFileRequest\x1a .moov.wire.v1.DeleteFileResponse\x12^\n" +
+	"\x0fGetFileContents\x12$.moov.wire.v1.GetFileContentsRequest\x1a%.moov.wire.v1.GetFileContentsResponse\x12U\n" +
+	"\fValidateFile\x12!.moov.wire.v1.ValidateFileRequest\x1a\".moov.wire.v1.ValidateFileResponse\x12O\n" +
+	"\x11AddFEDWireMessage\x12&.moov.wire.v1.AddFEDWireMessageRequest\x1a\x12.moov.wire.v1.File\x12I\n" +
+	"\bValidate\x12\x1d.moov.wire.v1.ValidateRequest\x1a\x1e.moov.wire.v1.ValidateResponse\x12F\n" +
+	"\aConvert\x12\x1c.moov.wire.v1.ConvertRequest\x1a\x1d.moov.wire.v1.ConvertResponse\x12[\n" +
+	"\x0eGetFileHistory\x12#.moov.wire.v1.GetFileHistoryRequest\x1a$.moov.wire.v1.GetFileHistoryResponse\x12S\n" +
+	"\x0fGetFileApproval\x12$.moov.wire.v1.GetFileApprovalRequest\x1a\x1a.moov.wire.v1.FileApproval\x12J\n" +
+	"\vApproveFile\x12\x1f.moov.wire.v1.DecideFileRequest\x1a\x1a.moov.wire.v1.FileApproval\x12I\n" +
+	"\n" +
+	"RejectFile\x12\x1f.moov.wire.v1.DecideFileRequest\x1a\x1a.moov.wire.v1.FileApprovalB Z\x1egithub.com/moov-io/wire/wirepbb\x06proto3"
+
+var (
+	file_wire_proto_rawDescOnce sync.Once
+	file_wire_proto_rawDescData []byte
+)
+
+func file_wire_proto_rawDescGZIP() []byte {
+	file_wire_proto_rawDescOnce.Do(func() {
+		file_wire_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wire_proto_rawDesc), len(file_wire_proto_rawDesc)))
+	})
+	return file_wire_proto_rawDescData
+}
+
+var file_wire_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_wire_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
+var file_wire_proto_goTypes = []any{
+	(Format)(0),                             // 0: moov.wire.v1.Format
+	(*ListFilesRequest)(nil),                // 1: moov.wire.v1.ListFilesRequest
+	(*ListFilesResponse)(nil),               // 2: moov.wire.v1.ListFilesResponse
+	(*CreateFileRequest)(nil),               // 3: moov.wire.v1.CreateFileRequest
+	(*GetFileRequest)(nil),                  // 4: moov.wire.v1.GetFileRequest
+	(*DeleteFileRequest)(nil),               // 5: moov.wire.v1.DeleteFileRequest
+	(*DeleteFileResponse)(nil),              // 6: moov.wire.v1.DeleteFileResponse
+	(*GetFileContentsRequest)(nil),          // 7: moov.wire.v1.GetFileContentsRequest
+	(*GetFileContentsResponse)(nil),         // 8: moov.wire.v1.GetFileContentsResponse
+	(*ValidateFileRequest)(nil),             // 9: moov.wire.v1.ValidateFileRequest
+	(*ValidateFileResponse)(nil),            // 10: moov.wire.v1.ValidateFileResponse
+	(*AddFEDWireMessageRequest)(nil),        // 11: moov.wire.v1.AddFEDWireMessageRequest
+	(*ValidateRequest)(nil),                 // 12: moov.wire.v1.ValidateRequest
+	(*ValidateResponse)(nil),                // 13: moov.wire.v1.ValidateResponse
+	(*ValidationError)(nil),                 // 14: moov.wire.v1.ValidationError
+	(*ConvertRequest)(nil),                  // 15: moov.wire.v1.ConvertRequest
+	(*ConvertResponse)(nil),                 // 16: moov.wire.v1.ConvertResponse
+	(*GetFileHistoryRequest)(nil),           // 17: moov.wire.v1.GetFileHistoryRequest
+	(*GetFileHistoryResponse)(nil),          // 18: moov.wire.v1.GetFileHistoryResponse
+	(*AuditEntry)(nil),                      // 19: moov.wire.v1.AuditEntry
+	(*AuditChange)(nil),                     // 20: moov.wire.v1.AuditChange
+	(*GetFileApprovalRequest)(nil),          // 21: moov.wire.v1.GetFileApprovalRequest
+	(*DecideFileRequest)(nil),               // 22: moov.wire.v1.DecideFileRequest
+	(*FileApproval)(nil),                    // 23: moov.wire.v1.FileApproval
+	(*File)(nil),                            // 24: moov.wire.v1.File
+	(*FEDWireMessage)(nil),                  // 25: moov.wire.v1.FEDWireMessage
+	(*MessageDisposition)(nil),              // 26: moov.wire.v1.MessageDisposition
+	(*ReceiptTimeStamp)(nil),                // 27: moov.wire.v1.ReceiptTimeStamp
+	(*OutputMessageAccountabilityData)(nil), // 28: moov.wire.v1.OutputMessageAccountabilityData
+	(*ErrorWire)(nil),                       // 29: moov.wire.v1.ErrorWire
+	(*SenderSupplied)(nil),                  // 30: moov.wire.v1.SenderSupplied
+	(*TypeSubType)(nil),                     // 31: moov.wire.v1.TypeSubType
+	(*InputMessageAccountabilityData)(nil),  // 32: moov.wire.v1.InputMessageAccountabilityData
+	(*Amount)(nil),                          // 33: moov.wire.v1.Amount
+	(*SenderDepositoryInstitution)(nil),     // 34: moov.wire.v1.SenderDepositoryInstitution
+	(*ReceiverDepositoryInstitution)(nil),   // 35: moov.wire.v1.ReceiverDepositoryInstitution
+	(*BusinessFunctionCode)(nil),            // 36: moov.wire.v1.BusinessFunctionCode
+	(*SenderReference)(nil),                 // 37: moov.wire.v1.SenderReference
+	(*PreviousMessageIdentifier)(nil),       // 38: moov.wire.v1.PreviousMessageIdentifier
+	(*LocalInstrument)(nil),                 // 39: moov.wire.v1.LocalInstrument
+	(*PaymentNotification)(nil),             // 40: moov.wire.v1.PaymentNotification
+	(*Charges)(nil),                         // 41: moov.wire.v1.Charges
+	(*InstructedAmount)(nil),                // 42: moov.wire.v1.InstructedAmount
+	(*ExchangeRate)(nil),                    // 43: moov.wire.v1.ExchangeRate
+	(*BeneficiaryIntermediaryFI)(nil),       // 44: moov.wire.v1.BeneficiaryIntermediaryFI
+	(*BeneficiaryFI)(nil),                   // 45: moov.wire.v1.BeneficiaryFI
+	(*Beneficiary)(nil),                     // 46: moov.wire.v1.Beneficiary
+	(*BeneficiaryReference)(nil),            // 47: moov.wire.v1.BeneficiaryReference
+	(*AccountDebitedDrawdown)(nil),          // 48: moov.wire.v1.AccountDebitedDrawdown
+	(*Originator)(nil),                      // 49: moov.wire.v1.Originator
+	(*OriginatorOptionF)(nil),               // 50: moov.wire.v1.OriginatorOptionF
+	(*OriginatorFI)(nil),                    // 51: moov.wire.v1.OriginatorFI
+	(*InstructingFI)(nil),                   // 52: moov.wire.v1.InstructingFI
+	(*AccountCreditedDrawdown)(nil),         // 53: moov.wire.v1.AccountCreditedDrawdown
+	(*OriginatorToBeneficiary)(nil),         // 54: moov.wire.v1.OriginatorToBeneficiary
+	(*FIReceiverFI)(nil),                    // 55: moov.wire.v1.FIReceiverFI
+	(*FIDrawdownDebitAccountAdvice)(nil),    // 56: moov.wire.v1.FIDrawdownDebitAccountAdvice
+	(*FIIntermediaryFI)(nil),                // 57: moov.wire.v1.FIIntermediaryFI
+	(*FIIntermediaryFIAdvice)(nil),          // 58: moov.wire.v1.FIIntermediaryFIAdvice
+	(*FIBeneficiaryFI)(nil),                 // 59: moov.wire.v1.FIBeneficiaryFI
+	(*FIBeneficiaryFIAdvice)(nil),           // 60: moov.wire.v1.FIBeneficiaryFIAdvice
+	(*FIBeneficiary)(nil),                   // 61: moov.wire.v1.FIBeneficiary
+	(*FIBeneficiaryAdvice)(nil),             // 62: moov.wire.v1.FIBeneficiaryAdvice
+	(*FIPaymentMethodToBeneficiary)(nil),    // 63: moov.wire.v1.FIPaymentMethodToBeneficiary
+	(*FIAdditionalFIToFI)(nil),              // 64: moov.wire.v1.FIAdditionalFIToFI
+	(*CurrencyInstructedAmount)(nil),        // 65: moov.wire.v1.CurrencyInstructedAmount
+	(*OrderingCustomer)(nil),                // 66: moov.wire.v1.OrderingCustomer
+	(*OrderingInstitution)(nil),             // 67: moov.wire.v1.OrderingInstitution
+	(*IntermediaryInstitution)(nil),         // 68: moov.wire.v1.IntermediaryInstitution
+	(*InstitutionAccount)(nil),              // 69: moov.wire.v1.InstitutionAccount
+	(*BeneficiaryCustomer)(nil),             // 70: moov.wire.v1.BeneficiaryCustomer
+	(*Remittance)(nil),                      // 71: moov.wire.v1.Remittance
+	(*SenderToReceiver)(nil),                // 72: moov.wire.v1.SenderToReceiver
+	(*UnstructuredAddenda)(nil),             // 73: moov.wire.v1.UnstructuredAddenda
+	(*RelatedRemittance)(nil),               // 74: moov.wire.v1.RelatedRemittance
+	(*RemittanceOriginator)(nil),            // 75: moov.wire.v1.RemittanceOriginator
+	(*RemittanceBeneficiary)(nil),           // 76: moov.wire.v1.RemittanceBeneficiary
+	(*PrimaryRemittanceDocument)(nil),       // 77: moov.wire.v1.PrimaryRemittanceDocument
+	(*ActualAmountPaid)(nil),                // 78: moov.wire.v1.ActualAmountPaid
+	(*GrossAmountRemittanceDocument)(nil),   // 79: moov.wire.v1.GrossAmountRemittanceDocument
+	(*AmountNegotiatedDiscount)(nil),        // 80: moov.wire.v1.AmountNegotiatedDiscount
+	(*Adjustment)(nil),                      // 81: moov.wire.v1.Adjustment
+	(*DateRemittanceDocument)(nil),          // 82: moov.wire.v1.DateRemittanceDocument
+	(*SecondaryRemittanceDocument)(nil),     // 83: moov.wire.v1.SecondaryRemittanceDocument
+	(*RemittanceFreeText)(nil),              // 84: moov.wire.v1.RemittanceFreeText
+	(*ServiceMessage)(nil),                  // 85: moov.wire.v1.ServiceMessage
+	(*ValidateOpts)(nil),                    // 86: moov.wire.v1.ValidateOpts
+	(*FinancialInstitution)(nil),            // 87: moov.wire.v1.FinancialInstitution
+	(*Personal)(nil),                        // 88: moov.wire.v1.Personal
+	(*Address)(nil),                         // 89: moov.wire.v1.Address
+	(*FIToFI)(nil),                          // 90: moov.wire.v1.FIToFI
+	(*Advice)(nil),                          // 91: moov.wire.v1.Advice
+	(*AdditionalFIToFI)(nil),                // 92: moov.wire.v1.AdditionalFIToFI
+	(*CoverPayment)(nil),                    // 93: moov.wire.v1.CoverPayment
+	(*RemittanceData)(nil),                  // 94: moov.wire.v1.RemittanceData
+	(*RemittanceAmount)(nil),                // 95: moov.wire.v1.RemittanceAmount
+}
+var file_wire_proto_depIdxs = []int32{
+	24,  // 0: moov.wire.v1.ListFilesResponse.files:type_name -> moov.wire.v1.File
+	24,  // 1: moov.wire.v1.CreateFileRequest.file:type_name -> moov.wire.v1.File
+	86,  // 2: moov.wire.v1.CreateFileRequest.validate_options:type_name -> moov.wire.v1.ValidateOpts
+	25,  // 3: moov.wire.v1.AddFEDWireMessageRequest.fed_wire_message:type_name -> moov.wire.v1.FEDWireMessage
+	24,  // 4: moov.wire.v1.ValidateRequest.file:type_name -> moov.wire.v1.File
+	86,  // 5: moov.wire.v1.ValidateRequest.validate_options:type_name -> moov.wire.v1.ValidateOpts
+	14,  // 6: moov.wire.v1.ValidateResponse.errors:type_name -> moov.wire.v1.ValidationError
+	24,  // 7: moov.wire.v1.ConvertRequest.file:type_name -> moov.wire.v1.File
+	86,  // 8: moov.wire.v1.ConvertRequest.validate_options:type_name -> moov.wire.v1.ValidateOpts
+	0,   // 9: moov.wire.v1.ConvertRequest.to:type_name -> moov.wire.v1.Format
+	24,  // 10: moov.wire.v1.ConvertResponse.file:type_name -> moov.wire.v1.File
+	19,  // 11: moov.wire.v1.GetFileHistoryResponse.entries:type_name -> moov.wire.v1.AuditEntry
+	20,  // 12: moov.wire.v1.AuditEntry.changes:type_name -> moov.wire.v1.AuditChange
+	25,  // 13: moov.wire.v1.File.fed_wire_message:type_name -> moov.wire.v1.FEDWireMessage
+	26,  // 14: moov.wire.v1.FEDWireMessage.message_disposition:type_name -> moov.wire.v1.MessageDisposition
+	27,  // 15: moov.wire.v1.FEDWireMessage.receipt_time_stamp:type_name -> moov.wire.v1.ReceiptTimeStamp
+	28,  // 16: moov.wire.v1.FEDWireMessage.output_message_accountability_data:type_name -> moov.wire.v1.OutputMessageAccountabilityData
+	29,  // 17: moov.wire.v1.FEDWireMessage.error_wire:type_name -> moov.wire.v1.ErrorWire
+	30,  // 18: moov.wire.v1.FEDWireMessage.sender_supplied:type_name -> moov.wire.v1.SenderSupplied
+	31,  // 19: moov.wire.v1.FEDWireMessage.type_sub_type:type_name -> moov.wire.v1.TypeSubType
+	32,  // 20: moov.wire.v1.FEDWireMessage.input_message_accountability_data:type_name -> moov.wire.v1.InputMessageAccountabilityData
+	33,  // 21: moov.wire.v1.FEDWireMessage.amount:type_name -> moov.wire.v1.Amount
+	34,  // 22: moov.wire.v1.FEDWireMessage.sender_depository_institution:type_name -> moov.wire.v1.SenderDepositoryInstitution
+	35,  // 23: moov.wire.v1.FEDWireMessage.receiver_depository_institution:type_name -> moov.wire.v1.ReceiverDepositoryInstitution
+	36,  // 24: moov.wire.v1.FEDWireMessage.business_function_code:type_name -> moov.wire.v1.BusinessFunctionCode
+	37,  // 25: moov.wire.v1.FEDWireMessage.sender_reference:type_name -> moov.wire.v1.SenderReference
+	38,  // 26: moov.wire.v1.FEDWireMessage.previous_message_identifier:type_name -> moov.wire.v1.PreviousMessageIdentifier
+	39,  // 27: moov.wire.v1.FEDWireMessage.local_instrument:type_name -> moov.wire.v1.LocalInstrument
+	40,  // 28: moov.wire.v1.FEDWireMessage.payment_notification:type_name -> moov.wire.v1.PaymentNotification
+	41,  // 29: moov.wire.v1.FEDWireMessage.charges:type_name -> moov.wire.v1.Charges
+	42,  // 30: moov.wire.v1.FEDWireMessage.instructed_amount:type_name -> moov.wire.v1.InstructedAmount
+	43,  // 31: moov.wire.v1.FEDWireMessage.exchange_rate:type_name -> moov.wire.v1.ExchangeRate
+	44,  // 32: moov.wire.v1.FEDWireMessage.beneficiary_intermediary_fi:type_name -> moov.wire.v1.BeneficiaryIntermediaryFI
+	45,  // 33: moov.wire.v1.FEDWireMessage.beneficiary_fi:type_name -> moov.wire.v1.BeneficiaryFI
+	46,  // 34: moov.wire.v1.FEDWireMessage.beneficiary:type_name -> moov.wire.v1.Beneficiary
+	47,  // 35: moov.wire.v1.FEDWireMessage.beneficiary_reference:type_name -> moov.wire.v1.BeneficiaryReference
+	48,  // 36: moov.wire.v1.FEDWireMessage.account_debited_drawdown:type_name -> moov.wire.v1.AccountDebitedDrawdown
+	49,  // 37: moov.wire.v1.FEDWireMessage.originator:type_name -> moov.wire.v1.Originator
+	50,  // 38: moov.wire.v1.FEDWireMessage.originator_option_f:type_name -> moov.wire.v1.OriginatorOptionF
+	51,  // 39: moov.wire.v1.FEDWireMessage.originator_fi:type_name -> moov.wire.v1.OriginatorFI
+	52,  // 40: moov.wire.v1.FEDWireMessage.instructing_fi:type_name -> moov.wire.v1.InstructingFI
+	53,  // 41: moov.wire.v1.FEDWireMessage.account_credited_drawdown:type_name -> moov.wire.v1.AccountCreditedDrawdown
+	54,  // 42: moov.wire.v1.FEDWireMessage.originator_to_beneficiary:type_name -> moov.wire.v1.OriginatorToBeneficiary
+	55,  // 43: moov.wire.v1.FEDWireMessage.fi_receiver_fi:type_name -> moov.wire.v1.FIReceiverFI
+	56,  // 44: moov.wire.v1.FEDWireMessage.fi_drawdown_debit_account_advice:type_name -> moov.wire.v1.FIDrawdownDebitAccountAdvice
+	57,  // 45: moov.wire.v1.FEDWireMessage.fi_intermediary_fi:type_name -> moov.wire.v1.FIIntermediaryFI
+	58,  // 46: moov.wire.v1.FEDWireMessage.fi_intermediary_fi_advice:type_name -> moov.wire.v1.FIIntermediaryFIAdvice
+	59,  // 47: moov.wire.v1.FEDWireMessage.fi_beneficiary_fi:type_name -> moov.wire.v1.FIBeneficiaryFI
+	60,  // 48: moov.wire.v1.FEDWireMessage.fi_beneficiary_fi_advice:type_name -> moov.wire.v1.FIBeneficiaryFIAdvice
+	61,  // 49: moov.wire.v1.FEDWireMessage.fi_beneficiary:type_name -> moov.wire.v1.FIBeneficiary
+	62,  // 50: moov.wire.v1.FEDWireMessage.fi_beneficiary_advice:type_name -> moov.wire.v1.FIBeneficiaryAdvice
+	63,  // 51: moov.wire.v1.FEDWireMessage.fi_payment_method_to_beneficiary:type_name -> moov.wire.v1.FIPaymentMethodToBeneficiary
+	64,  // 52: moov.wire.v1.FEDWireMessage.fi_additional_fi_to_fi:type_name -> moov.wire.v1.FIAdditionalFIToFI
+	65,  // 53: moov.wire.v1.FEDWireMessage.currency_instructed_amount:type_name -> moov.wire.v1.CurrencyInstructedAmount
+	66,  // 54: moov.wire.v1.FEDWireMessage.ordering_customer:type_name -> moov.wire.v1.OrderingCustomer
+	67,  // 55: moov.wire.v1.FEDWireMessage.ordering_institution:type_name -> moov.wire.v1.OrderingInstitution
+	68,  // 56: moov.wire.v1.FEDWireMessage.intermediary_institution:type_name -> moov.wire.v1.IntermediaryInstitution
+	69,  // 57: moov.wire.v1.FEDWireMessage.institution_account:type_name -> moov.wire.v1.InstitutionAccount
+	70,  // 58: moov.wire.v1.FEDWireMessage.beneficiary_customer:type_name -> moov.wire.v1.BeneficiaryCustomer
+	71,  // 59: moov.wire.v1.FEDWireMessage.remittance:type_name -> moov.wire.v1.Remittance
+	72,  // 60: moov.wire.v1.FEDWireMessage.sender_to_receiver:type_name -> moov.wire.v1.SenderToReceiver
+	73,  // 61: moov.wire.v1.FEDWireMessage.unstructured_addenda:type_name -> moov.wire.v1.UnstructuredAddenda
+	74,  // 62: moov.wire.v1.FEDWireMessage.related_remittance:type_name -> moov.wire.v1.RelatedRemittance
+	75,  // 63: moov.wire.v1.FEDWireMessage.remittance_originator:type_name -> moov.wire.v1.RemittanceOriginator
+	76,  // 64: moov.wire.v1.FEDWireMessage.remittance_beneficiary:type_name -> moov.wire.v1.RemittanceBeneficiary
+	77,  // 65: moov.wire.v1.FEDWireMessage.primary_remittance_document:type_name -> moov.wire.v1.PrimaryRemittanceDocument
+	78,  // 66: moov.wire.v1.FEDWireMessage.actual_amount_paid:type_name -> moov.wire.v1.ActualAmountPaid
+	79,  // 67: moov.wire.v1.FEDWireMessage.gross_amount_remittance_document:type_name -> moov.wire.v1.GrossAmountRemittanceDocument
+	80,  // 68: moov.wire.v1.FEDWireMessage.amount_negotiated_discount:type_name -> moov.wire.v1.AmountNegotiatedDiscount
+	81,  // 69: moov.wire.v1.FEDWireMessage.adjustment:type_name -> moov.wire.v1.Adjustment
+	82,  // 70: moov.wire.v1.FEDWireMessage.date_remittance_document:type_name -> moov.wire.v1.DateRemittanceDocument
+	83,  // 71: moov.wire.v1.FEDWireMessage.secondary_remittance_document:type_name -> moov.wire.v1.SecondaryRemittanceDocument
+	84,  // 72: moov.wire.v1.FEDWireMessage.remittance_free_text:type_name -> moov.wire.v1.RemittanceFreeText
+	85,  // 73: moov.wire.v1.FEDWireMessage.service_message:type_name -> moov.wire.v1.ServiceMessage
+	86,  // 74: moov.wire.v1.FEDWireMessage.validate_options:type_name -> moov.wire.v1.ValidateOpts
+	87,  // 75: moov.wire.v1.BeneficiaryIntermediaryFI.financial_institution:type_name -> moov.wire.v1.FinancialInstitution
+	87,  // 76: moov.wire.v1.BeneficiaryFI.financial_institution:type_name -> moov.wire.v1.FinancialInstitution
+	88,  // 77: moov.wire.v1.Beneficiary.personal:type_name -> moov.wire.v1.Personal
+	89,  // 78: moov.wire.v1.AccountDebitedDrawdown.address:type_name -> moov.wire.v1.Address
+	88,  // 79: moov.wire.v1.Originator.personal:type_name -> moov.wire.v1.Personal
+	87,  // 80: moov.wire.v1.OriginatorFI.financial_institution:type_name -> moov.wire.v1.FinancialInstitution
+	87,  // 81: moov.wire.v1.InstructingFI.financial_institution:type_name -> moov.wire.v1.FinancialInstitution
+	90,  // 82: moov.wire.v1.FIReceiverFI.fi_to_fi:type_name -> moov.wire.v1.FIToFI
+	91,  // 83: moov.wire.v1.FIDrawdownDebitAccountAdvice.advice:type_name -> moov.wire.v1.Advice
+	90,  // 84: moov.wire.v1.FIIntermediaryFI.fi_to_fi:type_name -> moov.wire.v1.FIToFI
+	91,  // 85: moov.wire.v1.FIIntermediaryFIAdvice.advice:type_name -> moov.wire.v1.Advice
+	90,  // 86: moov.wire.v1.FIBeneficiaryFI.fi_to_fi:type_name -> moov.wire.v1.FIToFI
+	91,  // 87: moov.wire.v1.FIBeneficiaryFIAdvice.advice:type_name -> moov.wire.v1.Advice
+	90,  // 88: moov.wire.v1.FIBeneficiary.fi_to_fi:type_name -> moov.wire.v1.FIToFI
+	91,  // 89: moov.wire.v1.FIBeneficiaryAdvice.advice:type_name -> moov.wire.v1.Advice
+	92,  // 90: moov.wire.v1.FIAdditionalFIToFI.additional_fi_to_fi:type_name -> moov.wire.v1.AdditionalFIToFI
+	93,  // 91: moov.wire.v1.OrderingCustomer.cover_payment:type_name -> moov.wire.v1.CoverPayment
+	93,  // 92: moov.wire.v1.OrderingInstitution.cover_payment:type_name -> moov.wire.v1.CoverPayment
+	93,  // 93: moov.wire.v1.IntermediaryInstitution.cover_payment:type_name -> moov.wire.v1.CoverPayment
+	93,  // 94: moov.wire.v1.InstitutionAccount.cover_payment:type_name -> moov.wire.v1.CoverPayment
+	93,  // 95: moov.wire.v1.BeneficiaryCustomer.cover_payment:type_name -> moov.wire.v1.CoverPayment
+	93,  // 96: moov.wire.v1.Remittance.cover_payment:type_name -> moov.wire.v1.CoverPayment
+	93,  // 97: moov.wire.v1.SenderToReceiver.cover_payment:type_name -> moov.wire.v1.CoverPayment
+	94,  // 98: moov.wire.v1.RelatedRemittance.remittance_data:type_name -> moov.wire.v1.RemittanceData
+	94,  // 99: moov.wire.v1.RemittanceOriginator.remittance_data:type_name -> moov.wire.v1.RemittanceData
+	94,  // 100: moov.wire.v1.RemittanceBeneficiary.remittance_data:type_name -> moov.wire.v1.RemittanceData
+	95,  // 101: moov.wire.v1.ActualAmountPaid.remittance_amount:type_name -> moov.wire.v1.RemittanceAmount
+	95,  // 102: moov.wire.v1.GrossAmountRemittanceDocument.remittance_amount:type_name -> moov.wire.v1.RemittanceAmount
+	95,  // 103: moov.wire.v1.AmountNegotiatedDiscount.remittance_amount:type_name -> moov.wire.v1.RemittanceAmount
+	95,  // 104: moov.wire.v1.Adjustment.remittance_amount:type_name -> moov.wire.v1.RemittanceAmount
+	89,  // 105: moov.wire.v1.FinancialInstitution.address:type_name -> moov.wire.v1.Address
+	89,  // 106: moov.wire.v1.Personal.address:type_name -> moov.wire.v1.Address
+	1,   // 107: moov.wire.v1.WireService.ListFiles:input_type -> moov.wire.v1.ListFilesRequest
+	3,   // 108: moov.wire.v1.WireService.CreateFile:input_type -> moov.wire.v1.CreateFileRequest
+	4,   // 109: moov.wire.v1.WireService.GetFile:input_type -> moov.wire.v1.GetFileRequest
+	5,   // 110: moov.wire.v1.WireService.DeleteFile:input_type -> moov.wire.v1.DeleteFileRequest
+	7,   // 111: moov.wire.v1.WireService.GetFileContents:input_type -> moov.wire.v1.GetFileContentsRequest
+	9,   // 112: moov.wire.v1.WireService.ValidateFile:input_type -> moov.wire.v1.ValidateFileRequest
+	11,  // 113: moov.wire.v1.WireService.AddFEDWireMessage:input_type -> moov.wire.v1.AddFEDWireMessageRequest
+	12,  // 114: moov.wire.v1.WireService.Validate:input_type -> moov.wire.v1.ValidateRequest
+	15,  // 115: moov.wire.v1.WireService.Convert:input_type -> moov.wire.v1.ConvertRequest
+	17,  // 116: moov.wire.v1.WireService.GetFileHistory:input_type -> moov.wire.v1.GetFileHistoryRequest
+	21,  // 117: moov.wire.v1.WireService.GetFileApproval:input_type -> moov.wire.v1.GetFileApprovalRequest
+	22,  // 118: moov.wire.v1.WireService.ApproveFile:input_type -> moov.wire.v1.DecideFileRequest
+	22,  // 119: moov.wire.v1.WireService.RejectFile:input_type -> moov.wire.v1.DecideFileRequest
+	2,   // 120: moov.wire.v1.WireService.ListFiles:output_type -> moov.wire.v1.ListFilesResponse
+	24,  // 121: moov.wire.v1.WireService.CreateFile:output_type -> moov.wire.v1.File
+	24,  // 122: moov.wire.v1.WireService.GetFile:output_type -> moov.wire.v1.File
+	6,   // 123: moov.wire.v1.WireService.DeleteFile:output_type -> moov.wire.v1.DeleteFileResponse
+	8,   // 124: moov.wire.v1.WireService.GetFileContents:output_type -> moov.wire.v1.GetFileContentsResponse
+	10,  // 125: moov.wire.v1.WireService.ValidateFile:output_type -> moov.wire.v1.ValidateFileResponse
+	24,  // 126: moov.wire.v1.WireService.AddFEDWireMessage:output_type -> moov.wire.v1.File
+	13,  // 127: moov.wire.v1.WireService.Validate:output_type -> moov.wire.v1.ValidateResponse
+	16,  // 128: moov.wire.v1.WireService.Convert:output_type -> moov.wire.v1.ConvertResponse
+	18,  // 129: moov.wire.v1.WireService.GetFileHistory:output_type -> moov.wire.v1.GetFileHistoryResponse
+	23,  // 130: moov.wire.v1.WireService.GetFileApproval:output_type -> moov.wire.v1.FileApproval
+	23,  // 131: moov.wire.v1.WireService.ApproveFile:output_type -> moov.wire.v1.FileApproval
+	23,  // 132: moov.wire.v1.WireService.RejectFile:output_type -> moov.wire.v1.FileApproval
+	120, // [120:133] is the sub-list for method output_type
+	107, // [107:120] is the sub-list for method input_type
+	107, // [107:107] is the sub-list for extension type_name
+	107, // [107:107] is the sub-list for extension extendee
+	0,   // [0:107] is the sub-list for field type_name
+}
+
+func init() { file_wire_proto_init() }
+func file_wire_proto_init() {
+	if File_wire_proto != nil {
+		return
+	}
+	file_wire_proto_msgTypes[0].OneofWrappers = []any{}
+	file_wire_proto_msgTypes[2].OneofWrappers = []any{
+		(*CreateFileRequest_File)(nil),
+		(*CreateFileRequest_Contents)(nil),
+	}
+	file_wire_proto_msgTypes[11].OneofWrappers = []any{
+		(*ValidateRequest_File)(nil),
+		(*ValidateRequest_Contents)(nil),
+	}
+	file_wire_proto_msgTypes[14].OneofWrappers = []any{
+		(*ConvertRequest_File)(nil),
+		(*ConvertRequest_Contents)(nil),
+	}
+	file_wire_proto_msgTypes[15].OneofWrappers = []any{
+		(*ConvertResponse_File)(nil),
+		(*ConvertResponse_Contents)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wire_proto_rawDesc), len(file_wire_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   95,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wire_proto_goTypes,
+		DependencyIndexes: file_wire_proto_depIdxs,
+		EnumInfos:         file_wire_proto_enumTypes,
+		MessageInfos:      file_wire_proto_msgTypes,
+	}.Build()
+	File_wire_proto = out.File
+	file_wire_proto_goTypes = nil
+	file_wire_proto_depIdxs = nil
+}
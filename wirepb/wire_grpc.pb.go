@@ -0,0 +1,630 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// The gRPC API of the Wire server. Messages mirror the JSON of the REST API: each field's JSON name is the one
+// used by the REST API, so files convert between the two without loss.
+//
+// Generate the Go code with `make grpc` after changing this file.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: wire.proto
+
+package wirepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WireService_ListFiles_FullMethodName         = "/moov.wire.v1.WireService/ListFiles"
+	WireService_CreateFile_FullMethodName        = "/moov.wire.v1.WireService/CreateFile"
+	WireService_GetFile_FullMethodName           = "/moov.wire.v1.WireService/GetFile"
+	WireService_DeleteFile_FullMethodName        = "/moov.wire.v1.WireService/DeleteFile"
+	WireService_GetFileContents_FullMethodName   = "/moov.wire.v1.WireService/GetFileContents"
+	WireService_ValidateFile_FullMethodName      = "/moov.wire.v1.WireService/ValidateFile"
+	WireService_AddFEDWireMessage_FullMethodName = "/moov.wire.v1.WireService/AddFEDWireMessage"
+	WireService_Validate_FullMethodName          = "/moov.wire.v1.WireService/Validate"
+	WireService_Convert_FullMethodName           = "/moov.wire.v1.WireService/Convert"
+	WireService_GetFileHistory_FullMethodName    = "/moov.wire.v1.WireService/GetFileHistory"
+	WireService_GetFileApproval_FullMethodName   = "/moov.wire.v1.WireService/GetFileApproval"
+	WireService_ApproveFile_FullMethodName       = "/moov.wire.v1.WireService/ApproveFile"
+	WireService_RejectFile_FullMethodName        = "/moov.wire.v1.WireService/RejectFile"
+)
+
+// WireServiceClient is the client API for WireService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// WireService mirrors the /files, /validate and /convert routes of the REST API, along with the history and
+// approval of files. Errors are returned as gRPC status codes: InvalidArgument for invalid files and requests,
+// NotFound for unknown files, AlreadyExists for duplicate messages, FailedPrecondition for files which can't be
+// approved or rejected as they are, Unimplemented for features the server isn't configured for, Unauthenticated
+// and PermissionDenied for callers which may not make the call.
+//
+// The routes reading and editing single tags (/files/{fileId}/FEDWireMessage/{tag}) and the webhook
+// subscriptions (/webhooks) are only served by the REST API.
+type WireServiceClient interface {
+	// ListFiles returns a page of the files matching the request, as GET /files does
+	ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error)
+	// CreateFile stores a file given as a message or in the FED format, as POST /files/create does
+	CreateFile(ctx context.Context, in *CreateFileRequest, opts ...grpc.CallOption) (*File, error)
+	// GetFile returns a file, as GET /files/{fileId} does
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*File, error)
+	// DeleteFile deletes a file, as DELETE /files/{fileId} does
+	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
+	// GetFileContents returns a file in the FED format, as GET /files/{fileId}/contents does
+	GetFileContents(ctx context.Context, in *GetFileContentsRequest, opts ...grpc.CallOption) (*GetFileContentsResponse, error)
+	// ValidateFile validates a stored file, as GET /files/{fileId}/validate does
+	ValidateFile(ctx context.Context, in *ValidateFileRequest, opts ...grpc.CallOption) (*ValidateFileResponse, error)
+	// AddFEDWireMessage replaces the message of a file, as POST /files/{fileId}/FEDWireMessage does
+	AddFEDWireMessage(ctx context.Context, in *AddFEDWireMessageRequest, opts ...grpc.CallOption) (*File, error)
+	// Validate returns the validation errors of a file without storing it, as POST /validate does
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	// Convert converts a valid file to another format without storing it, as POST /convert does
+	Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertResponse, error)
+	// GetFileHistory returns the audit entries of a file, oldest first, as GET /files/{fileId}/history does
+	GetFileHistory(ctx context.Context, in *GetFileHistoryRequest, opts ...grpc.CallOption) (*GetFileHistoryResponse, error)
+	// GetFileApproval returns the approval state of a file, as GET /files/{fileId}/approval does
+	GetFileApproval(ctx context.Context, in *GetFileApprovalRequest, opts ...grpc.CallOption) (*FileApproval, error)
+	// ApproveFile approves a file pending approval, as POST /files/{fileId}/approve does
+	ApproveFile(ctx context.Context, in *DecideFileRequest, opts ...grpc.CallOption) (*FileApproval, error)
+	// RejectFile rejects a file pending approval, as POST /files/{fileId}/reject does
+	RejectFile(ctx context.Context, in *DecideFileRequest, opts ...grpc.CallOption) (*FileApproval, error)
+}
+
+type wireServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWireServiceClient(cc grpc.ClientConnInterface) WireServiceClient {
+	return &wireServiceClient{cc}
+}
+
+func (c *wireServiceClient) ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFilesResponse)
+	err := c.cc.Invoke(ctx, WireService_ListFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) CreateFile(ctx context.Context, in *CreateFileRequest, opts ...grpc.CallOption) (*File, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(File)
+	err := c.cc.Invoke(ctx, WireService_CreateFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*File, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(File)
+	err := c.cc.Invoke(ctx, WireService_GetFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFileResponse)
+	err := c.cc.Invoke(ctx, WireService_DeleteFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) GetFileContents(ctx context.Context, in *GetFileContentsRequest, opts ...grpc.CallOption) (*GetFileContentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileContentsResponse)
+	err := c.cc.Invoke(ctx, WireService_GetFileContents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) ValidateFile(ctx context.Context, in *ValidateFileRequest, opts ...grpc.CallOption) (*ValidateFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateFileResponse)
+	err := c.cc.Invoke(ctx, WireService_ValidateFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) AddFEDWireMessage(ctx context.Context, in *AddFEDWireMessageRequest, opts ...grpc.CallOption) (*File, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(File)
+	err := c.cc.Invoke(ctx, WireService_AddFEDWireMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, WireService_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertResponse)
+	err := c.cc.Invoke(ctx, WireService_Convert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) GetFileHistory(ctx context.Context, in *GetFileHistoryRequest, opts ...grpc.CallOption) (*GetFileHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileHistoryResponse)
+	err := c.cc.Invoke(ctx, WireService_GetFileHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) GetFileApproval(ctx context.Context, in *GetFileApprovalRequest, opts ...grpc.CallOption) (*FileApproval, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileApproval)
+	err := c.cc.Invoke(ctx, WireService_GetFileApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) ApproveFile(ctx context.Context, in *DecideFileRequest, opts ...grpc.CallOption) (*FileApproval, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileApproval)
+	err := c.cc.Invoke(ctx, WireService_ApproveFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wireServiceClient) RejectFile(ctx context.Context, in *DecideFileRequest, opts ...grpc.CallOption) (*FileApproval, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileApproval)
+	err := c.cc.Invoke(ctx, WireService_RejectFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WireServiceServer is the server API for WireService service.
+// All implementations must embed UnimplementedWireServiceServer
+// for forward compatibility.
+//
+// WireService mirrors the /files, /validate and /convert routes of the REST API, along with the history and
+// approval of files. Errors are returned as gRPC status codes: InvalidArgument for invalid files and requests,
+// NotFound for unknown files, AlreadyExists for duplicate messages, FailedPrecondition for files which can't be
+// approved or rejected as they are, Unimplemented for features the server isn't configured for, Unauthenticated
+// and PermissionDenied for callers which may not make the call.
+//
+// The routes reading and editing single tags (/files/{fileId}/FEDWireMessage/{tag}) and the webhook
+// subscriptions (/webhooks) are only served by the REST API.
+type WireServiceServer interface {
+	// ListFiles returns a page of the files matching the request, as GET /files does
+	ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error)
+	// CreateFile stores a file given as a message or in the FED format, as POST /files/create does
+	CreateFile(context.Context, *CreateFileRequest) (*File, error)
+	// GetFile returns a file, as GET /files/{fileId} does
+	GetFile(context.Context, *GetFileRequest) (*File, error)
+	// DeleteFile deletes a file, as DELETE /files/{fileId} does
+	DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error)
+	// GetFileContents returns a file in the FED format, as GET /files/{fileId}/contents does
+	GetFileContents(context.Context, *GetFileContentsRequest) (*GetFileContentsResponse, error)
+	// ValidateFile validates a stored file, as GET /files/{fileId}/validate does
+	ValidateFile(context.Context, *ValidateFileRequest) (*ValidateFileResponse, error)
+	// AddFEDWireMessage replaces the message of a file, as POST /files/{fileId}/FEDWireMessage does
+	AddFEDWireMessage(context.Context, *AddFEDWireMessageRequest) (*File, error)
+	// Validate returns the validation errors of a file without storing it, as POST /validate does
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	// Convert converts a valid file to another format without storing it, as POST /convert does
+	Convert(context.Context, *ConvertRequest) (*ConvertResponse, error)
+	// GetFileHistory returns the audit entries of a file, oldest first, as GET /files/{fileId}/history does
+	GetFileHistory(context.Context, *GetFileHistoryRequest) (*GetFileHistoryResponse, error)
+	// GetFileApproval returns the approval state of a file, as GET /files/{fileId}/approval does
+	GetFileApproval(context.Context, *GetFileApprovalRequest) (*FileApproval, error)
+	// ApproveFile approves a file pending approval, as POST /files/{fileId}/approve does
+	ApproveFile(context.Context, *DecideFileRequest) (*FileApproval, error)
+	// RejectFile rejects a file pending approval, as POST /files/{fileId}/reject does
+	RejectFile(context.Context, *DecideFileRequest) (*FileApproval, error)
+	mustEmbedUnimplementedWireServiceServer()
+}
+
+// UnimplementedWireServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWireServiceServer struct{}
+
+func (UnimplementedWireServiceServer) ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFiles not implemented")
+}
+func (UnimplementedWireServiceServer) CreateFile(context.Context, *CreateFileRequest) (*File, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFile not implemented")
+}
+func (UnimplementedWireServiceServer) GetFile(context.Context, *GetFileRequest) (*File, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFile not implemented")
+}
+func (UnimplementedWireServiceServer) DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteFile not implemented")
+}
+func (UnimplementedWireServiceServer) GetFileContents(context.Context, *GetFileContentsRequest) (*GetFileContentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFileContents not implemented")
+}
+func (UnimplementedWireServiceServer) ValidateFile(context.Context, *ValidateFileRequest) (*ValidateFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateFile not implemented")
+}
+func (UnimplementedWireServiceServer) AddFEDWireMessage(context.Context, *AddFEDWireMessageRequest) (*File, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddFEDWireMessage not implemented")
+}
+func (UnimplementedWireServiceServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedWireServiceServer) Convert(context.Context, *ConvertRequest) (*ConvertResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Convert not implemented")
+}
+func (UnimplementedWireServiceServer) GetFileHistory(context.Context, *GetFileHistoryRequest) (*GetFileHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFileHistory not implemented")
+}
+func (UnimplementedWireServiceServer) GetFileApproval(context.Context, *GetFileApprovalRequest) (*FileApproval, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFileApproval not implemented")
+}
+func (UnimplementedWireServiceServer) ApproveFile(context.Context, *DecideFileRequest) (*FileApproval, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveFile not implemented")
+}
+func (UnimplementedWireServiceServer) RejectFile(context.Context, *DecideFileRequest) (*FileApproval, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectFile not implemented")
+}
+func (UnimplementedWireServiceServer) mustEmbedUnimplementedWireServiceServer() {}
+func (UnimplementedWireServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeWireServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WireServiceServer will
+// result in compilation errors.
+type UnsafeWireServiceServer interface {
+	mustEmbedUnimplementedWireServiceServer()
+}
+
+func RegisterWireServiceServer(s grpc.ServiceRegistrar, srv WireServiceServer) {
+	// If the following call panics, it indicates UnimplementedWireServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WireService_ServiceDesc, srv)
+}
+
+func _WireService_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).ListFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_ListFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).ListFiles(ctx, req.(*ListFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_CreateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).CreateFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_CreateFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).CreateFile(ctx, req.(*CreateFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_GetFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).GetFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_GetFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).GetFile(ctx, req.(*GetFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).DeleteFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_DeleteFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).DeleteFile(ctx, req.(*DeleteFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_GetFileContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileContentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).GetFileContents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_GetFileContents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).GetFileContents(ctx, req.(*GetFileContentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_ValidateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).ValidateFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_ValidateFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).ValidateFile(ctx, req.(*ValidateFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_AddFEDWireMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFEDWireMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).AddFEDWireMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_AddFEDWireMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).AddFEDWireMessage(ctx, req.(*AddFEDWireMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_Convert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).Convert(ctx, req.(*ConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_GetFileHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).GetFileHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_GetFileHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).GetFileHistory(ctx, req.(*GetFileHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_GetFileApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).GetFileApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_GetFileApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).GetFileApproval(ctx, req.(*GetFileApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_ApproveFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).ApproveFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_ApproveFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).ApproveFile(ctx, req.(*DecideFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WireService_RejectFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WireServiceServer).RejectFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WireService_RejectFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WireServiceServer).RejectFile(ctx, req.(*DecideFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WireService_ServiceDesc is the grpc.ServiceDesc for WireService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WireService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "moov.wire.v1.WireService",
+	HandlerType: (*WireServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFiles",
+			Handler:    _WireService_ListFiles_Handler,
+		},
+		{
+			MethodName: "CreateFile",
+			Handler:    _WireService_CreateFile_Handler,
+		},
+		{
+			MethodName: "GetFile",
+			Handler:    _WireService_GetFile_Handler,
+		},
+		{
+			MethodName: "DeleteFile",
+			Handler:    _WireService_DeleteFile_Handler,
+		},
+		{
+			MethodName: "GetFileContents",
+			Handler:    _WireService_GetFileContents_Handler,
+		},
+		{
+			MethodName: "ValidateFile",
+			Handler:    _WireService_ValidateFile_Handler,
+		},
+		{
+			MethodName: "AddFEDWireMessage",
+			Handler:    _WireService_AddFEDWireMessage_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _WireService_Validate_Handler,
+		},
+		{
+			MethodName: "Convert",
+			Handler:    _WireService_Convert_Handler,
+		},
+		{
+			MethodName: "GetFileHistory",
+			Handler:    _WireService_GetFileHistory_Handler,
+		},
+		{
+			MethodName: "GetFileApproval",
+			Handler:    _WireService_GetFileApproval_Handler,
+		},
+		{
+			MethodName: "ApproveFile",
+			Handler:    _WireService_ApproveFile_Handler,
+		},
+		{
+			MethodName: "RejectFile",
+			Handler:    _WireService_RejectFile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wire.proto",
+}
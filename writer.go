@@ -6,9 +6,13 @@ package wire
 
 import (
 	"bufio"
+	"context"
 	"io"
 	"slices"
 	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // A Writer writes an fedWireMessage to an encoded file.
@@ -62,12 +66,20 @@ func NewWriter(w io.Writer, opts ...OptionFunc) *Writer {
 //	first bool : has variable length
 //	second bool : has not new line
 func (w *Writer) Write(file *File) error {
-	if err := file.Validate(); err != nil {
+	return w.WriteContext(context.Background(), file)
+}
+
+// WriteContext writes file as Write does, with ctx as the parent of its trace spans.
+func (w *Writer) WriteContext(ctx context.Context, file *File) (err error) {
+	ctx, span := startSpan(ctx, "wire.Writer.Write", trace.WithAttributes(messageAttributes(&file.FEDWireMessage)...))
+	defer func() { endSpan(span, err) }()
+
+	if err := file.ValidateContext(ctx); err != nil {
 		return err
 	}
 	w.lineNum = 0
 	// Iterate over all records in the file
-	if err := w.writeFEDWireMessage(file); err != nil {
+	if err := w.writeFEDWireMessage(ctx, file); err != nil {
 		return err
 	}
 	w.lineNum++
@@ -82,7 +94,7 @@ func (w *Writer) Flush() error {
 	return w.w.Flush()
 }
 
-func (w *Writer) writeFEDWireMessage(file *File) error {
+func (w *Writer) writeFEDWireMessage(ctx context.Context, file *File) error {
 	fwm := file.FEDWireMessage
 
 	var outputLines []string
@@ -144,6 +156,7 @@ func (w *Writer) writeFEDWireMessage(file *File) error {
 	outputLines = append(outputLines, fedAppendedLines...)
 
 	slices.Sort(outputLines)
+	trace.SpanFromContext(ctx).SetAttributes(attribute.Int("wire.tags", len(outputLines)))
 	w.w.WriteString(strings.Join(outputLines, w.NewlineCharacter))
 	w.w.WriteString(w.NewlineCharacter)
 